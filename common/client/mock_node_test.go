@@ -8,6 +8,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	types "github.com/smartcontractkit/chainlink/v2/common/types"
 )
 
@@ -52,6 +54,34 @@ func (_m *mockNode[CHAIN_ID, HEAD, RPC]) ConfiguredChainID() CHAIN_ID {
 	return r0
 }
 
+// LatencyStats provides a mock function with given fields:
+func (_m *mockNode[CHAIN_ID, HEAD, RPC]) LatencyStats() (time.Duration, float64) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatencyStats")
+	}
+
+	var r0 time.Duration
+	var r1 float64
+	if rf, ok := ret.Get(0).(func() (time.Duration, float64)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(1).(func() float64); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(float64)
+	}
+
+	return r0, r1
+}
+
 // Name provides a mock function with given fields:
 func (_m *mockNode[CHAIN_ID, HEAD, RPC]) Name() string {
 	ret := _m.Called()
@@ -72,6 +72,9 @@ type Node[
 	UnsubscribeAllExceptAliveLoop()
 	ConfiguredChainID() CHAIN_ID
 	Order() int32
+	// LatencyStats returns the moving averages of the node's call latency and error rate (0-1).
+	// A zero latency means no successful call has been observed yet.
+	LatencyStats() (latency time.Duration, errorRate float64)
 	Start(context.Context) error
 	Close() error
 }
@@ -95,6 +98,9 @@ type node[
 	http *url.URL
 
 	rpc RPC
+	// rpcReportsCalls is true if rpc reports all of its calls (including liveness polls) to the latency tracker
+	rpcReportsCalls bool
+	latency         *latencyTracker
 
 	stateMu sync.RWMutex // protects state* fields
 	state   nodeState
@@ -155,6 +161,11 @@ func NewNode[
 	n.stateLatestBlockNumber = -1
 	n.rpc = rpc
 	n.chainFamily = chainFamily
+	n.latency = newLatencyTracker(chainID.String(), name)
+	if reporter, ok := any(rpc).(rpcCallReporter); ok {
+		reporter.SetCallObserver(n.latency.observe)
+		n.rpcReportsCalls = true
+	}
 	return n
 }
 
@@ -178,6 +189,10 @@ func (n *node[CHAIN_ID, HEAD, RPC]) RPC() RPC {
 	return n.rpc
 }
 
+func (n *node[CHAIN_ID, HEAD, RPC]) LatencyStats() (time.Duration, float64) {
+	return n.latency.stats()
+}
+
 func (n *node[CHAIN_ID, HEAD, RPC]) SubscribersCount() int32 {
 	return n.rpc.SubscribersCount()
 }
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promPoolRPCNodeLatency = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pool_rpc_node_latency_ewma_seconds",
+		Help: "Exponentially weighted moving average of the latency of successful calls to the given RPC node",
+	}, []string{"chainID", "nodeName"})
+	promPoolRPCNodeErrorRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pool_rpc_node_error_rate_ewma",
+		Help: "Exponentially weighted moving average of the error rate (0-1) of calls to the given RPC node",
+	}, []string{"chainID", "nodeName"})
+)
+
+// latencyEWMAWeight is the weight given to each new observation when updating the moving averages. With 0.1, the
+// averages mostly reflect the last ~20 calls, and 3 consecutive failures on a healthy node exceed
+// lowestLatencyMaxErrorRate.
+const latencyEWMAWeight = 0.1
+
+// rpcCallReporter is implemented by RPCs which report the duration and outcome of every call made through them.
+// Nodes wrapping such an RPC feed their latency tracker from these reports, instead of from liveness polls only.
+type rpcCallReporter interface {
+	SetCallObserver(observe func(duration time.Duration, err error))
+}
+
+// latencyTracker keeps exponentially weighted moving averages of the latency and error rate of the calls made to a node.
+type latencyTracker struct {
+	chainID  string
+	nodeName string
+
+	mu        sync.RWMutex
+	latency   time.Duration
+	errorRate float64
+}
+
+func newLatencyTracker(chainID, nodeName string) *latencyTracker {
+	return &latencyTracker{chainID: chainID, nodeName: nodeName}
+}
+
+// observe records the outcome of a single call. The latency of failed calls is ignored, as it usually reflects a
+// timeout or a connection error rather than how fast the node responds.
+func (t *latencyTracker) observe(duration time.Duration, err error) {
+	t.mu.Lock()
+	failure := 0.0
+	if err != nil {
+		failure = 1
+	} else if t.latency == 0 {
+		t.latency = duration
+	} else {
+		t.latency += time.Duration(latencyEWMAWeight * float64(duration-t.latency))
+	}
+	t.errorRate += latencyEWMAWeight * (failure - t.errorRate)
+	latency, errorRate := t.latency, t.errorRate
+	t.mu.Unlock()
+
+	promPoolRPCNodeLatency.WithLabelValues(t.chainID, t.nodeName).Set(latency.Seconds())
+	promPoolRPCNodeErrorRate.WithLabelValues(t.chainID, t.nodeName).Set(errorRate)
+}
+
+// stats returns the current moving averages. A zero latency means no successful call has been observed yet.
+func (t *latencyTracker) stats() (latency time.Duration, errorRate float64) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.latency, t.errorRate
+}
@@ -158,11 +158,15 @@ func (n *node[CHAIN_ID, HEAD, RPC]) aliveLoop() {
 		case <-pollCh:
 			promPoolRPCNodePolls.WithLabelValues(n.chainID.String(), n.name).Inc()
 			lggr.Tracew("Polling for version", "nodeState", n.State(), "pollFailures", pollFailures)
+			pollStart := time.Now()
 			version, err := func(ctx context.Context) (string, error) {
 				ctx, cancel := context.WithTimeout(ctx, pollInterval)
 				defer cancel()
 				return n.RPC().ClientVersion(ctx)
 			}(ctx)
+			if !n.rpcReportsCalls {
+				n.latency.observe(time.Since(pollStart), err)
+			}
 			if err != nil {
 				// prevent overflow
 				if pollFailures < math.MaxUint32 {
@@ -287,7 +291,7 @@ func (n *node[CHAIN_ID, HEAD, RPC]) syncStatus(num int64, td *big.Int) (outOfSyn
 	ln, highest, greatest := n.nLiveNodes()
 	mode := n.nodePoolCfg.SelectionMode()
 	switch mode {
-	case NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel:
+	case NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel, NodeSelectionModeLowestLatency:
 		return num < highest-int64(threshold), ln
 	case NodeSelectionModeTotalDifficulty:
 		bigThreshold := big.NewInt(int64(threshold))
@@ -1354,7 +1354,7 @@ func TestUnit_NodeLifecycle_syncStatus(t *testing.T) {
 			},
 		}
 
-		for _, selectionMode := range []string{NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel} {
+		for _, selectionMode := range []string{NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel, NodeSelectionModeLowestLatency} {
 			node := newTestNode(t, testNodeOpts{
 				config: testNodeConfig{
 					syncThreshold: syncThreshold,
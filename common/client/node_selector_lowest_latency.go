@@ -0,0 +1,69 @@
+package client
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// lowestLatencyMaxErrorRate is the moving average error rate above which a node is shed by the lowestLatencyNodeSelector,
+// as long as there is at least one alive node below it.
+const lowestLatencyMaxErrorRate = 0.25
+
+type lowestLatencyNodeSelector[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+] []Node[CHAIN_ID, HEAD, RPC]
+
+func NewLowestLatencyNodeSelector[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+](nodes []Node[CHAIN_ID, HEAD, RPC]) NodeSelector[CHAIN_ID, HEAD, RPC] {
+	return lowestLatencyNodeSelector[CHAIN_ID, HEAD, RPC](nodes)
+}
+
+// Select returns the alive node with the lowest moving average latency, skipping nodes whose error rate exceeds
+// lowestLatencyMaxErrorRate. If every alive node exceeds it, the nodes with the lowest error rate are considered instead.
+// Nodes without any successful call observed yet are treated as the fastest, so that they get measured.
+// Ties are broken by node Order.
+func (s lowestLatencyNodeSelector[CHAIN_ID, HEAD, RPC]) Select() Node[CHAIN_ID, HEAD, RPC] {
+	var fastestNodes, leastFailingNodes []Node[CHAIN_ID, HEAD, RPC]
+	var lowestLatency time.Duration
+	lowestErrorRate := 1.0
+	for _, n := range s {
+		if n.State() != nodeStateAlive {
+			continue
+		}
+		latency, errorRate := n.LatencyStats()
+		if errorRate > lowestLatencyMaxErrorRate {
+			if fastestNodes != nil {
+				continue
+			}
+			if errorRate < lowestErrorRate {
+				lowestErrorRate = errorRate
+				leastFailingNodes = nil
+			}
+			if errorRate == lowestErrorRate {
+				leastFailingNodes = append(leastFailingNodes, n)
+			}
+			continue
+		}
+		if fastestNodes == nil || latency < lowestLatency {
+			lowestLatency = latency
+			fastestNodes = nil
+		}
+		if latency == lowestLatency {
+			fastestNodes = append(fastestNodes, n)
+		}
+	}
+	if fastestNodes == nil {
+		return firstOrHighestPriority(leastFailingNodes)
+	}
+	return firstOrHighestPriority(fastestNodes)
+}
+
+func (s lowestLatencyNodeSelector[CHAIN_ID, HEAD, RPC]) Name() string {
+	return NodeSelectionModeLowestLatency
+}
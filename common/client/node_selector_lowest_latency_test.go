@@ -0,0 +1,149 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+func TestLowestLatencyNodeSelectorName(t *testing.T) {
+	selector := newNodeSelector[types.ID, Head, NodeClient[types.ID, Head]](NodeSelectionModeLowestLatency, nil)
+	assert.Equal(t, selector.Name(), NodeSelectionModeLowestLatency)
+}
+
+func TestLowestLatencyNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	type nodeClient NodeClient[types.ID, Head]
+	type testNode struct {
+		order     int32
+		state     nodeState
+		latency   time.Duration
+		errorRate float64
+	}
+	type testCase struct {
+		name   string
+		nodes  []testNode
+		expect int // index of the node expected to be returned by Select, -1 for none
+	}
+
+	testCases := []testCase{
+		{
+			name: "NoneAvailable",
+			nodes: []testNode{
+				{order: 1, state: nodeStateOutOfSync, latency: time.Millisecond},
+				{order: 1, state: nodeStateUnreachable, latency: time.Millisecond},
+			},
+			expect: -1,
+		},
+		{
+			name: "LowestLatency",
+			nodes: []testNode{
+				{order: 1, state: nodeStateAlive, latency: 30 * time.Millisecond},
+				{order: 1, state: nodeStateAlive, latency: 10 * time.Millisecond},
+				{order: 1, state: nodeStateAlive, latency: 20 * time.Millisecond},
+			},
+			expect: 1,
+		},
+		{
+			name: "SkipsNodesThatAreNotAlive",
+			nodes: []testNode{
+				{order: 1, state: nodeStateOutOfSync, latency: 10 * time.Millisecond},
+				{order: 1, state: nodeStateAlive, latency: 20 * time.Millisecond},
+			},
+			expect: 1,
+		},
+		{
+			name: "UnmeasuredNodeIsPreferred",
+			nodes: []testNode{
+				{order: 1, state: nodeStateAlive, latency: 10 * time.Millisecond},
+				{order: 1, state: nodeStateAlive},
+			},
+			expect: 1,
+		},
+		{
+			name: "TieBrokenByOrder",
+			nodes: []testNode{
+				{order: 2, state: nodeStateAlive, latency: 10 * time.Millisecond},
+				{order: 1, state: nodeStateAlive, latency: 10 * time.Millisecond},
+			},
+			expect: 1,
+		},
+		{
+			name: "ShedsNodeWithErrorRateSpike",
+			nodes: []testNode{
+				{order: 1, state: nodeStateAlive, latency: 10 * time.Millisecond, errorRate: 0.3},
+				{order: 1, state: nodeStateAlive, latency: 50 * time.Millisecond, errorRate: 0.1},
+			},
+			expect: 1,
+		},
+		{
+			name: "AllNodesFailing: lowest error rate",
+			nodes: []testNode{
+				{order: 1, state: nodeStateAlive, latency: 10 * time.Millisecond, errorRate: 0.9},
+				{order: 1, state: nodeStateAlive, latency: 50 * time.Millisecond, errorRate: 0.5},
+				{order: 1, state: nodeStateAlive, latency: 20 * time.Millisecond, errorRate: 0.7},
+			},
+			expect: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var nodes []Node[types.ID, Head, nodeClient]
+			for _, tn := range tc.nodes {
+				node := newMockNode[types.ID, Head, nodeClient](t)
+				node.On("State").Return(tn.state)
+				node.On("Order").Return(tn.order).Maybe()
+				node.On("LatencyStats").Return(tn.latency, tn.errorRate).Maybe()
+				nodes = append(nodes, node)
+			}
+
+			selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+			if tc.expect < 0 {
+				assert.Nil(t, selector.Select())
+				return
+			}
+			assert.Same(t, nodes[tc.expect], selector.Select())
+		})
+	}
+}
+
+func TestLatencyTracker(t *testing.T) {
+	t.Parallel()
+
+	tracker := newLatencyTracker(types.RandomID().String(), "test node")
+	latency, errorRate := tracker.stats()
+	assert.Zero(t, latency)
+	assert.Zero(t, errorRate)
+
+	// first successful call sets the latency
+	tracker.observe(100*time.Millisecond, nil)
+	latency, errorRate = tracker.stats()
+	assert.Equal(t, 100*time.Millisecond, latency)
+	assert.Zero(t, errorRate)
+
+	// subsequent calls are averaged
+	tracker.observe(200*time.Millisecond, nil)
+	latency, _ = tracker.stats()
+	assert.Equal(t, 110*time.Millisecond, latency)
+
+	// failures raise the error rate, but don't affect the latency
+	for i := 0; i < 3; i++ {
+		tracker.observe(time.Minute, assert.AnError)
+	}
+	latency, errorRate = tracker.stats()
+	assert.Equal(t, 110*time.Millisecond, latency)
+	assert.InDelta(t, 0.271, errorRate, 0.0001)
+	assert.Greater(t, errorRate, lowestLatencyMaxErrorRate)
+
+	// and recover with successful calls
+	for i := 0; i < 10; i++ {
+		tracker.observe(110*time.Millisecond, nil)
+	}
+	_, errorRate = tracker.stats()
+	assert.Less(t, errorRate, lowestLatencyMaxErrorRate)
+}
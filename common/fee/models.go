@@ -11,13 +11,14 @@ import (
 )
 
 var (
-	ErrBumpFeeExceedsLimit = errors.New("fee bump exceeds limit")
-	ErrBump                = errors.New("fee bump failed")
-	ErrConnectivity        = errors.New("transaction propagation issue: transactions are not being mined")
+	ErrBumpFeeExceedsLimit  = errors.New("fee bump exceeds limit")
+	ErrBumpFeeExceedsBudget = errors.New("fee bump exceeds budget")
+	ErrBump                 = errors.New("fee bump failed")
+	ErrConnectivity         = errors.New("transaction propagation issue: transactions are not being mined")
 )
 
 func IsBumpErr(err error) bool {
-	return err != nil && (errors.Is(err, ErrBumpFeeExceedsLimit) || errors.Is(err, ErrBump) || errors.Is(err, ErrConnectivity))
+	return err != nil && (errors.Is(err, ErrBumpFeeExceedsLimit) || errors.Is(err, ErrBumpFeeExceedsBudget) || errors.Is(err, ErrBump) || errors.Is(err, ErrConnectivity))
 }
 
 // CalculateFee computes the fee price for a transaction.
@@ -29,6 +29,12 @@ var (
 		Name: "head_tracker_very_old_head",
 		Help: "Counter is incremented every time we get a head that is much lower than the highest seen head ('much lower' is defined as a block that is EVM.FinalityDepth or greater below the highest seen head)",
 	}, []string{"evmChainID"})
+
+	promReorgDepth = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "head_tracker_reorg_depth",
+		Help:    "The number of blocks replaced by each reorg detected by the head tracker",
+		Buckets: []float64{1, 2, 3, 5, 10, 20, 50, 100, 200, 500, 1000},
+	}, []string{"evmChainID"})
 )
 
 // HeadsBufferSize - The buffer is used when heads sampling is disabled, to ensure the callback is run for every head
@@ -42,6 +48,8 @@ type HeadTracker[H types.Head[BLOCK_HASH], BLOCK_HASH types.Hashable] interface
 	// Backfill given a head will fill in any missing heads up to latestFinalized
 	Backfill(ctx context.Context, headWithChain, latestFinalized H) (err error)
 	LatestChain() H
+	// SubscribeReorgs registers the callback to be notified of every reorg detected, until unsubscribe is called
+	SubscribeReorgs(callback ReorgTrackable[BLOCK_HASH]) (unsubscribe func())
 }
 
 type headTracker[
@@ -66,6 +74,10 @@ type headTracker[
 	chStop       services.StopChan
 	wgDone       sync.WaitGroup
 	getNilHead   func() HTH
+
+	// latestBackfilled is the latest head whose chain was backfilled, only accessed by the backfillLoop
+	latestBackfilled HTH
+	reorgSubscribers reorgSubscribers[BLOCK_HASH]
 }
 
 // NewHeadTracker instantiates a new HeadTracker using HeadSaver to persist new block numbers.
@@ -215,6 +227,10 @@ func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) LatestChain() HTH {
 	return ht.headSaver.LatestChain()
 }
 
+func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) SubscribeReorgs(callback ReorgTrackable[BLOCK_HASH]) (unsubscribe func()) {
+	return ht.reorgSubscribers.subscribe(callback)
+}
+
 func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) handleNewHead(ctx context.Context, head HTH) error {
 	prevHead := ht.headSaver.LatestChain()
 
@@ -328,6 +344,8 @@ func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) backfillLoop() {
 						ht.log.Warnw("Unexpected error while backfilling heads", "err", err)
 					} else if ctx.Err() != nil {
 						break
+					} else {
+						ht.detectReorg(ctx, head)
 					}
 				}
 			}
@@ -335,6 +353,33 @@ func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) backfillLoop() {
 	}
 }
 
+// detectReorg compares the chain of the newly backfilled head with the chain of the previous one. If the previous
+// head is no longer part of the canonical chain, the reorg is persisted and broadcast to the subscribers.
+func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) detectReorg(ctx context.Context, head HTH) {
+	// the backfilled chain is only linked in the copy held by the headSaver
+	headWithChain := ht.headSaver.Chain(head.BlockHash())
+	if !headWithChain.IsValid() {
+		return
+	}
+	prevHead := ht.latestBackfilled
+	ht.latestBackfilled = headWithChain
+
+	reorg, found := findReorg[HTH, BLOCK_HASH](prevHead, headWithChain)
+	if !found {
+		return
+	}
+	from, to := reorg.AffectedRange()
+	ht.log.Warnw("Detected reorg", "depth", reorg.Depth, "fromBlock", from, "toBlock", to,
+		"oldHeadHash", reorg.OldHeadHash, "newHeadNumber", reorg.NewHeadNumber, "newHeadHash", reorg.NewHeadHash,
+		"commonAncestorHash", reorg.CommonAncestorHash)
+	promReorgDepth.WithLabelValues(ht.chainID.String()).Observe(float64(reorg.Depth))
+
+	if err := ht.headSaver.SaveReorg(ctx, reorg); err != nil {
+		ht.log.Errorw("Failed to save reorg", "reorg", reorg, "err", err)
+	}
+	ht.reorgSubscribers.notify(ctx, ht.log, reorg)
+}
+
 // calculateLatestFinalized - returns latest finalized block. It's expected that currentHeadNumber - is the head of
 // canonical chain. There is no guaranties that returned block belongs to the canonical chain. Additional verification
 // must be performed before usage.
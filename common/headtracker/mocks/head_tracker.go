@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	headtracker "github.com/smartcontractkit/chainlink/v2/common/headtracker"
 	mock "github.com/stretchr/testify/mock"
 
 	types "github.com/smartcontractkit/chainlink/v2/common/types"
@@ -143,6 +144,26 @@ func (_m *HeadTracker[H, BLOCK_HASH]) Start(_a0 context.Context) error {
 	return r0
 }
 
+// SubscribeReorgs provides a mock function with given fields: callback
+func (_m *HeadTracker[H, BLOCK_HASH]) SubscribeReorgs(callback headtracker.ReorgTrackable[BLOCK_HASH]) func() {
+	ret := _m.Called(callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeReorgs")
+	}
+
+	var r0 func()
+	if rf, ok := ret.Get(0).(func(headtracker.ReorgTrackable[BLOCK_HASH]) func()); ok {
+		r0 = rf(callback)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func())
+		}
+	}
+
+	return r0
+}
+
 // NewHeadTracker creates a new instance of HeadTracker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewHeadTracker[H types.Head[BLOCK_HASH], BLOCK_HASH types.Hashable](t interface {
@@ -0,0 +1,132 @@
+package headtracker
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// Reorg describes a reorg detected by the HeadTracker: the blocks after the common ancestor, up to the old head, were
+// replaced by the chain of the new head.
+type Reorg[BLOCK_HASH types.Hashable] struct {
+	// Depth is the number of blocks of the old chain which were replaced
+	Depth int64
+	// CommonAncestorNumber is the highest block shared by the old and new chains.
+	CommonAncestorNumber int64
+	// CommonAncestorHash is empty if the reorg goes deeper than the chains known to the HeadTracker, in which case
+	// Depth is a lower bound.
+	CommonAncestorHash BLOCK_HASH
+	OldHeadNumber      int64
+	OldHeadHash        BLOCK_HASH
+	NewHeadNumber      int64
+	NewHeadHash        BLOCK_HASH
+	DetectedAt         time.Time
+}
+
+// AffectedRange returns the range, inclusive, of the numbers of the blocks which were replaced.
+func (r Reorg[BLOCK_HASH]) AffectedRange() (from, to int64) {
+	return r.CommonAncestorNumber + 1, r.OldHeadNumber
+}
+
+func (r Reorg[BLOCK_HASH]) String() string {
+	from, to := r.AffectedRange()
+	return fmt.Sprintf("Reorg{Depth: %d, Blocks: %d-%d, OldHead: %s, NewHead: %d %s}", r.Depth, from, to, r.OldHeadHash, r.NewHeadNumber, r.NewHeadHash)
+}
+
+// ReorgTrackable is implemented by services which need to react to reorgs, such as the log poller or the txm.
+//
+//go:generate mockery --quiet --name ReorgTrackable --output ./mocks/ --case=underscore
+type ReorgTrackable[BLOCK_HASH types.Hashable] interface {
+	// OnReorg is called for every reorg detected by the HeadTracker, once the new chain was backfilled.
+	OnReorg(ctx context.Context, reorg Reorg[BLOCK_HASH])
+}
+
+// findReorg compares the chain of the new head with the chain of the previous head, and returns the reorg which
+// replaced the previous head, if any.
+func findReorg[H types.Head[BLOCK_HASH], BLOCK_HASH types.Hashable](prevHead, head H) (reorg Reorg[BLOCK_HASH], found bool) {
+	var zero BLOCK_HASH
+	if !prevHead.IsValid() || !head.IsValid() || head.BlockNumber() < prevHead.BlockNumber() {
+		return reorg, false
+	}
+	if hash := head.HashAtHeight(prevHead.BlockNumber()); hash == zero || hash == prevHead.BlockHash() {
+		// the new chain either extends the previous head, or is too short to tell
+		return reorg, false
+	}
+
+	reorg = Reorg[BLOCK_HASH]{
+		OldHeadNumber: prevHead.BlockNumber(),
+		OldHeadHash:   prevHead.BlockHash(),
+		NewHeadNumber: head.BlockNumber(),
+		NewHeadHash:   head.BlockHash(),
+		DetectedAt:    time.Now(),
+	}
+	for n := prevHead.BlockNumber() - 1; ; n-- {
+		oldHash, newHash := prevHead.HashAtHeight(n), head.HashAtHeight(n)
+		if oldHash == zero || newHash == zero {
+			// ran out of known blocks before finding the common ancestor
+			reorg.CommonAncestorNumber = n
+			break
+		}
+		if oldHash == newHash {
+			reorg.CommonAncestorNumber = n
+			reorg.CommonAncestorHash = newHash
+			break
+		}
+	}
+	reorg.Depth = reorg.OldHeadNumber - reorg.CommonAncestorNumber
+	return reorg, true
+}
+
+type reorgSubscribers[BLOCK_HASH types.Hashable] struct {
+	mu        sync.Mutex
+	callbacks map[int]ReorgTrackable[BLOCK_HASH]
+	lastID    int
+}
+
+func (s *reorgSubscribers[BLOCK_HASH]) subscribe(callback ReorgTrackable[BLOCK_HASH]) (unsubscribe func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.callbacks == nil {
+		s.callbacks = make(map[int]ReorgTrackable[BLOCK_HASH])
+	}
+	s.lastID++
+	id := s.lastID
+	s.callbacks[id] = callback
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.callbacks, id)
+	}
+}
+
+// notify calls every subscriber concurrently, each with TrackableCallbackTimeout, and waits for them to return.
+func (s *reorgSubscribers[BLOCK_HASH]) notify(ctx context.Context, lggr logger.SugaredLogger, reorg Reorg[BLOCK_HASH]) {
+	s.mu.Lock()
+	callbacks := make([]ReorgTrackable[BLOCK_HASH], 0, len(s.callbacks))
+	for _, callback := range s.callbacks {
+		callbacks = append(callbacks, callback)
+	}
+	s.mu.Unlock()
+
+	var wg sync.WaitGroup
+	wg.Add(len(callbacks))
+	for _, callback := range callbacks {
+		go func(trackable ReorgTrackable[BLOCK_HASH]) {
+			defer wg.Done()
+			start := time.Now()
+			cctx, cancel := context.WithTimeout(ctx, TrackableCallbackTimeout)
+			defer cancel()
+			trackable.OnReorg(cctx, reorg)
+			lggr.Debugw(fmt.Sprintf("Finished reorg callback in %s", time.Since(start)),
+				"callbackType", reflect.TypeOf(trackable), "depth", reorg.Depth)
+		}(callback)
+	}
+	wg.Wait()
+}
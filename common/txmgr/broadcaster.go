@@ -34,6 +34,11 @@ const (
 	// TransmitCheckTimeout controls the maximum amount of time that will be
 	// spent on the transmit check.
 	TransmitCheckTimeout = 2 * time.Second
+
+	// unstartedTxQueueObserveInterval controls how often the depth of the
+	// unstarted queues is reported. Prometheus' default interval is 15s, set
+	// this to under 7.5s to avoid aliasing (see: https://en.wikipedia.org/wiki/Nyquist_frequency)
+	unstartedTxQueueObserveInterval = 6500 * time.Millisecond
 )
 
 var (
@@ -50,6 +55,14 @@ var (
 			float64(2 * time.Minute),
 		},
 	}, []string{"chainID"})
+	promNumBroadcastedTxs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_broadcasted_txes",
+		Help: "Number of transactions broadcast for the first time, by priority class",
+	}, []string{"chainID", "priority"})
+	promNumUnstartedTxs = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_num_unstarted_txes",
+		Help: "Number of unstarted transactions queued per key, by priority class",
+	}, []string{"chainID", "fromAddress", "priority"})
 )
 
 var ErrTxRemoved = errors.New("tx removed")
@@ -113,12 +126,17 @@ type Broadcaster[
 	txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	sequenceTracker txmgrtypes.SequenceTracker[ADDR, SEQ]
 	resumeCallback  ResumeCallback
+	keyPaused       KeyPausedChecker[ADDR]
 	chainID         CHAIN_ID
 	config          txmgrtypes.BroadcasterChainConfig
 	feeConfig       txmgrtypes.BroadcasterFeeConfig
 	txConfig        txmgrtypes.BroadcasterTransactionsConfig
 	listenerConfig  txmgrtypes.BroadcasterListenerConfig
 
+	// pausedKeys holds the addresses last seen paused, so that pausing and resuming are only logged once
+	pausedKeysMu sync.Mutex
+	pausedKeys   map[ADDR]bool
+
 	// autoSyncSequence, if set, will cause Broadcaster to fast-forward the sequence
 	// when Start is called
 	autoSyncSequence bool
@@ -179,6 +197,7 @@ func NewBroadcaster[
 		checkerFactory:   checkerFactory,
 		autoSyncSequence: autoSyncSequence,
 		sequenceTracker:  sequenceTracker,
+		pausedKeys:       make(map[ADDR]bool),
 	}
 
 	b.processUnstartedTxsImpl = b.processUnstartedTxs
@@ -221,6 +240,10 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) star
 		eb.triggers[addr] = triggerCh
 		go eb.monitorTxs(addr, triggerCh)
 	}
+	if len(eb.enabledAddresses) > 0 {
+		eb.wg.Add(1)
+		go eb.monitorUnstartedTxQueues(eb.enabledAddresses)
+	}
 
 	eb.isStarted = true
 	return nil
@@ -249,6 +272,11 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SetR
 	eb.resumeCallback = callback
 }
 
+// SetKeyPausedChecker sets the function used to check whether a key may only broadcast critical priority transactions.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SetKeyPausedChecker(checker KeyPausedChecker[ADDR]) {
+	eb.keyPaused = checker
+}
+
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Name() string {
 	return eb.lggr.Name()
 }
@@ -395,6 +423,11 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) proc
 		if etx == nil {
 			return false, nil
 		}
+		// Unstarted transactions come in order of priority, so none of the remaining ones are critical either.
+		if eb.keyPaused != nil && etx.Priority < txmgrtypes.TxPriorityCritical && eb.checkKeyPaused(fromAddress) {
+			eb.lggr.Debugw("Key is paused, skipping non-critical transactions", "address", fromAddress, "txID", etx.ID, "priority", etx.Priority)
+			return false, nil
+		}
 		n++
 
 		if err, retryable := eb.handleUnstartedTx(ctx, etx); err != nil {
@@ -403,6 +436,59 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) proc
 	}
 }
 
+// checkKeyPaused returns whether fromAddress is paused, and logs when it gets paused or resumed
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) checkKeyPaused(fromAddress ADDR) bool {
+	paused := eb.keyPaused(fromAddress)
+
+	eb.pausedKeysMu.Lock()
+	defer eb.pausedKeysMu.Unlock()
+	if paused == eb.pausedKeys[fromAddress] {
+		return paused
+	}
+	if paused {
+		eb.pausedKeys[fromAddress] = true
+		eb.lggr.Warnw("Key is paused, only critical priority transactions are broadcast until it's funded", "address", fromAddress)
+	} else {
+		delete(eb.pausedKeys, fromAddress)
+		eb.lggr.Infow("Key is resumed, broadcasting transactions of all priorities", "address", fromAddress)
+	}
+	return paused
+}
+
+// monitorUnstartedTxQueues periodically reports the depth of the unstarted queues of addresses, off the
+// processUnstartedTxs hot path
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) monitorUnstartedTxQueues(addresses []ADDR) {
+	defer eb.wg.Done()
+
+	ctx, cancel := eb.chStop.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(utils.WithJitter(unstartedTxQueueObserveInterval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			for _, addr := range addresses {
+				eb.observeUnstartedTxQueue(ctx, addr)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// observeUnstartedTxQueue reports the depth of the unstarted queue of fromAddress for each priority class
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) observeUnstartedTxQueue(ctx context.Context, fromAddress ADDR) {
+	counts, err := eb.txStore.CountUnstartedTransactionsByPriority(ctx, fromAddress, eb.chainID)
+	if err != nil {
+		eb.lggr.Warnw("Failed to count unstarted transactions by priority", "address", fromAddress, "err", err)
+		return
+	}
+	for p := txmgrtypes.TxPriorityLow; p <= txmgrtypes.TxPriorityCritical; p++ {
+		promNumUnstartedTxs.WithLabelValues(eb.chainID.String(), fromAddress.String(), p.String()).Set(float64(counts[p]))
+	}
+}
+
 // handleInProgressTx checks if there is any transaction
 // in_progress and if so, finishes the job
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) handleAnyInProgressTx(ctx context.Context, fromAddress ADDR) (err error, retryable bool) {
@@ -530,6 +616,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 		// and hand off to the confirmer to get the receipt (or mark as
 		// failed).
 		observeTimeUntilBroadcast(eb.chainID, etx.CreatedAt, time.Now())
+		promNumBroadcastedTxs.WithLabelValues(eb.chainID.String(), etx.Priority.String()).Inc()
 		err = eb.txStore.UpdateTxAttemptInProgressToBroadcast(ctx, &etx, attempt, txmgrtypes.TxAttemptBroadcast)
 		if err != nil {
 			return err, true
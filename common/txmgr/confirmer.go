@@ -53,6 +53,10 @@ var (
 		Name: "tx_manager_gas_bump_exceeds_limit",
 		Help: "Number of times gas bumping failed from exceeding the configured limit. Any counts of this type indicate a serious problem.",
 	}, []string{"chainID"})
+	promGasBumpExceedsBudget = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_gas_bump_exceeds_budget",
+		Help: "Number of times gas bumping was paused from exceeding the fee bump budget of a key or job. Any counts of this type indicate transactions are stuck until the budget frees up.",
+	}, []string{"chainID"})
 	promNumConfirmedTxs = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "tx_manager_num_confirmed_transactions",
 		Help: "Total number of confirmed transactions. Note that this can err to be too high since transactions are counted on each confirmation, which can happen multiple times per transaction in the case of re-orgs",
@@ -123,6 +127,7 @@ type Confirmer[
 	client  txmgrtypes.TxmClient[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]
 	txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	stuckTxDetector txmgrtypes.StuckTxDetector[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
+	feeBumpBudget   txmgrtypes.FeeBumpBudget[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	resumeCallback  ResumeCallback
 	chainConfig     txmgrtypes.ConfirmerChainConfig
 	feeConfig       txmgrtypes.ConfirmerFeeConfig
@@ -164,6 +169,7 @@ func NewConfirmer[
 	lggr logger.Logger,
 	isReceiptNil func(R) bool,
 	stuckTxDetector txmgrtypes.StuckTxDetector[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
+	feeBumpBudget txmgrtypes.FeeBumpBudget[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
 ) *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
 	lggr = logger.Named(lggr, "Confirmer")
 	return &Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{
@@ -181,6 +187,7 @@ func NewConfirmer[
 		mb:               mailbox.NewSingle[HEAD](),
 		isReceiptNil:     isReceiptNil,
 		stuckTxDetector:  stuckTxDetector,
+		feeBumpBudget:    feeBumpBudget,
 	}
 }
 
@@ -885,6 +892,9 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) bum
 	var bumpedFee FEE
 	var bumpedFeeLimit uint64
 	bumpedAttempt, bumpedFee, bumpedFeeLimit, _, err = ec.NewBumpTxAttempt(ctx, etx, previousAttempt, previousAttempts, ec.lggr)
+	if err == nil {
+		bumpedAttempt.FeeBumpSpend, err = ec.feeBumpBudget.CheckBump(ctx, etx, previousAttempt, bumpedAttempt)
+	}
 
 	// if no error, return attempt
 	// if err, continue below
@@ -897,6 +907,10 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) bum
 	if errors.Is(err, commonfee.ErrBumpFeeExceedsLimit) {
 		promGasBumpExceedsLimit.WithLabelValues(ec.chainID.String()).Inc()
 	}
+	if errors.Is(err, commonfee.ErrBumpFeeExceedsBudget) {
+		promGasBumpExceedsBudget.WithLabelValues(ec.chainID.String()).Inc()
+		ec.lggr.Criticalw("Fee bumping paused for tx: the bump would exceed the fee bump budget. The tx will keep being rebroadcast at its current fee until the budget frees up", append(logFields, "bumpedFee", bumpedFee.String(), "bumpedFeeLimit", bumpedFeeLimit, "err", err)...)
+	}
 
 	return bumpedAttempt, fmt.Errorf("error bumping gas: %w", err)
 }
@@ -390,6 +390,11 @@ func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Ready(
 	return r0
 }
 
+// RegisterKeyPausedChecker provides a mock function with given fields: fn
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RegisterKeyPausedChecker(fn txmgr.KeyPausedChecker[ADDR]) {
+	_m.Called(fn)
+}
+
 // RegisterResumeCallback provides a mock function with given fields: fn
 func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RegisterResumeCallback(fn txmgr.ResumeCallback) {
 	_m.Called(fn)
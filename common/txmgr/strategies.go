@@ -62,3 +62,40 @@ func (s DropOldestStrategy) PruneQueue(ctx context.Context, pruneService txmgrty
 	}
 	return
 }
+
+var _ txmgrtypes.PriorityTxStrategy = PriorityStrategy{}
+
+// PriorityStrategy assigns a priority class to txes, so that they are broadcast before any unstarted
+// txes of a lower class. If a queue size is specified, the oldest unstarted txes of the same subject
+// and class are removed from the queue once it is exceeded.
+type PriorityStrategy struct {
+	subject   uuid.UUID
+	priority  txmgrtypes.TxPriority
+	queueSize uint32
+}
+
+// NewPriorityStrategy creates a new TxStrategy that queues txes with the given priority class. A
+// queueSize of zero means the queue for this class is unbounded.
+func NewPriorityStrategy(subject uuid.UUID, priority txmgrtypes.TxPriority, queueSize uint32) PriorityStrategy {
+	return PriorityStrategy{subject, priority, queueSize}
+}
+
+func (s PriorityStrategy) Subject() uuid.NullUUID {
+	return uuid.NullUUID{UUID: s.subject, Valid: true}
+}
+
+func (s PriorityStrategy) Priority() txmgrtypes.TxPriority {
+	return s.priority
+}
+
+func (s PriorityStrategy) PruneQueue(ctx context.Context, pruneService txmgrtypes.UnstartedTxQueuePruner) (ids []int64, err error) {
+	if s.queueSize == 0 {
+		return nil, nil
+	}
+	// NOTE: As with DropOldestStrategy, we prune one less than the queue size to leave room for the tx about to be inserted.
+	ids, err = pruneService.PruneUnstartedTxQueueByPriority(ctx, s.queueSize-1, s.subject, s.priority)
+	if err != nil {
+		return ids, fmt.Errorf("PriorityStrategy#PruneQueue failed: %w", err)
+	}
+	return
+}
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	nullv4 "gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -26,9 +28,18 @@ import (
 // For more information about the Txm architecture, see the design doc:
 // https://www.notion.so/chainlink/Txm-Architecture-Overview-9dc62450cd7a443ba9e7dceffa1a8d6b
 
+var promNumPrunedTxs = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "tx_manager_num_pruned_txes",
+	Help: "Number of unstarted transactions removed from the queue because its size was exceeded",
+}, []string{"chainID", "priority"})
+
 // ResumeCallback is assumed to be idempotent
 type ResumeCallback func(ctx context.Context, id uuid.UUID, result interface{}, err error) error
 
+// KeyPausedChecker returns true if only critical priority transactions may be broadcast from the address,
+// e.g. because it's running out of funds.
+type KeyPausedChecker[ADDR types.Hashable] func(addr ADDR) bool
+
 // TxManager is the main component of the transaction manager.
 // It is also the interface to external callers.
 //
@@ -49,6 +60,7 @@ type TxManager[
 	GetForwarderForEOA(ctx context.Context, eoa ADDR) (forwarder ADDR, err error)
 	GetForwarderForEOAOCR2Feeds(ctx context.Context, eoa, ocr2AggregatorID ADDR) (forwarder ADDR, err error)
 	RegisterResumeCallback(fn ResumeCallback)
+	RegisterKeyPausedChecker(fn KeyPausedChecker[ADDR])
 	SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	Reset(addr ADDR, abandon bool) error
 	// Find transactions by a field in the TxMeta blob and transaction states
@@ -117,6 +129,10 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RegisterRe
 	b.confirmer.SetResumeCallback(fn)
 }
 
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RegisterKeyPausedChecker(fn KeyPausedChecker[ADDR]) {
+	b.broadcaster.SetKeyPausedChecker(fn)
+}
+
 // NewTxm creates a new Txm with the given configuration.
 func NewTxm[
 	CHAIN_ID types.ID,
@@ -683,6 +699,8 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Hea
 }
 func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RegisterResumeCallback(fn ResumeCallback) {
 }
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RegisterKeyPausedChecker(fn KeyPausedChecker[ADDR]) {
+}
 func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) FindTxesByMetaFieldAndStates(ctx context.Context, metaField string, metaValue string, states []txmgrtypes.TxState, chainID *big.Int) (txes []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
 	return txes, errors.New(n.ErrMsg)
 }
@@ -719,6 +737,10 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pruneQueue
 	b.pruneQueueAndCreateLock.Lock()
 	defer b.pruneQueueAndCreateLock.Unlock()
 
+	if s, ok := txRequest.Strategy.(txmgrtypes.PriorityTxStrategy); ok {
+		txRequest.Priority = s.Priority()
+	}
+
 	pruned, err := txRequest.Strategy.PruneQueue(ctx, b.txStore)
 	if err != nil {
 		return tx, err
@@ -726,8 +748,10 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pruneQueue
 	if len(pruned) > 0 {
 		b.logger.Warnw(fmt.Sprintf("Pruned %d old unstarted transactions", len(pruned)),
 			"subject", txRequest.Strategy.Subject(),
+			"priority", txRequest.Priority,
 			"pruned-tx-ids", pruned,
 		)
+		promNumPrunedTxs.WithLabelValues(chainID.String(), txRequest.Priority.String()).Add(float64(len(pruned)))
 	}
 
 	tx, err = b.txStore.CreateTransaction(ctx, txRequest, chainID)
@@ -738,6 +762,7 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pruneQueue
 		"fromAddress", txRequest.FromAddress,
 		"toAddress", txRequest.ToAddress,
 		"meta", txRequest.Meta,
+		"priority", txRequest.Priority,
 		"transactionID", tx.ID,
 	)
 
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"context"
+	"math/big"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// FeeBumpBudget is used by the Confirmer to cap the fees spent on bumping transactions over a rolling window
+type FeeBumpBudget[
+	CHAIN_ID types.ID, // CHAIN_ID - chain id type
+	ADDR types.Hashable, // ADDR - chain address type
+	TX_HASH, BLOCK_HASH types.Hashable, // various chain hash types
+	SEQ types.Sequence, // SEQ - chain sequence type (nonce, utxo, etc)
+	FEE feetypes.Fee, // FEE - chain fee type
+] interface {
+	// CheckBump returns the additional fee of replacing the previous attempt with the bumped attempt, or nil if there
+	// is nothing to account for. Returns an error wrapping fee.ErrBumpFeeExceedsBudget if the bump would exceed the
+	// budget of the sending key or of the job which created the transaction.
+	// The spend is not recorded until the bumped attempt carrying it is saved, see TxAttempt.FeeBumpSpend.
+	CheckBump(ctx context.Context, etx Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], previousAttempt, bumpedAttempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (*FeeBumpSpend, error)
+}
+
+// FeeBumpSpend is the additional fee of a bumped attempt which counts against the fee bump budget
+type FeeBumpSpend struct {
+	JobID  *int32
+	Amount *big.Int
+}
@@ -150,6 +150,36 @@ func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CountUnstar
 	return r0, r1
 }
 
+// CountUnstartedTransactionsByPriority provides a mock function with given fields: ctx, fromAddress, chainID
+func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CountUnstartedTransactionsByPriority(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID) (map[txmgrtypes.TxPriority]uint32, error) {
+	ret := _m.Called(ctx, fromAddress, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnstartedTransactionsByPriority")
+	}
+
+	var r0 map[txmgrtypes.TxPriority]uint32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, CHAIN_ID) (map[txmgrtypes.TxPriority]uint32, error)); ok {
+		return rf(ctx, fromAddress, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, CHAIN_ID) map[txmgrtypes.TxPriority]uint32); ok {
+		r0 = rf(ctx, fromAddress, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[txmgrtypes.TxPriority]uint32)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ADDR, CHAIN_ID) error); ok {
+		r1 = rf(ctx, fromAddress, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateTransaction provides a mock function with given fields: ctx, txRequest, chainID
 func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTransaction(ctx context.Context, txRequest txmgrtypes.TxRequest[ADDR, TX_HASH], chainID CHAIN_ID) (txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
 	ret := _m.Called(ctx, txRequest, chainID)
@@ -978,6 +1008,36 @@ func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) PruneUnstar
 	return r0, r1
 }
 
+// PruneUnstartedTxQueueByPriority provides a mock function with given fields: ctx, queueSize, subject, priority
+func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) PruneUnstartedTxQueueByPriority(ctx context.Context, queueSize uint32, subject uuid.UUID, priority txmgrtypes.TxPriority) ([]int64, error) {
+	ret := _m.Called(ctx, queueSize, subject, priority)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneUnstartedTxQueueByPriority")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uuid.UUID, txmgrtypes.TxPriority) ([]int64, error)); ok {
+		return rf(ctx, queueSize, subject, priority)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uuid.UUID, txmgrtypes.TxPriority) []int64); ok {
+		r0 = rf(ctx, queueSize, subject, priority)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uuid.UUID, txmgrtypes.TxPriority) error); ok {
+		r1 = rf(ctx, queueSize, subject, priority)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReapTxHistory provides a mock function with given fields: ctx, minBlockNumberToKeep, timeThreshold, chainID
 func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ReapTxHistory(ctx context.Context, minBlockNumberToKeep int64, timeThreshold time.Time, chainID CHAIN_ID) error {
 	ret := _m.Called(ctx, minBlockNumberToKeep, timeThreshold, chainID)
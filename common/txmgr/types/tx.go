@@ -33,6 +33,50 @@ type TxStrategy interface {
 	PruneQueue(ctx context.Context, pruneService UnstartedTxQueuePruner) (ids []int64, err error)
 }
 
+// PriorityTxStrategy is a TxStrategy which assigns a priority class to the txes it queues.
+// The strategy's priority takes precedence over TxRequest.Priority.
+type PriorityTxStrategy interface {
+	TxStrategy
+	// Priority is saved as txes.priority
+	Priority() TxPriority
+}
+
+// TxPriority is the priority class of a tx. Unstarted txes of a higher priority class are always
+// broadcast before those of a lower priority class from the same address.
+type TxPriority int8
+
+const (
+	TxPriorityLow TxPriority = iota - 1
+	TxPriorityNormal
+	TxPriorityHigh
+	TxPriorityCritical
+)
+
+var txPriorityStrings = map[TxPriority]string{
+	TxPriorityLow:      "low",
+	TxPriorityNormal:   "normal",
+	TxPriorityHigh:     "high",
+	TxPriorityCritical: "critical",
+}
+
+// NewTxPriority parses a priority class from its string representation
+func NewTxPriority(s string) (TxPriority, error) {
+	for p, str := range txPriorityStrings {
+		if str == s {
+			return p, nil
+		}
+	}
+	return TxPriorityNormal, fmt.Errorf("invalid tx priority: %q", s)
+}
+
+// String returns string formatted priority classes for logging and metrics
+func (p TxPriority) String() string {
+	if str, ok := txPriorityStrings[p]; ok {
+		return str
+	}
+	return fmt.Sprintf("TxPriority(%d)", int8(p))
+}
+
 type TxAttemptState int8
 
 type TxState string
@@ -90,6 +134,10 @@ type TxRequest[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 
 	Strategy TxStrategy
 
+	// Priority is the priority class of the tx. It is overridden by the Strategy if that is a
+	// PriorityTxStrategy. Defaults to TxPriorityNormal.
+	Priority TxPriority
+
 	// Checker defines the check that should be run before a transaction is submitted on chain.
 	Checker TransmitCheckerSpec[ADDR]
 
@@ -146,6 +194,10 @@ type TxMeta[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 	// Used for keepers
 	UpkeepID *string `json:"UpkeepID,omitempty"`
 
+	// Used by the fee bump budget - the max fees, in the chain's smallest unit, that the
+	// job may spend on bumping its txs over the configured window
+	FeeBumpBudget *string `json:"FeeBumpBudget,omitempty"`
+
 	// Used for VRF to know if the txn is a ForceFulfilment txn
 	ForceFulfilled          *bool   `json:"ForceFulfilled,omitempty"`
 	ForceFulfillmentAttempt *uint64 `json:"ForceFulfillmentAttempt,omitempty"`
@@ -184,6 +236,8 @@ type TxAttempt[
 	Receipts                []ChainReceipt[TX_HASH, BLOCK_HASH] `json:"-"`
 	TxType                  int
 	IsPurgeAttempt          bool
+	// FeeBumpSpend is recorded in the same transaction as the attempt is saved in, if set
+	FeeBumpSpend *FeeBumpSpend `json:"-"`
 }
 
 func (a *TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) String() string {
@@ -222,6 +276,8 @@ type Tx[
 	Meta    *sqlutil.JSON
 	Subject uuid.NullUUID
 	ChainID CHAIN_ID
+	// Priority is the priority class used to order unstarted txes for broadcast
+	Priority TxPriority
 
 	PipelineTaskRunID uuid.NullUUID
 	MinConfirmations  clnull.Uint32
@@ -67,6 +67,7 @@ type TransactionStore[
 	CountUnconfirmedTransactions(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID) (count uint32, err error)
 	CountTransactionsByState(ctx context.Context, state TxState, chainID CHAIN_ID) (count uint32, err error)
 	CountUnstartedTransactions(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID) (count uint32, err error)
+	CountUnstartedTransactionsByPriority(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID) (counts map[TxPriority]uint32, err error)
 	CreateTransaction(ctx context.Context, txRequest TxRequest[ADDR, TX_HASH], chainID CHAIN_ID) (tx Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	DeleteInProgressAttempt(ctx context.Context, attempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error
 	FindLatestSequence(ctx context.Context, fromAddress ADDR, chainId CHAIN_ID) (SEQ, error)
@@ -115,6 +116,8 @@ type TxHistoryReaper[CHAIN_ID types.ID] interface {
 
 type UnstartedTxQueuePruner interface {
 	PruneUnstartedTxQueue(ctx context.Context, queueSize uint32, subject uuid.UUID) (ids []int64, err error)
+	// PruneUnstartedTxQueueByPriority prunes the oldest unstarted txes of the given subject and priority class
+	PruneUnstartedTxQueueByPriority(ctx context.Context, queueSize uint32, subject uuid.UUID, priority TxPriority) (ids []int64, err error)
 }
 
 // R is the raw unparsed transaction receipt
@@ -48,3 +48,26 @@ func TestTxAttemptState(t *testing.T) {
 		}
 	})
 }
+
+func TestTxPriority(t *testing.T) {
+	for p, str := range txPriorityStrings {
+		t.Run(str, func(t *testing.T) {
+			assert.Equal(t, str, p.String())
+			parsed, err := NewTxPriority(str)
+			assert.NoError(t, err)
+			assert.Equal(t, p, parsed)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		assert.Equal(t, "TxPriority(42)", TxPriority(42).String())
+		_, err := NewTxPriority("urgent")
+		assert.EqualError(t, err, `invalid tx priority: "urgent"`)
+	})
+
+	t.Run("zero value is normal", func(t *testing.T) {
+		var p TxPriority
+		assert.Equal(t, TxPriorityNormal, p)
+		assert.True(t, TxPriorityLow < TxPriorityNormal && TxPriorityNormal < TxPriorityHigh && TxPriorityHigh < TxPriorityCritical)
+	})
+}
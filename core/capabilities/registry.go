@@ -3,9 +3,12 @@ package capabilities
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	coretypes "github.com/smartcontractkit/chainlink-common/pkg/types/core"
+
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
@@ -15,8 +18,21 @@ type Registry struct {
 	lggr logger.Logger
 	m    map[string]capabilities.BaseCapability
 	mu   sync.RWMutex
+
+	// workflows maps capability IDs to the IDs of the workflows registered to them
+	workflows map[string]map[string]struct{}
 }
 
+// WorkflowTracker is implemented by registries which keep track of the
+// workflows registered to each capability.
+type WorkflowTracker interface {
+	RegisterWorkflow(capabilityID, workflowID string)
+	UnregisterWorkflow(capabilityID, workflowID string)
+	Workflows(capabilityID string) []string
+}
+
+var _ WorkflowTracker = (*Registry)(nil)
+
 // Get gets a capability from the registry.
 func (r *Registry) Get(_ context.Context, id string) (capabilities.BaseCapability, error) {
 	r.mu.RLock()
@@ -149,10 +165,81 @@ func (r *Registry) Add(ctx context.Context, c capabilities.BaseCapability) error
 	return nil
 }
 
+// RegisterWorkflow records that the workflow registered to the capability.
+func (r *Registry) RegisterWorkflow(capabilityID, workflowID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.workflows[capabilityID] == nil {
+		r.workflows[capabilityID] = map[string]struct{}{}
+	}
+	r.workflows[capabilityID][workflowID] = struct{}{}
+}
+
+// UnregisterWorkflow records that the workflow unregistered from the capability.
+func (r *Registry) UnregisterWorkflow(capabilityID, workflowID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.workflows[capabilityID], workflowID)
+	if len(r.workflows[capabilityID]) == 0 {
+		delete(r.workflows, capabilityID)
+	}
+}
+
+// Workflows returns the sorted IDs of the workflows registered to the capability.
+func (r *Registry) Workflows(capabilityID string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	workflowIDs := make([]string, 0, len(r.workflows[capabilityID]))
+	for id := range r.workflows[capabilityID] {
+		workflowIDs = append(workflowIDs, id)
+	}
+	sort.Strings(workflowIDs)
+	return workflowIDs
+}
+
+// RegisteredCapability describes a capability of a registry, for operators.
+type RegisteredCapability struct {
+	capabilities.CapabilityInfo
+	// Local is false for capabilities provided by a remote DON
+	Local bool
+	// Workflows is nil if the registry does not track workflows
+	Workflows []string
+}
+
+// Describe returns the capabilities of the registry, sorted by ID.
+func Describe(ctx context.Context, registry coretypes.CapabilitiesRegistry) ([]RegisteredCapability, error) {
+	cl, err := registry.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tracker, tracksWorkflows := registry.(WorkflowTracker)
+
+	described := make([]RegisteredCapability, 0, len(cl))
+	for _, c := range cl {
+		info, err := c.Info(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get capability info: %w", err)
+		}
+		rc := RegisteredCapability{CapabilityInfo: info, Local: info.DON == nil}
+		if tracksWorkflows {
+			rc.Workflows = tracker.Workflows(info.ID)
+		}
+		described = append(described, rc)
+	}
+	sort.Slice(described, func(i, j int) bool {
+		return described[i].ID < described[j].ID
+	})
+	return described, nil
+}
+
 // NewRegistry returns a new Registry.
 func NewRegistry(lggr logger.Logger) *Registry {
 	return &Registry{
-		m:    map[string]capabilities.BaseCapability{},
-		lggr: lggr.Named("CapabilityRegistry"),
+		m:         map[string]capabilities.BaseCapability{},
+		lggr:      lggr.Named("CapabilityRegistry"),
+		workflows: map[string]map[string]struct{}{},
 	}
 }
@@ -184,3 +184,46 @@ func TestRegistry_ChecksExecutionAPIByType(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistry_Describe(t *testing.T) {
+	ctx := testutils.Context(t)
+	r := coreCapabilities.NewRegistry(logger.TestLogger(t))
+
+	local, err := capabilities.NewCapabilityInfo(
+		"capability-2",
+		capabilities.CapabilityTypeAction,
+		"capability-2-description",
+		"v1.0.0",
+	)
+	require.NoError(t, err)
+	require.NoError(t, r.Add(ctx, &mockCapability{CapabilityInfo: local}))
+
+	remote, err := capabilities.NewCapabilityInfo(
+		"capability-1",
+		capabilities.CapabilityTypeTarget,
+		"capability-1-description",
+		"v1.0.0",
+	)
+	require.NoError(t, err)
+	remote.DON = &capabilities.DON{ID: "don-1", F: 1}
+	require.NoError(t, r.Add(ctx, &mockCapability{CapabilityInfo: remote}))
+
+	r.RegisterWorkflow("capability-2", "workflow-b")
+	r.RegisterWorkflow("capability-2", "workflow-a")
+	r.RegisterWorkflow("capability-1", "workflow-a")
+	r.UnregisterWorkflow("capability-1", "workflow-a")
+
+	described, err := coreCapabilities.Describe(ctx, r)
+	require.NoError(t, err)
+	require.Len(t, described, 2)
+
+	assert.Equal(t, "capability-1", described[0].ID)
+	assert.False(t, described[0].Local)
+	assert.Equal(t, "don-1", described[0].DON.ID)
+	assert.Empty(t, described[0].Workflows)
+
+	assert.Equal(t, "capability-2", described[1].ID)
+	assert.Equal(t, capabilities.CapabilityTypeAction, described[1].CapabilityType)
+	assert.True(t, described[1].Local)
+	assert.Equal(t, []string{"workflow-a", "workflow-b"}, described[1].Workflows)
+}
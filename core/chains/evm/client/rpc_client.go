@@ -132,6 +132,9 @@ type rpcClient struct {
 	// this rpcClient. Closing and replacing should be serialized through
 	// stateMu since it can happen on state transitions as well as rpcClient Close.
 	chStopInFlight chan struct{}
+
+	// callObserver is set by the node wrapping this rpcClient, before it is started, to track call latency and errors
+	callObserver func(duration time.Duration, err error)
 }
 
 // NewRPCCLient returns a new *rpcClient as commonclient.RPC
@@ -279,6 +282,26 @@ func (r *rpcClient) logResult(
 			callName,                       // rpc call name
 		).
 		Observe(float64(callDuration))
+	r.observeCall(callDuration, err)
+}
+
+// SetCallObserver registers a function to be notified of the duration and outcome of every call. Not thread-safe, must
+// be called before the rpcClient is used.
+func (r *rpcClient) SetCallObserver(observe func(duration time.Duration, err error)) {
+	r.callObserver = observe
+}
+
+// observeCall notifies the callObserver, if any. JSON-RPC error responses (e.g. execution reverted) are reported as
+// successful calls, as they show the RPC is responsive. Calls cancelled by the caller are not reported.
+func (r *rpcClient) observeCall(callDuration time.Duration, err error) {
+	if r.callObserver == nil || pkgerrors.Is(err, context.Canceled) {
+		return
+	}
+	var jsonErr rpc.Error
+	if pkgerrors.As(err, &jsonErr) {
+		err = nil
+	}
+	r.callObserver(callDuration, err)
 }
 
 func (r *rpcClient) getRPCDomain() string {
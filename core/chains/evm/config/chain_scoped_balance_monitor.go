@@ -1,6 +1,9 @@
 package config
 
-import "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+)
 
 type balanceMonitorConfig struct {
 	c toml.BalanceMonitor
@@ -9,3 +12,11 @@ type balanceMonitorConfig struct {
 func (b *balanceMonitorConfig) Enabled() bool {
 	return *b.c.Enabled
 }
+
+func (b *balanceMonitorConfig) MinBalance() *assets.Wei {
+	return b.c.MinBalance
+}
+
+func (b *balanceMonitorConfig) PauseBelowMinBalance() bool {
+	return *b.c.PauseBelowMinBalance
+}
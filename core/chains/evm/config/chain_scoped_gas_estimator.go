@@ -36,6 +36,10 @@ func (g *gasEstimatorConfig) BlockHistory() BlockHistory {
 	return &blockHistoryConfig{c: g.c.BlockHistory, blockDelay: g.blockDelay, bumpThreshold: g.c.BumpThreshold}
 }
 
+func (g *gasEstimatorConfig) FeeHistory() FeeHistory {
+	return &feeHistoryConfig{c: g.c.FeeHistory}
+}
+
 func (g *gasEstimatorConfig) EIP1559DynamicFees() bool {
 	return *g.c.EIP1559DynamicFees
 }
@@ -172,3 +176,15 @@ func (b *blockHistoryConfig) TransactionPercentile() uint16 {
 func (b *blockHistoryConfig) BlockDelay() uint16 {
 	return *b.blockDelay
 }
+
+type feeHistoryConfig struct {
+	c toml.FeeHistoryEstimator
+}
+
+func (f *feeHistoryConfig) BlockWindow() uint16 {
+	return *f.c.BlockWindow
+}
+
+func (f *feeHistoryConfig) RewardPercentile() uint16 {
+	return *f.c.RewardPercentile
+}
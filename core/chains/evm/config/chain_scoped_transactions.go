@@ -4,6 +4,7 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 )
 
@@ -39,6 +40,10 @@ func (t *transactionsConfig) AutoPurge() AutoPurgeConfig {
 	return &autoPurgeConfig{c: t.c.AutoPurge}
 }
 
+func (t *transactionsConfig) FeeBumpBudget() FeeBumpBudgetConfig {
+	return &feeBumpBudgetConfig{c: t.c.FeeBumpBudget}
+}
+
 type autoPurgeConfig struct {
 	c toml.AutoPurgeConfig
 }
@@ -58,3 +63,19 @@ func (a *autoPurgeConfig) MinAttempts() uint32 {
 func (a *autoPurgeConfig) DetectionApiUrl() *url.URL {
 	return a.c.DetectionApiUrl.URL()
 }
+
+type feeBumpBudgetConfig struct {
+	c toml.FeeBumpBudgetConfig
+}
+
+func (b *feeBumpBudgetConfig) Enabled() bool {
+	return *b.c.Enabled
+}
+
+func (b *feeBumpBudgetConfig) Window() time.Duration {
+	return b.c.Window.Duration()
+}
+
+func (b *feeBumpBudgetConfig) MaxPerKey() *assets.Wei {
+	return b.c.MaxPerKey
+}
@@ -77,6 +77,8 @@ type HeadTracker interface {
 
 type BalanceMonitor interface {
 	Enabled() bool
+	MinBalance() *assets.Wei
+	PauseBelowMinBalance() bool
 }
 
 type ClientErrors interface {
@@ -104,6 +106,7 @@ type Transactions interface {
 	MaxInFlight() uint32
 	MaxQueued() uint64
 	AutoPurge() AutoPurgeConfig
+	FeeBumpBudget() FeeBumpBudgetConfig
 }
 
 type AutoPurgeConfig interface {
@@ -113,9 +116,16 @@ type AutoPurgeConfig interface {
 	DetectionApiUrl() *url.URL
 }
 
+type FeeBumpBudgetConfig interface {
+	Enabled() bool
+	Window() time.Duration
+	MaxPerKey() *assets.Wei
+}
+
 //go:generate mockery --quiet --name GasEstimator --output ./mocks/ --case=underscore
 type GasEstimator interface {
 	BlockHistory() BlockHistory
+	FeeHistory() FeeHistory
 	LimitJobType() LimitJobType
 
 	EIP1559DynamicFees() bool
@@ -156,6 +166,11 @@ type BlockHistory interface {
 	TransactionPercentile() uint16
 }
 
+type FeeHistory interface {
+	BlockWindow() uint16
+	RewardPercentile() uint16
+}
+
 type Workflow interface {
 	FromAddress() *types.EIP55Address
 	ForwarderAddress() *types.EIP55Address
@@ -221,6 +221,15 @@ func TestChainScopedConfig_BlockHistory(t *testing.T) {
 	assert.Equal(t, uint16(4), bh.EIP1559FeeCapBufferBlocks())
 }
 
+func TestChainScopedConfig_FeeHistory(t *testing.T) {
+	t.Parallel()
+	cfg := testutils.NewTestChainScopedConfig(t, nil)
+
+	fh := cfg.EVM().GasEstimator().FeeHistory()
+	assert.Equal(t, uint16(20), fh.BlockWindow())
+	assert.Equal(t, uint16(60), fh.RewardPercentile())
+}
+
 func TestChainScopedConfig_GasEstimator(t *testing.T) {
 	t.Parallel()
 	cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
@@ -318,6 +327,16 @@ func TestChainScopedConfig_HeadTracker(t *testing.T) {
 	assert.Equal(t, uint32(10000), ht.MaxAllowedFinalityDepth())
 }
 
+func TestChainScopedConfig_BalanceMonitor(t *testing.T) {
+	t.Parallel()
+	cfg := testutils.NewTestChainScopedConfig(t, nil)
+
+	bm := cfg.EVM().BalanceMonitor()
+	assert.True(t, bm.Enabled())
+	assert.True(t, bm.MinBalance().IsZero())
+	assert.False(t, bm.PauseBelowMinBalance())
+}
+
 func TestNodePoolConfig(t *testing.T) {
 	cfg := testutils.NewTestChainScopedConfig(t, nil)
 
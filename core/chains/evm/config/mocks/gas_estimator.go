@@ -148,6 +148,26 @@ func (_m *GasEstimator) FeeCapDefault() *assets.Wei {
 	return r0
 }
 
+// FeeHistory provides a mock function with given fields:
+func (_m *GasEstimator) FeeHistory() config.FeeHistory {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeeHistory")
+	}
+
+	var r0 config.FeeHistory
+	if rf, ok := ret.Get(0).(func() config.FeeHistory); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.FeeHistory)
+		}
+	}
+
+	return r0
+}
+
 // LimitDefault provides a mock function with given fields:
 func (_m *GasEstimator) LimitDefault() uint64 {
 	ret := _m.Called()
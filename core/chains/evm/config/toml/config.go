@@ -442,7 +442,8 @@ type Transactions struct {
 	ReaperThreshold      *commonconfig.Duration
 	ResendAfterThreshold *commonconfig.Duration
 
-	AutoPurge AutoPurgeConfig `toml:",omitempty"`
+	AutoPurge     AutoPurgeConfig     `toml:",omitempty"`
+	FeeBumpBudget FeeBumpBudgetConfig `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -465,6 +466,7 @@ func (t *Transactions) setFrom(f *Transactions) {
 		t.ResendAfterThreshold = v
 	}
 	t.AutoPurge.setFrom(&f.AutoPurge)
+	t.FeeBumpBudget.setFrom(&f.FeeBumpBudget)
 }
 
 type AutoPurgeConfig struct {
@@ -489,6 +491,34 @@ func (a *AutoPurgeConfig) setFrom(f *AutoPurgeConfig) {
 	}
 }
 
+type FeeBumpBudgetConfig struct {
+	Enabled   *bool
+	Window    *commonconfig.Duration
+	MaxPerKey *assets.Wei
+}
+
+func (b *FeeBumpBudgetConfig) setFrom(f *FeeBumpBudgetConfig) {
+	if v := f.Enabled; v != nil {
+		b.Enabled = v
+	}
+	if v := f.Window; v != nil {
+		b.Window = v
+	}
+	if v := f.MaxPerKey; v != nil {
+		b.MaxPerKey = v
+	}
+}
+
+func (b *FeeBumpBudgetConfig) ValidateConfig() (err error) {
+	if b.Enabled == nil || !*b.Enabled {
+		return
+	}
+	if b.Window == nil || b.Window.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Window", Value: b.Window, Msg: "must be greater than zero"})
+	}
+	return
+}
+
 type OCR2 struct {
 	Automation Automation `toml:",omitempty"`
 }
@@ -522,13 +552,21 @@ func (m *Workflow) setFrom(f *Workflow) {
 }
 
 type BalanceMonitor struct {
-	Enabled *bool
+	Enabled              *bool
+	MinBalance           *assets.Wei
+	PauseBelowMinBalance *bool
 }
 
 func (m *BalanceMonitor) setFrom(f *BalanceMonitor) {
 	if v := f.Enabled; v != nil {
 		m.Enabled = v
 	}
+	if v := f.MinBalance; v != nil {
+		m.MinBalance = v
+	}
+	if v := f.PauseBelowMinBalance; v != nil {
+		m.PauseBelowMinBalance = v
+	}
 }
 
 type GasEstimator struct {
@@ -556,6 +594,7 @@ type GasEstimator struct {
 	TipCapMin     *assets.Wei
 
 	BlockHistory BlockHistoryEstimator `toml:",omitempty"`
+	FeeHistory   FeeHistoryEstimator   `toml:",omitempty"`
 }
 
 func (e *GasEstimator) ValidateConfig() (err error) {
@@ -592,6 +631,16 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
 			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
 	}
+	if *e.Mode == "FeeHistory" {
+		if *e.FeeHistory.BlockWindow < 1 || *e.FeeHistory.BlockWindow > maxFeeHistoryBlockWindow {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "FeeHistory.BlockWindow", Value: *e.FeeHistory.BlockWindow,
+				Msg: fmt.Sprintf("must be between 1 and %d with FeeHistory Mode", maxFeeHistoryBlockWindow)})
+		}
+		if *e.FeeHistory.RewardPercentile > 100 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "FeeHistory.RewardPercentile", Value: *e.FeeHistory.RewardPercentile,
+				Msg: "must be less than or equal to 100"})
+		}
+	}
 
 	return
 }
@@ -647,6 +696,7 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	}
 	e.LimitJobType.setFrom(&f.LimitJobType)
 	e.BlockHistory.setFrom(&f.BlockHistory)
+	e.FeeHistory.setFrom(&f.FeeHistory)
 }
 
 type GasLimitJobType struct {
@@ -709,6 +759,23 @@ func (e *BlockHistoryEstimator) setFrom(f *BlockHistoryEstimator) {
 	}
 }
 
+// maxFeeHistoryBlockWindow is the maximum block count accepted by geth's eth_feeHistory.
+const maxFeeHistoryBlockWindow = 1024
+
+type FeeHistoryEstimator struct {
+	BlockWindow      *uint16
+	RewardPercentile *uint16
+}
+
+func (e *FeeHistoryEstimator) setFrom(f *FeeHistoryEstimator) {
+	if v := f.BlockWindow; v != nil {
+		e.BlockWindow = v
+	}
+	if v := f.RewardPercentile; v != nil {
+		e.RewardPercentile = v
+	}
+}
+
 type KeySpecificConfig []KeySpecific
 
 func (ks KeySpecificConfig) ValidateConfig() (err error) {
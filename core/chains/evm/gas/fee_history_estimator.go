@@ -0,0 +1,328 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+var (
+	_ EvmEstimator = &FeeHistoryEstimator{}
+)
+
+type feeHistoryConfig interface {
+	BlockWindow() uint16
+	RewardPercentile() uint16
+}
+
+type feeHistoryEstimatorClient interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// feeHistoryResult is the response of eth_feeHistory.
+// BaseFee contains one more element than the other fields: the projected base fee of the next block.
+type feeHistoryResult struct {
+	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
+	Reward       [][]*hexutil.Big `json:"reward"`
+	BaseFee      []*hexutil.Big   `json:"baseFeePerGas"`
+	GasUsedRatio []float64        `json:"gasUsedRatio"`
+}
+
+// FeeHistoryEstimator is an Estimator which uses eth_feeHistory to price transactions.
+//
+// The tip cap is the configured percentile of the priority fees paid in each of the last BlockWindow blocks,
+// and the base fee is the one projected by the RPC node for the next block. Unlike BlockHistoryEstimator, it
+// does not need to download full blocks.
+type FeeHistoryEstimator struct {
+	services.StateMachine
+
+	eConfig    estimatorGasEstimatorConfig
+	fhConfig   feeHistoryConfig
+	bhConfig   fixedPriceEstimatorBlockHistoryConfig
+	client     feeHistoryEstimatorClient
+	pollPeriod time.Duration
+	logger     logger.SugaredLogger
+
+	pricesMu    sync.RWMutex
+	tipCap      *assets.Wei
+	nextBaseFee *assets.Wei
+
+	chForceRefetch chan (chan struct{})
+	chInitialised  chan struct{}
+	chStop         services.StopChan
+	chDone         chan struct{}
+
+	l1Oracle rollups.L1Oracle
+}
+
+// NewFeeHistoryEstimator returns a new Estimator which uses eth_feeHistory percentiles.
+func NewFeeHistoryEstimator(lggr logger.Logger, client feeEstimatorClient, eCfg estimatorGasEstimatorConfig, fhCfg feeHistoryConfig, bhCfg fixedPriceEstimatorBlockHistoryConfig, l1Oracle rollups.L1Oracle) EvmEstimator {
+	return &FeeHistoryEstimator{
+		eConfig:        eCfg,
+		fhConfig:       fhCfg,
+		bhConfig:       bhCfg,
+		client:         client,
+		pollPeriod:     10 * time.Second,
+		logger:         logger.Sugared(logger.Named(lggr, "FeeHistoryEstimator")),
+		chForceRefetch: make(chan (chan struct{})),
+		chInitialised:  make(chan struct{}),
+		chStop:         make(chan struct{}),
+		chDone:         make(chan struct{}),
+		l1Oracle:       l1Oracle,
+	}
+}
+
+func (f *FeeHistoryEstimator) Name() string {
+	return f.logger.Name()
+}
+
+func (f *FeeHistoryEstimator) L1Oracle() rollups.L1Oracle {
+	return f.l1Oracle
+}
+
+func (f *FeeHistoryEstimator) Start(context.Context) error {
+	return f.StartOnce("FeeHistoryEstimator", func() error {
+		go f.run()
+		<-f.chInitialised
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) Close() error {
+	return f.StopOnce("FeeHistoryEstimator", func() error {
+		close(f.chStop)
+		<-f.chDone
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) HealthReport() map[string]error {
+	return map[string]error{f.Name(): f.Healthy()}
+}
+
+func (f *FeeHistoryEstimator) run() {
+	defer close(f.chDone)
+
+	t := f.refreshPrices()
+	close(f.chInitialised)
+
+	for {
+		select {
+		case <-f.chStop:
+			return
+		case ch := <-f.chForceRefetch:
+			t.Stop()
+			t = f.refreshPrices()
+			close(ch)
+		case <-t.C:
+			t = f.refreshPrices()
+		}
+	}
+}
+
+func (f *FeeHistoryEstimator) refreshPrices() (t *time.Timer) {
+	t = time.NewTimer(utils.WithJitter(f.pollPeriod))
+
+	ctx, cancel := f.chStop.CtxCancel(evmclient.ContextWithDefaultTimeout())
+	defer cancel()
+
+	percentile := f.fhConfig.RewardPercentile()
+	var res feeHistoryResult
+	if err := f.client.CallContext(ctx, &res, "eth_feeHistory", hexutil.Uint64(f.fhConfig.BlockWindow()), "latest", []float64{float64(percentile)}); err != nil {
+		f.logger.Warnf("Failed to refresh prices, got error: %s", err)
+		return
+	}
+
+	tipCap, nextBaseFee, err := calculateFeeHistoryPrices(res, int(percentile))
+	if err != nil {
+		f.logger.Warnw("Failed to calculate prices from fee history", "err", err)
+		return
+	}
+
+	f.logger.Debugw("refreshPrices", "tipCap", tipCap, "nextBaseFee", nextBaseFee, "oldestBlock", res.OldestBlock, "blocks", len(res.GasUsedRatio))
+
+	f.pricesMu.Lock()
+	defer f.pricesMu.Unlock()
+	f.tipCap = tipCap
+	f.nextBaseFee = nextBaseFee
+	return
+}
+
+// calculateFeeHistoryPrices returns the percentile of the per-block rewards, ignoring empty blocks, and the
+// projected base fee of the next block. tipCap is nil if none of the blocks contained transactions.
+func calculateFeeHistoryPrices(res feeHistoryResult, percentile int) (tipCap *assets.Wei, nextBaseFee *assets.Wei, err error) {
+	if len(res.BaseFee) == 0 {
+		return nil, nil, pkgerrors.New("fee history contains no base fees")
+	}
+	if last := res.BaseFee[len(res.BaseFee)-1]; last != nil {
+		nextBaseFee = assets.NewWei(last.ToInt())
+	} else {
+		nextBaseFee = assets.NewWeiI(0)
+	}
+
+	var rewards []*big.Int
+	for i, r := range res.Reward {
+		if i < len(res.GasUsedRatio) && res.GasUsedRatio[i] == 0 {
+			// empty blocks always report zero rewards
+			continue
+		}
+		if len(r) == 0 || r[0] == nil {
+			continue
+		}
+		rewards = append(rewards, r[0].ToInt())
+	}
+	if len(rewards) == 0 {
+		return nil, nextBaseFee, nil
+	}
+	sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+	idx := ((len(rewards) - 1) * percentile) / 100
+	return assets.NewWei(rewards[idx]), nextBaseFee, nil
+}
+
+// Uses the force refetch chan to trigger a price update and blocks until complete
+func (f *FeeHistoryEstimator) forceRefresh(ctx context.Context) (err error) {
+	ch := make(chan struct{})
+	select {
+	case f.chForceRefetch <- ch:
+	case <-f.chStop:
+		return pkgerrors.New("estimator stopped")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-ch:
+	case <-f.chStop:
+		return pkgerrors.New("estimator stopped")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return
+}
+
+func (f *FeeHistoryEstimator) OnNewLongestChain(context.Context, *evmtypes.Head) {}
+
+// getTipCap returns the estimated tip cap, falling back to TipCapDefault when the recent blocks were empty,
+// and never going below TipCapMin.
+func (f *FeeHistoryEstimator) getTipCap() *assets.Wei {
+	tipCap := f.getEstimatedTipCap()
+	if tipCap == nil {
+		tipCap = f.eConfig.TipCapDefault()
+	}
+	return assets.WeiMax(tipCap, f.eConfig.TipCapMin())
+}
+
+func (f *FeeHistoryEstimator) getEstimatedTipCap() *assets.Wei {
+	f.pricesMu.RLock()
+	defer f.pricesMu.RUnlock()
+	return f.tipCap
+}
+
+func (f *FeeHistoryEstimator) getNextBaseFee() *assets.Wei {
+	f.pricesMu.RLock()
+	defer f.pricesMu.RUnlock()
+	return f.nextBaseFee
+}
+
+// getGasPrice returns the legacy gas price, which is the projected base fee plus the tip cap.
+func (f *FeeHistoryEstimator) getGasPrice() *assets.Wei {
+	baseFee := f.getNextBaseFee()
+	if baseFee == nil {
+		return nil
+	}
+	return assets.WeiMax(baseFee.Add(f.getTipCap()), f.eConfig.PriceMin())
+}
+
+func (f *FeeHistoryEstimator) GetLegacyGas(ctx context.Context, _ []byte, gasLimit uint64, maxGasPriceWei *assets.Wei, opts ...feetypes.Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint64, err error) {
+	chainSpecificGasLimit = gasLimit
+	ok := f.IfStarted(func() {
+		if slices.Contains(opts, feetypes.OptForceRefetch) {
+			err = f.forceRefresh(ctx)
+		}
+		if gasPrice = f.getGasPrice(); gasPrice == nil {
+			err = pkgerrors.New("failed to estimate gas; gas price not set")
+			return
+		}
+		f.logger.Debugw("GetLegacyGas", "GasPrice", gasPrice, "GasLimit", gasLimit)
+	})
+	if !ok {
+		return nil, 0, pkgerrors.New("estimator is not started")
+	} else if err != nil {
+		return
+	}
+	gasPrice = capGasPrice(gasPrice, maxGasPriceWei, f.eConfig.PriceMax())
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpLegacyGas(ctx context.Context, originalGasPrice *assets.Wei, gasLimit uint64, maxGasPriceWei *assets.Wei, _ []EvmPriorAttempt) (bumpedGasPrice *assets.Wei, chainSpecificGasLimit uint64, err error) {
+	chainSpecificGasLimit = gasLimit
+	ok := f.IfStarted(func() {
+		if rerr := f.forceRefresh(ctx); rerr != nil {
+			f.logger.Warnw("Failed to refresh prices before bumping, using the last known prices", "err", rerr)
+		}
+		bumpedGasPrice, err = BumpLegacyGasPriceOnly(f.eConfig, f.logger, f.getGasPrice(), originalGasPrice, maxGasPriceWei)
+	})
+	if !ok {
+		return nil, 0, pkgerrors.New("estimator is not started")
+	}
+	return
+}
+
+func (f *FeeHistoryEstimator) GetDynamicFee(_ context.Context, maxGasPriceWei *assets.Wei) (fee DynamicFee, err error) {
+	if !f.eConfig.EIP1559DynamicFees() {
+		return fee, pkgerrors.New("Can't get dynamic fee, EIP1559 is disabled")
+	}
+
+	ok := f.IfStarted(func() {
+		baseFee := f.getNextBaseFee()
+		if baseFee == nil {
+			err = pkgerrors.New("FeeHistoryEstimator: no value for next block base fee; cannot estimate EIP-1559 fees")
+			return
+		}
+		fee.TipCap = f.getTipCap()
+		maxGasPrice := getMaxGasPrice(maxGasPriceWei, f.eConfig.PriceMax())
+		if f.eConfig.BumpThreshold() == 0 {
+			// just use the max gas price if gas bumping is disabled
+			fee.FeeCap = maxGasPrice
+		} else {
+			// leave headroom for base fee increases until the transaction is bumped
+			fee.FeeCap = calcFeeCap(baseFee, int(f.bhConfig.EIP1559FeeCapBufferBlocks()), fee.TipCap, maxGasPrice)
+		}
+		if fee.TipCap.Cmp(fee.FeeCap) > 0 {
+			err = fmt.Errorf("estimated tip cap of %s is greater than the maximum gas price of %s", fee.TipCap, maxGasPrice)
+		}
+	})
+	if !ok {
+		return fee, pkgerrors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpDynamicFee(ctx context.Context, originalFee DynamicFee, maxGasPriceWei *assets.Wei, _ []EvmPriorAttempt) (bumped DynamicFee, err error) {
+	ok := f.IfStarted(func() {
+		if rerr := f.forceRefresh(ctx); rerr != nil {
+			f.logger.Warnw("Failed to refresh prices before bumping, using the last known prices", "err", rerr)
+		}
+		bumped, err = BumpDynamicFeeOnly(f.eConfig, f.bhConfig.EIP1559FeeCapBufferBlocks(), f.logger, f.getTipCap(), f.getNextBaseFee(), originalFee, maxGasPriceWei)
+	})
+	if !ok {
+		return bumped, pkgerrors.New("FeeHistoryEstimator is not started; cannot bump gas")
+	}
+	return
+}
@@ -0,0 +1,206 @@
+package gas_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+	rollupMocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups/mocks"
+)
+
+// feeHistoryResponse has rewards of 1, 2 and 3 wei for three blocks followed by an empty block,
+// and a projected base fee of 12 wei for the next block.
+const feeHistoryResponse = `{
+	"oldestBlock": "0x10",
+	"reward": [["0x1"], ["0x3"], ["0x2"], ["0x0"]],
+	"baseFeePerGas": ["0xa", "0xa", "0xa", "0xa", "0xc"],
+	"gasUsedRatio": [0.5, 0.6, 0.4, 0]
+}`
+
+func mockFeeHistory(client *mocks.FeeEstimatorClient, response string) *mock.Call {
+	return client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", hexutil.Uint64(4), "latest", []float64{50}).Return(nil).Run(func(args mock.Arguments) {
+		if err := json.Unmarshal([]byte(response), args.Get(1)); err != nil {
+			panic(err)
+		}
+	})
+}
+
+func TestFeeHistoryEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(100)
+	calldata := []byte{0x00, 0x00, 0x01, 0x02, 0x03}
+	const gasLimit uint64 = 80000
+
+	fhCfg := &gas.MockFeeHistoryConfig{BlockWindowF: 4, RewardPercentileF: 50}
+	bhCfg := &gas.MockBlockHistoryConfig{EIP1559FeeCapBufferBlocksF: 0}
+	newCfg := func() *gas.MockGasEstimatorConfig {
+		return &gas.MockGasEstimatorConfig{
+			EIP1559DynamicFeesF: true,
+			BumpPercentF:        10,
+			BumpMinF:            assets.NewWeiI(1),
+			BumpThresholdF:      1,
+			TipCapDefaultF:      assets.NewWeiI(5),
+			TipCapMinF:          assets.NewWeiI(0),
+			PriceMinF:           assets.NewWeiI(0),
+			PriceMaxF:           maxGasPrice,
+		}
+	}
+
+	t.Run("calling GetLegacyGas on unstarted estimator returns error", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), fhCfg, bhCfg, rollupMocks.NewL1Oracle(t))
+		_, _, err := o.GetLegacyGas(tests.Context(t), calldata, gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "estimator is not started")
+	})
+
+	t.Run("GetLegacyGas returns the projected base fee plus the percentile tip", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockFeeHistory(client, feeHistoryResponse)
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), fhCfg, bhCfg, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+		gasPrice, chainSpecificGasLimit, err := o.GetLegacyGas(tests.Context(t), calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(14), gasPrice)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+
+		// capped by the user specified max
+		gasPrice, _, err = o.GetLegacyGas(tests.Context(t), calldata, gasLimit, assets.NewWeiI(13))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(13), gasPrice)
+	})
+
+	t.Run("GetDynamicFee returns the percentile tip and buffered fee cap", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockFeeHistory(client, feeHistoryResponse)
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), fhCfg, bhCfg, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+		fee, err := o.GetDynamicFee(tests.Context(t), maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(2), fee.TipCap)
+		assert.Equal(t, assets.NewWeiI(14), fee.FeeCap)
+	})
+
+	t.Run("GetDynamicFee uses the max gas price as fee cap when bumping is disabled", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockFeeHistory(client, feeHistoryResponse)
+		cfg := newCfg()
+		cfg.BumpThresholdF = 0
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, fhCfg, bhCfg, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+		fee, err := o.GetDynamicFee(tests.Context(t), maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(2), fee.TipCap)
+		assert.Equal(t, maxGasPrice, fee.FeeCap)
+	})
+
+	t.Run("GetDynamicFee errors when EIP1559 is disabled", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		cfg := newCfg()
+		cfg.EIP1559DynamicFeesF = false
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, fhCfg, bhCfg, rollupMocks.NewL1Oracle(t))
+		_, err := o.GetDynamicFee(tests.Context(t), maxGasPrice)
+		assert.EqualError(t, err, "Can't get dynamic fee, EIP1559 is disabled")
+	})
+
+	t.Run("falls back to TipCapDefault when all blocks are empty", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockFeeHistory(client, `{
+			"oldestBlock": "0x10",
+			"reward": [["0x0"], ["0x0"], ["0x0"], ["0x0"]],
+			"baseFeePerGas": ["0xa", "0xa", "0xa", "0xa", "0xa"],
+			"gasUsedRatio": [0, 0, 0, 0]
+		}`)
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), fhCfg, bhCfg, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+		fee, err := o.GetDynamicFee(tests.Context(t), maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(5), fee.TipCap)
+		assert.Equal(t, assets.NewWeiI(15), fee.FeeCap)
+	})
+
+	t.Run("returns error if the fee history has never been fetched", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", mock.Anything, mock.Anything, mock.Anything).Return(pkgerrors.New("kaboom"))
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), fhCfg, bhCfg, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+		_, _, err := o.GetLegacyGas(tests.Context(t), calldata, gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "failed to estimate gas; gas price not set")
+		_, err = o.GetDynamicFee(tests.Context(t), maxGasPrice)
+		assert.EqualError(t, err, "FeeHistoryEstimator: no value for next block base fee; cannot estimate EIP-1559 fees")
+	})
+
+	t.Run("BumpLegacyGas refreshes the price and bumps by at least BumpPercent", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockFeeHistory(client, feeHistoryResponse)
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), fhCfg, bhCfg, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+
+		// current price is higher than the bumped original price
+		gasPrice, _, err := o.BumpLegacyGas(tests.Context(t), assets.NewWeiI(10), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(14), gasPrice)
+
+		// bumped original price is higher than the current price
+		gasPrice, _, err = o.BumpLegacyGas(tests.Context(t), assets.NewWeiI(20), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(22), gasPrice)
+	})
+
+	t.Run("BumpDynamicFee bumps the tip cap and fee cap", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockFeeHistory(client, feeHistoryResponse)
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), fhCfg, bhCfg, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+
+		bumped, err := o.BumpDynamicFee(tests.Context(t), gas.DynamicFee{TipCap: assets.NewWeiI(10), FeeCap: assets.NewWeiI(40)}, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(11), bumped.TipCap)
+		assert.Equal(t, assets.NewWeiI(44), bumped.FeeCap)
+	})
+}
+
+func TestFeeHistoryEstimator_CalculatePrices(t *testing.T) {
+	t.Parallel()
+
+	hb := func(i int64) *hexutil.Big { return (*hexutil.Big)(big.NewInt(i)) }
+
+	t.Run("picks the percentile of non-empty blocks", func(t *testing.T) {
+		reward := [][]*hexutil.Big{{hb(4)}, {hb(1)}, {hb(0)}, {hb(3)}, {hb(2)}}
+		baseFee := []*hexutil.Big{hb(1), hb(1), hb(1), hb(1), hb(1), hb(7)}
+		ratio := []float64{1, 1, 0, 1, 1}
+
+		for percentile, expected := range map[int]int64{0: 1, 50: 2, 75: 3, 100: 4} {
+			tipCap, nextBaseFee, err := gas.CalculateFeeHistoryPrices(reward, baseFee, ratio, percentile)
+			require.NoError(t, err)
+			assert.Equal(t, assets.NewWeiI(expected), tipCap, "percentile %d", percentile)
+			assert.Equal(t, assets.NewWeiI(7), nextBaseFee)
+		}
+	})
+
+	t.Run("errors without base fees", func(t *testing.T) {
+		_, _, err := gas.CalculateFeeHistoryPrices(nil, nil, nil, 50)
+		assert.EqualError(t, err, "fee history contains no base fees")
+	})
+}
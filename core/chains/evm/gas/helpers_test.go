@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/common/config"
@@ -202,3 +203,20 @@ func (m *MockGasEstimatorConfig) LimitMax() uint64 {
 func (m *MockGasEstimatorConfig) Mode() string {
 	return m.ModeF
 }
+
+type MockFeeHistoryConfig struct {
+	BlockWindowF      uint16
+	RewardPercentileF uint16
+}
+
+func (m *MockFeeHistoryConfig) BlockWindow() uint16 {
+	return m.BlockWindowF
+}
+
+func (m *MockFeeHistoryConfig) RewardPercentile() uint16 {
+	return m.RewardPercentileF
+}
+
+func CalculateFeeHistoryPrices(reward [][]*hexutil.Big, baseFee []*hexutil.Big, gasUsedRatio []float64, percentile int) (*assets.Wei, *assets.Wei, error) {
+	return calculateFeeHistoryPrices(feeHistoryResult{Reward: reward, BaseFee: baseFee, GasUsedRatio: gasUsedRatio}, percentile)
+}
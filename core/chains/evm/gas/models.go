@@ -62,6 +62,8 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, cfg Config,
 		"blockHistorySize", bh.BlockHistorySize(),
 		"eip1559FeeCapBufferBlocks", bh.EIP1559FeeCapBufferBlocks(),
 		"transactionPercentile", bh.TransactionPercentile(),
+		"feeHistoryBlockWindow", geCfg.FeeHistory().BlockWindow(),
+		"feeHistoryRewardPercentile", geCfg.FeeHistory().RewardPercentile(),
 		"eip1559DynamicFees", geCfg.EIP1559DynamicFees(),
 		"gasBumpPercent", geCfg.BumpPercent(),
 		"gasBumpThreshold", geCfg.BumpThreshold(),
@@ -91,6 +93,10 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, cfg Config,
 		newEstimator = func(l logger.Logger) EvmEstimator {
 			return NewBlockHistoryEstimator(lggr, ethClient, cfg, geCfg, bh, ethClient.ConfiguredChainID(), l1Oracle)
 		}
+	case "FeeHistory":
+		newEstimator = func(l logger.Logger) EvmEstimator {
+			return NewFeeHistoryEstimator(lggr, ethClient, geCfg, geCfg.FeeHistory(), bh, l1Oracle)
+		}
 	case "FixedPrice":
 		newEstimator = func(l logger.Logger) EvmEstimator {
 			return NewFixedPriceEstimator(geCfg, ethClient, bh, lggr, l1Oracle)
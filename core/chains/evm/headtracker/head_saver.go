@@ -3,6 +3,7 @@ package headtracker
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -14,6 +15,9 @@ import (
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
 
+// ReorgHistoryRetention is how long the reorgs detected are kept in the DB
+const ReorgHistoryRetention = 30 * 24 * time.Hour
+
 type headSaver struct {
 	orm      ORM
 	config   commontypes.Config
@@ -87,6 +91,13 @@ func (hs *headSaver) MarkFinalized(ctx context.Context, finalized *evmtypes.Head
 	return hs.orm.TrimOldHeads(ctx, minBlockToKeep)
 }
 
+func (hs *headSaver) SaveReorg(ctx context.Context, reorg httypes.Reorg) error {
+	if err := hs.orm.InsertReorg(ctx, reorg); err != nil {
+		return err
+	}
+	return hs.orm.TrimOldReorgs(ctx, time.Now().Add(-ReorgHistoryRetention))
+}
+
 var NullSaver httypes.HeadSaver = &nullSaver{}
 
 type nullSaver struct{}
@@ -101,3 +112,4 @@ func (*nullSaver) Chain(hash common.Hash) *evmtypes.Head
 func (*nullSaver) MarkFinalized(ctx context.Context, latestFinalized *evmtypes.Head) error {
 	return nil
 }
+func (*nullSaver) SaveReorg(ctx context.Context, reorg httypes.Reorg) error { return nil }
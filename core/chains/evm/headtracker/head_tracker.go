@@ -53,3 +53,6 @@ func (*nullTracker) Backfill(ctx context.Context, headWithChain, latestFinalized
 	return nil
 }
 func (*nullTracker) LatestChain() *evmtypes.Head { return nil }
+func (*nullTracker) SubscribeReorgs(callback httypes.ReorgTrackable) (unsubscribe func()) {
+	return func() {}
+}
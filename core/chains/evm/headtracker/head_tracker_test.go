@@ -804,6 +804,78 @@ func TestHeadTracker_SwitchesToLongestChainWithHeadSamplingDisabled(t *testing.T
 	}
 }
 
+func TestHeadTracker_DetectsReorgs(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	config := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+		c.FinalityDepth = ptr[uint32](50)
+		c.HeadTracker.SamplingInterval = commonconfig.MustNewDuration(0)
+	})
+	orm := headtracker.NewORM(*testutils.FixtureChainID, db)
+
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	chchHeaders := make(chan testutils.RawSub[*evmtypes.Head], 1)
+	mockEth := &testutils.MockEth{EthClient: ethClient}
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).
+		Return(
+			func(ctx context.Context, ch chan<- *evmtypes.Head) ethereum.Subscription {
+				sub := mockEth.NewSub(t)
+				chchHeaders <- testutils.NewRawSub(ch, sub.Err())
+				return sub
+			},
+			func(ctx context.Context, ch chan<- *evmtypes.Head) error { return nil },
+		)
+
+	blocks := NewBlocks(t, 5)
+	// the new chain replaces blocks 2 to 4, and is longer
+	blocksForked := blocks.ForkAt(t, 2, 1)
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(blocks.Head(0), nil)
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(0)).Return(blocks.Head(0), nil)
+
+	reorgAwaiter := testutils.NewAwaiter()
+	reorgTrackable := htmocks.NewReorgTrackable[common.Hash](t)
+	reorgTrackable.On("OnReorg", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			reorg := args.Get(1).(httypes.Reorg)
+			assert.Equal(t, int64(3), reorg.Depth)
+			assert.Equal(t, int64(1), reorg.CommonAncestorNumber)
+			assert.Equal(t, blocks.Head(1).Hash, reorg.CommonAncestorHash)
+			assert.Equal(t, int64(4), reorg.OldHeadNumber)
+			assert.Equal(t, blocks.Head(4).Hash, reorg.OldHeadHash)
+			assert.Equal(t, int64(5), reorg.NewHeadNumber)
+			assert.Equal(t, blocksForked.Head(5).Hash, reorg.NewHeadHash)
+			from, to := reorg.AffectedRange()
+			assert.Equal(t, int64(2), from)
+			assert.Equal(t, int64(4), to)
+			reorgAwaiter.ItHappened()
+		}).Return().Once()
+
+	ht := createHeadTracker(t, ethClient, config.EVM(), config.EVM().HeadTracker(), orm)
+	ht.headTracker.SubscribeReorgs(reorgTrackable)
+	ht.Start(t)
+
+	headers := <-chchHeaders
+	for i := uint64(1); i <= 4; i++ {
+		headers.TrySend(blocks.Head(i))
+		time.Sleep(tests.TestInterval)
+	}
+	for i := uint64(2); i <= 5; i++ {
+		headers.TrySend(blocksForked.Head(i))
+		time.Sleep(tests.TestInterval)
+	}
+
+	reorgAwaiter.AwaitOrFail(t, tests.WaitTimeout(t))
+	ht.Stop(t)
+
+	reorgs, count, err := orm.Reorgs(tests.Context(t), 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	assert.Equal(t, int64(3), reorgs[0].Depth)
+	assert.Equal(t, blocks.Head(1).Hash, reorgs[0].CommonAncestorHash)
+	assert.Equal(t, blocksForked.Head(5).Hash, reorgs[0].NewHeadHash)
+}
+
 func TestHeadTracker_Backfill(t *testing.T) {
 	t.Parallel()
 
@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 )
@@ -25,6 +27,12 @@ type ORM interface {
 	LatestHeads(ctx context.Context, minBlockNumber int64) (heads []*evmtypes.Head, err error)
 	// HeadByHash fetches the head with the given hash from the db, returns nil if none exists
 	HeadByHash(ctx context.Context, hash common.Hash) (head *evmtypes.Head, err error)
+	// InsertReorg records a reorg detected by the head tracker
+	InsertReorg(ctx context.Context, reorg httypes.Reorg) error
+	// Reorgs returns the reorgs recorded, the most recent first, along with their total count
+	Reorgs(ctx context.Context, offset, limit int) (reorgs []httypes.Reorg, count int, err error)
+	// TrimOldReorgs deletes the reorgs detected before the given time
+	TrimOldReorgs(ctx context.Context, before time.Time) error
 }
 
 var _ ORM = &DbORM{}
@@ -82,3 +90,64 @@ func (orm *DbORM) HeadByHash(ctx context.Context, hash common.Hash) (head *evmty
 	}
 	return head, err
 }
+
+// dbReorg is the row of a Reorg in evm.reorgs
+type dbReorg struct {
+	Depth                int64
+	CommonAncestorNumber int64
+	CommonAncestorHash   *common.Hash
+	OldHeadNumber        int64
+	OldHeadHash          common.Hash
+	NewHeadNumber        int64
+	NewHeadHash          common.Hash
+	DetectedAt           time.Time
+}
+
+func (r dbReorg) toReorg() httypes.Reorg {
+	reorg := httypes.Reorg{
+		Depth:                r.Depth,
+		CommonAncestorNumber: r.CommonAncestorNumber,
+		OldHeadNumber:        r.OldHeadNumber,
+		OldHeadHash:          r.OldHeadHash,
+		NewHeadNumber:        r.NewHeadNumber,
+		NewHeadHash:          r.NewHeadHash,
+		DetectedAt:           r.DetectedAt,
+	}
+	if r.CommonAncestorHash != nil {
+		reorg.CommonAncestorHash = *r.CommonAncestorHash
+	}
+	return reorg
+}
+
+func (orm *DbORM) InsertReorg(ctx context.Context, reorg httypes.Reorg) error {
+	var commonAncestorHash *common.Hash
+	if reorg.CommonAncestorHash != (common.Hash{}) {
+		commonAncestorHash = &reorg.CommonAncestorHash
+	}
+	query := `
+	INSERT INTO evm.reorgs (evm_chain_id, depth, common_ancestor_number, common_ancestor_hash, old_head_number, old_head_hash, new_head_number, new_head_hash, detected_at) VALUES (
+	$1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	_, err := orm.ds.ExecContext(ctx, query, orm.chainID, reorg.Depth, reorg.CommonAncestorNumber, commonAncestorHash, reorg.OldHeadNumber, reorg.OldHeadHash, reorg.NewHeadNumber, reorg.NewHeadHash, reorg.DetectedAt)
+	return pkgerrors.Wrap(err, "InsertReorg failed")
+}
+
+func (orm *DbORM) Reorgs(ctx context.Context, offset, limit int) (reorgs []httypes.Reorg, count int, err error) {
+	if err = orm.ds.GetContext(ctx, &count, `SELECT count(*) FROM evm.reorgs WHERE evm_chain_id = $1`, orm.chainID); err != nil {
+		return nil, 0, pkgerrors.Wrap(err, "Reorgs failed to count reorgs")
+	}
+	var rows []dbReorg
+	err = orm.ds.SelectContext(ctx, &rows, `SELECT depth, common_ancestor_number, common_ancestor_hash, old_head_number, old_head_hash, new_head_number, new_head_hash, detected_at
+	FROM evm.reorgs WHERE evm_chain_id = $1 ORDER BY detected_at DESC, id DESC LIMIT $2 OFFSET $3`, orm.chainID, limit, offset)
+	if err != nil {
+		return nil, 0, pkgerrors.Wrap(err, "Reorgs failed")
+	}
+	for _, row := range rows {
+		reorgs = append(reorgs, row.toReorg())
+	}
+	return reorgs, count, nil
+}
+
+func (orm *DbORM) TrimOldReorgs(ctx context.Context, before time.Time) error {
+	_, err := orm.ds.ExecContext(ctx, `DELETE FROM evm.reorgs WHERE evm_chain_id = $1 AND detected_at < $2`, orm.chainID, before)
+	return pkgerrors.Wrap(err, "TrimOldReorgs failed")
+}
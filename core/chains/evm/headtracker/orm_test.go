@@ -1,7 +1,9 @@
 package headtracker_test
 
 import (
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -10,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 )
@@ -110,3 +113,44 @@ func TestORM_LatestHeads_NoRows(t *testing.T) {
 	require.Zero(t, len(heads))
 	require.NoError(t, err)
 }
+
+func TestORM_Reorgs(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	orm := headtracker.NewORM(*testutils.FixtureChainID, db)
+	ctx := tests.Context(t)
+
+	old := httypes.Reorg{Depth: 1, CommonAncestorNumber: 9, CommonAncestorHash: testutils.NewHash(), OldHeadNumber: 10, OldHeadHash: testutils.NewHash(),
+		NewHeadNumber: 11, NewHeadHash: testutils.NewHash(), DetectedAt: time.Now().Add(-time.Hour)}
+	require.NoError(t, orm.InsertReorg(ctx, old))
+	// the common ancestor of a reorg deeper than the known chain is unknown
+	deep := httypes.Reorg{Depth: 5, CommonAncestorNumber: 15, OldHeadNumber: 20, OldHeadHash: testutils.NewHash(),
+		NewHeadNumber: 21, NewHeadHash: testutils.NewHash(), DetectedAt: time.Now()}
+	require.NoError(t, orm.InsertReorg(ctx, deep))
+
+	// other chains' reorgs are not returned
+	otherORM := headtracker.NewORM(*big.NewInt(1337), db)
+	require.NoError(t, otherORM.InsertReorg(ctx, deep))
+
+	reorgs, count, err := orm.Reorgs(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, reorgs, 2)
+	assert.Equal(t, deep.NewHeadHash, reorgs[0].NewHeadHash)
+	assert.Equal(t, common.Hash{}, reorgs[0].CommonAncestorHash)
+	assert.Equal(t, old.CommonAncestorHash, reorgs[1].CommonAncestorHash)
+	assert.Equal(t, old.Depth, reorgs[1].Depth)
+
+	reorgs, count, err = orm.Reorgs(ctx, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, reorgs, 1)
+	assert.Equal(t, old.NewHeadHash, reorgs[0].NewHeadHash)
+
+	require.NoError(t, orm.TrimOldReorgs(ctx, time.Now().Add(-time.Minute)))
+	reorgs, count, err = orm.Reorgs(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, deep.NewHeadHash, reorgs[0].NewHeadHash)
+}
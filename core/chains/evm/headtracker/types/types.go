@@ -22,4 +22,6 @@ type (
 	HeadTrackable   = headtracker.HeadTrackable[*evmtypes.Head, common.Hash]
 	HeadListener    = headtracker.HeadListener[*evmtypes.Head, common.Hash]
 	HeadBroadcaster = headtracker.HeadBroadcaster[*evmtypes.Head, common.Hash]
+	Reorg           = headtracker.Reorg[common.Hash]
+	ReorgTrackable  = headtracker.ReorgTrackable[common.Hash]
 )
@@ -28,6 +28,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 )
@@ -78,6 +79,7 @@ type LogPollerTest interface {
 	Filter(from, to *big.Int, bh *common.Hash) ethereum.FilterQuery
 	GetReplayFromBlock(ctx context.Context, requested int64) (int64, error)
 	PruneOldBlocks(ctx context.Context) (bool, error)
+	BackfillFilters(ctx context.Context)
 }
 
 type Client interface {
@@ -96,6 +98,9 @@ var (
 	ErrFinalityViolated                   = pkgerrors.New("finality violated")
 )
 
+// maxFilterBackfillBatches is the maximum number of batches fetched for a filter backfill in one call to BackfillFilters.
+const maxFilterBackfillBatches = 10
+
 type logPoller struct {
 	services.StateMachine
 	ec                       Client
@@ -117,8 +122,13 @@ type logPoller struct {
 	cachedAddresses []common.Address
 	cachedEventSigs []common.Hash
 
+	filterBackfillUnscheduled bool // guarded by filterMu, set while a registered filter's backfill may lack its last block
+	filterBackfillMu          sync.RWMutex
+	filterBackfillErrs        map[string]error // keyed by filter name, present while the filter's backfill is pending
+
 	replayStart    chan int64
 	replayComplete chan error
+	pollNow        chan struct{} // signals the run loop to poll without waiting for the next tick, e.g. after a reorg
 	stopCh         services.StopChan
 	wg             sync.WaitGroup
 	// This flag is raised whenever the log poller detects that the chain's finality has been violated.
@@ -158,6 +168,7 @@ func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, opts Opts) *logPoller
 		lggr:                     logger.Sugared(logger.Named(lggr, "LogPoller")),
 		replayStart:              make(chan int64),
 		replayComplete:           make(chan error),
+		pollNow:                  make(chan struct{}, 1),
 		pollPeriod:               opts.PollPeriod,
 		backupPollerBlockDelay:   opts.BackupPollerBlockDelay,
 		finalityDepth:            opts.FinalityDepth,
@@ -168,6 +179,7 @@ func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, opts Opts) *logPoller
 		logPrunePageSize:         opts.LogPrunePageSize,
 		filters:                  make(map[string]Filter),
 		filterDirty:              true, // Always build Filter on first call to cache an empty filter if nothing registered yet.
+		filterBackfillErrs:       make(map[string]error),
 		finalityViolated:         new(atomic.Bool),
 	}
 }
@@ -182,6 +194,14 @@ type Filter struct {
 	Retention    time.Duration      // maximum amount of time to retain logs
 	MaxLogsKept  uint64             // maximum number of logs to retain ( 0 = unlimited )
 	LogsPerBlock uint64             // rate limit ( maximum # of logs per block, 0 = unlimited )
+	// StartBlock, if set, is the first block for which historical logs are backfilled when the filter is registered.
+	// Unlike Replay, the backfill only fetches logs matching this filter.
+	StartBlock int64
+}
+
+// query builds a FilterQuery which matches only this filter's addresses and event sigs.
+func (filter *Filter) query(from, to *big.Int, bh *common.Hash) ethereum.FilterQuery {
+	return ethereum.FilterQuery{FromBlock: from, ToBlock: to, BlockHash: bh, Topics: [][]common.Hash{filter.EventSigs}, Addresses: filter.Addresses}
 }
 
 // FilterName is a suggested convenience function for clients to construct unique filter names
@@ -243,6 +263,8 @@ func (filter *Filter) Contains(other *Filter) bool {
 // which means that anonymous events are not supported and log.Topics >= 1 always (log.Topics[0] is the event signature).
 // The filter may be unregistered later by Filter.Name
 // Warnings/debug information is keyed by filter name.
+// If filter.StartBlock is set, historical logs matching this filter from StartBlock up to the last block polled without
+// it are backfilled by the poll loop, without affecting any other filter. See BackfillFilters.
 func (lp *logPoller) RegisterFilter(ctx context.Context, filter Filter) error {
 	if len(filter.Addresses) == 0 {
 		return pkgerrors.Errorf("at least one address must be specified")
@@ -261,6 +283,9 @@ func (lp *logPoller) RegisterFilter(ctx context.Context, filter Filter) error {
 			return pkgerrors.Errorf("empty address")
 		}
 	}
+	if filter.StartBlock < 0 {
+		return pkgerrors.Errorf("invalid start block %d", filter.StartBlock)
+	}
 
 	lp.filterMu.Lock()
 	defer lp.filterMu.Unlock()
@@ -279,6 +304,11 @@ func (lp *logPoller) RegisterFilter(ctx context.Context, filter Filter) error {
 	}
 	lp.filters[filter.Name] = filter
 	lp.filterDirty = true
+
+	if filter.StartBlock > 0 {
+		lp.filterBackfillUnscheduled = true
+		lp.lggr.Infow("Added filter backfill", "name", filter.Name, "fromBlock", filter.StartBlock)
+	}
 	return nil
 }
 
@@ -300,6 +330,7 @@ func (lp *logPoller) UnregisterFilter(ctx context.Context, name string) error {
 	}
 	delete(lp.filters, name)
 	lp.filterDirty = true
+	lp.clearFilterBackfillStatus(name)
 	return nil
 }
 
@@ -329,6 +360,7 @@ func (lp *logPoller) GetFilters() map[string]Filter {
 			Retention:    v.Retention,
 			MaxLogsKept:  v.MaxLogsKept,
 			LogsPerBlock: v.LogsPerBlock,
+			StartBlock:   v.StartBlock,
 		}
 		copy(deepCopyFilter.Addresses, v.Addresses)
 		copy(deepCopyFilter.EventSigs, v.EventSigs)
@@ -482,6 +514,19 @@ func (lp *logPoller) ReplayAsync(fromBlock int64) {
 	}()
 }
 
+var _ httypes.ReorgTrackable = (*logPoller)(nil)
+
+// OnReorg makes the LogPoller poll right away when the HeadTracker detects a reorg, so that the logs of the replaced
+// blocks are removed without waiting for the next poll.
+func (lp *logPoller) OnReorg(ctx context.Context, reorg httypes.Reorg) {
+	from, to := reorg.AffectedRange()
+	lp.lggr.Debugw("HeadTracker detected a reorg, polling for logs", "fromBlock", from, "toBlock", to)
+	select {
+	case lp.pollNow <- struct{}{}:
+	default: // a poll is already pending
+	}
+}
+
 func (lp *logPoller) Start(context.Context) error {
 	return lp.StartOnce("LogPoller", func() error {
 		lp.wg.Add(2)
@@ -514,8 +559,15 @@ func (lp *logPoller) Name() string {
 	return lp.lggr.Name()
 }
 
+// HealthReport includes an entry for every filter with a pending backfill, holding the error of its last attempt, if any.
 func (lp *logPoller) HealthReport() map[string]error {
-	return map[string]error{lp.Name(): lp.Healthy()}
+	report := map[string]error{lp.Name(): lp.Healthy()}
+	lp.filterBackfillMu.RLock()
+	defer lp.filterBackfillMu.RUnlock()
+	for name, err := range lp.filterBackfillErrs {
+		report[lp.Name()+".FilterBackfill."+name] = err
+	}
+	return report
 }
 
 func (lp *logPoller) GetReplayFromBlock(ctx context.Context, requested int64) (int64, error) {
@@ -545,6 +597,8 @@ func (lp *logPoller) loadFilters(ctx context.Context) error {
 
 	lp.filters = filters
 	lp.filterDirty = true
+	// Backfills added before a restart may not have been scheduled yet
+	lp.filterBackfillUnscheduled = true
 	return nil
 }
 
@@ -563,6 +617,8 @@ func (lp *logPoller) run() {
 			return
 		case fromBlockReq := <-lp.replayStart:
 			lp.handleReplayRequest(ctx, fromBlockReq, filtersLoaded)
+		case <-lp.pollNow:
+			logPollTick = time.After(0)
 		case <-logPollTick:
 			logPollTick = time.After(utils.WithJitter(lp.pollPeriod))
 			if !filtersLoaded {
@@ -602,6 +658,7 @@ func (lp *logPoller) run() {
 				start = lastProcessed.BlockNumber + 1
 			}
 			lp.PollAndSaveLogs(ctx, start)
+			lp.BackfillFilters(ctx)
 		case <-backupLogPollTick:
 			if lp.backupPollerBlockDelay == 0 {
 				continue // backup poller is disabled
@@ -659,6 +716,100 @@ func (lp *logPoller) backgroundWorkerRun() {
 	}
 }
 
+// scheduleFilterBackfills sets toBlock as the last block of the backfills of newly registered filters. It is called
+// by the poll loop before polling the blocks after toBlock. Holding filterMu guarantees that every filter whose
+// backfill is scheduled is included in that poll, so no block is left out between the backfill and the poll.
+func (lp *logPoller) scheduleFilterBackfills(ctx context.Context, toBlock int64) {
+	lp.filterMu.Lock()
+	defer lp.filterMu.Unlock()
+	if !lp.filterBackfillUnscheduled {
+		return
+	}
+	if err := lp.orm.ScheduleFilterBackfills(ctx, toBlock); err != nil {
+		lp.lggr.Errorw("Unable to schedule filter backfills, will retry", "err", err)
+		return
+	}
+	lp.filterBackfillUnscheduled = false
+}
+
+// BackfillFilters fetches historical logs for every filter registered with a StartBlock whose backfill is not complete.
+// Each filter is backfilled on its own, fetching only logs for the filter's addresses and event sigs in batches of
+// BackfillBatchSize blocks, so registering a new filter does not require a Replay of all filters. Progress is saved to the
+// db after every batch, so backfills resume where they left off after a restart or an error.
+// It is run by the poll loop after every poll, so it never races with polling or reorg handling. To not delay the next
+// poll for long, at most maxFilterBackfillBatches batches are fetched per filter per call.
+// Only finalized blocks are backfilled, later blocks are left for a subsequent call.
+func (lp *logPoller) BackfillFilters(ctx context.Context) {
+	backfills, err := lp.orm.SelectPendingFilterBackfills(ctx)
+	if err != nil {
+		lp.lggr.Errorw("Unable to load pending filter backfills", "err", err)
+		return
+	}
+	if len(backfills) == 0 {
+		return
+	}
+	latestFinalizedBlockNumber, err := lp.savedFinalizedBlockNumber(ctx)
+	if err != nil {
+		lp.lggr.Errorw("Unable to get latest finalized block for filter backfills", "err", err)
+		return
+	}
+
+	filters := lp.GetFilters()
+	for _, backfill := range backfills {
+		filter, ok := filters[backfill.FilterName]
+		if !ok {
+			// Filter was unregistered concurrently, its backfill has been deleted with it.
+			continue
+		}
+		if err = lp.backfillFilter(ctx, filter, backfill, latestFinalizedBlockNumber); err != nil {
+			lp.lggr.Warnw("Filter backfill failed, will retry", "name", filter.Name, "err", err)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func (lp *logPoller) backfillFilter(ctx context.Context, filter Filter, backfill FilterBackfill, latestFinalizedBlockNumber int64) (err error) {
+	next := backfill.NextBlock
+	defer func() {
+		if next > backfill.ToBlock && err == nil {
+			lp.lggr.Infow("Filter backfill completed", "name", filter.Name, "fromBlock", backfill.FromBlock, "toBlock", backfill.ToBlock)
+			lp.clearFilterBackfillStatus(filter.Name)
+			return
+		}
+		lp.setFilterBackfillStatus(filter.Name, err, backfill.ToBlock-next+1)
+	}()
+
+	end := mathutil.Min(backfill.ToBlock, latestFinalizedBlockNumber)
+	for batches := 0; next <= end && batches < maxFilterBackfillBatches; batches++ {
+		to := mathutil.Min(next+lp.backfillBatchSize-1, end)
+		if err = lp.backfillQuery(ctx, next, to, filter.query); err != nil {
+			return err
+		}
+		if err = lp.orm.UpdateFilterBackfillProgress(ctx, filter.Name, to+1); err != nil {
+			return pkgerrors.Wrap(err, "error saving filter backfill progress")
+		}
+		next = to + 1
+		lp.setFilterBackfillStatus(filter.Name, nil, backfill.ToBlock-next+1)
+	}
+	return nil
+}
+
+func (lp *logPoller) setFilterBackfillStatus(name string, err error, remainingBlocks int64) {
+	lp.filterBackfillMu.Lock()
+	defer lp.filterBackfillMu.Unlock()
+	lp.filterBackfillErrs[name] = err
+	lpFilterBackfillRemainingBlocks.WithLabelValues(lp.ec.ConfiguredChainID().String(), name).Set(float64(remainingBlocks))
+}
+
+func (lp *logPoller) clearFilterBackfillStatus(name string) {
+	lp.filterBackfillMu.Lock()
+	defer lp.filterBackfillMu.Unlock()
+	delete(lp.filterBackfillErrs, name)
+	lpFilterBackfillRemainingBlocks.DeleteLabelValues(lp.ec.ConfiguredChainID().String(), name)
+}
+
 func (lp *logPoller) handleReplayRequest(ctx context.Context, fromBlockReq int64, filtersLoaded bool) {
 	fromBlock, err := lp.GetReplayFromBlock(ctx, fromBlockReq)
 	if err == nil {
@@ -792,11 +943,16 @@ const jsonRpcLimitExceeded = -32005 // See https://github.com/ethereum/EIPs/blob
 // Retries until ctx cancelled. Will return an error if cancelled
 // or if there is an error backfilling.
 func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
+	return lp.backfillQuery(ctx, start, end, lp.Filter)
+}
+
+// backfillQuery is like backfill, but only fetches the logs matched by the query built by filterQuery.
+func (lp *logPoller) backfillQuery(ctx context.Context, start, end int64, filterQuery func(from, to *big.Int, bh *common.Hash) ethereum.FilterQuery) error {
 	batchSize := lp.backfillBatchSize
 	for from := start; from <= end; from += batchSize {
 		to := mathutil.Min(from+batchSize-1, end)
 
-		gethLogs, err := lp.ec.FilterLogs(ctx, lp.Filter(big.NewInt(from), big.NewInt(to), nil))
+		gethLogs, err := lp.ec.FilterLogs(ctx, filterQuery(big.NewInt(from), big.NewInt(to), nil))
 		if err != nil {
 			var rpcErr client.JsonError
 			if pkgerrors.As(err, &rpcErr) {
@@ -921,6 +1077,8 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 // conditions this would be equal to lastProcessed.BlockNumber + 1.
 func (lp *logPoller) PollAndSaveLogs(ctx context.Context, currentBlockNumber int64) {
 	lp.lggr.Debugw("Polling for logs", "currentBlockNumber", currentBlockNumber)
+	// Every filter registered so far is included in this poll, so their backfills end right before it.
+	lp.scheduleFilterBackfills(ctx, currentBlockNumber-1)
 	// Intentionally not using logPoller.finalityDepth directly but the latestFinalizedBlockNumber returned from lp.latestBlocks()
 	// latestBlocks knows how to pick a proper latestFinalizedBlockNumber based on the logPoller's configuration
 	latestBlock, latestFinalizedBlockNumber, err := lp.latestBlocks(ctx)
@@ -27,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 
 	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/log_emitter"
@@ -250,6 +251,46 @@ func TestLogPoller_BackupPollerStartup(t *testing.T) {
 	assert.Equal(t, int64(2), lp.backupPollerNextBlock)
 }
 
+// pollCountingORM signals every poll of the LogPoller, which starts by loading the latest block
+type pollCountingORM struct {
+	ORM
+	polls chan struct{}
+}
+
+func (o *pollCountingORM) SelectLatestBlock(ctx context.Context) (*LogPollerBlock, error) {
+	o.polls <- struct{}{}
+	return nil, errors.New("no blocks to poll from")
+}
+
+func TestLogPoller_OnReorg(t *testing.T) {
+	lggr := logger.Test(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := &pollCountingORM{ORM: NewORM(testutils.FixtureChainID, db, lggr), polls: make(chan struct{}, 10)}
+	lp := NewLogPoller(orm, nil, lggr, Opts{PollPeriod: time.Hour, FinalityDepth: 2, BackfillBatchSize: 3, RpcBatchSize: 2, KeepFinalizedBlocksDepth: 1000})
+	servicetest.Run(t, lp)
+
+	waitForPoll := func() {
+		select {
+		case <-orm.polls:
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for the LogPoller to poll")
+		}
+	}
+	// the first poll runs right away, the next one not before an hour
+	waitForPoll()
+
+	reorg := httypes.Reorg{Depth: 2, CommonAncestorNumber: 10, OldHeadNumber: 12, NewHeadNumber: 13}
+	lp.OnReorg(testutils.Context(t), reorg)
+	waitForPoll()
+
+	t.Run("does not block while a poll is pending", func(t *testing.T) {
+		lp := NewLogPoller(orm, nil, lggr, Opts{PollPeriod: time.Hour})
+		lp.OnReorg(testutils.Context(t), reorg)
+		lp.OnReorg(testutils.Context(t), reorg)
+		assert.Len(t, lp.pollNow, 1)
+	})
+}
+
 func mockBatchCallContext(t *testing.T, ec *evmclimocks.Client) {
 	ec.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
 		elems := args.Get(1).([]rpc.BatchElem)
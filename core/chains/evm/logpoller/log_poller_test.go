@@ -1909,6 +1909,85 @@ func Test_PruneOldBlocks(t *testing.T) {
 	}
 }
 
+func TestLogPoller_BackfillFilters(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	lpOpts := logpoller.Opts{
+		UseFinalityTag:           true,
+		BackfillBatchSize:        3,
+		RpcBatchSize:             2,
+		KeepFinalizedBlocksDepth: 1000,
+	}
+	th := SetupTH(t, lpOpts)
+
+	require.NoError(t, th.LogPoller.RegisterFilter(ctx, logpoller.Filter{
+		Name:      "existing",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses: []common.Address{th.EmitterAddress2},
+	}))
+
+	// Emit logs from both emitters, only those of the existing filter are polled
+	for i := 1; i <= 10; i++ {
+		_, err := th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(int64(i))})
+		require.NoError(t, err)
+		_, err = th.Emitter2.EmitLog1(th.Owner, []*big.Int{big.NewInt(int64(i))})
+		require.NoError(t, err)
+		th.Client.Commit()
+	}
+	latest := th.PollAndSaveLogs(ctx, 1) - 1
+
+	logs, err := th.LogPoller.Logs(ctx, 0, latest, EmitterABI.Events["Log1"].ID, th.EmitterAddress1)
+	require.NoError(t, err)
+	require.Empty(t, logs)
+
+	// Register a new filter with a start block, which is backfilled without affecting the existing filter
+	err = th.LogPoller.RegisterFilter(ctx, logpoller.Filter{
+		Name:       "backfilled",
+		EventSigs:  []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses:  []common.Address{th.EmitterAddress1},
+		StartBlock: 1,
+	})
+	require.NoError(t, err)
+	backfills, err := th.ORM.SelectPendingFilterBackfills(ctx)
+	require.NoError(t, err)
+	require.Empty(t, backfills)
+
+	// The backfill ends right before the first poll which includes the filter
+	markBlockAsFinalized(t, th, latest-5)
+	th.Client.Commit()
+	next := th.PollAndSaveLogs(ctx, latest+1)
+	backfills, err = th.ORM.SelectPendingFilterBackfills(ctx)
+	require.NoError(t, err)
+	require.Len(t, backfills, 1)
+	assert.Equal(t, latest, backfills[0].ToBlock)
+
+	// Nothing past the latest finalized block is backfilled
+	th.LogPoller.BackfillFilters(ctx)
+	logs, err = th.LogPoller.Logs(ctx, 0, latest, EmitterABI.Events["Log1"].ID, th.EmitterAddress1)
+	require.NoError(t, err)
+	assert.Len(t, logs, 5)
+	assert.Contains(t, th.LogPoller.HealthReport(), th.LogPoller.Name()+".FilterBackfill.backfilled")
+
+	// Once the rest is finalized, the backfill completes
+	markBlockAsFinalized(t, th, latest)
+	th.Client.Commit()
+	th.PollAndSaveLogs(ctx, next)
+	th.LogPoller.BackfillFilters(ctx)
+	logs, err = th.LogPoller.Logs(ctx, 0, latest, EmitterABI.Events["Log1"].ID, th.EmitterAddress1)
+	require.NoError(t, err)
+	assert.Len(t, logs, 10)
+	assert.NotContains(t, th.LogPoller.HealthReport(), th.LogPoller.Name()+".FilterBackfill.backfilled")
+
+	backfills, err = th.ORM.SelectPendingFilterBackfills(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, backfills)
+
+	// The existing filter's logs are unaffected
+	logs, err = th.LogPoller.Logs(ctx, 0, latest, EmitterABI.Events["Log1"].ID, th.EmitterAddress2)
+	require.NoError(t, err)
+	assert.Len(t, logs, 10)
+}
+
 func markBlockAsFinalized(t *testing.T, th TestHarness, blockNumber int64) {
 	b, err := th.Client.BlockByNumber(testutils.Context(t), big.NewInt(blockNumber))
 	require.NoError(t, err)
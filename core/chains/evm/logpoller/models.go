@@ -65,3 +65,16 @@ func NewLogPollerBlock(blockHash common.Hash, blockNumber int64, timestamp time.
 		FinalizedBlockNumber: finalizedBlockNumber,
 	}
 }
+
+// FilterBackfill tracks the progress of fetching historical logs for a single filter
+// registered with a StartBlock. Blocks [FromBlock, NextBlock) have already been backfilled.
+type FilterBackfill struct {
+	EvmChainId  *big.Big
+	FilterName  string
+	FromBlock   int64
+	ToBlock     int64
+	NextBlock   int64
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
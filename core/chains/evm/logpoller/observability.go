@@ -64,6 +64,10 @@ var (
 		Name: "log_poller_blocks_inserted",
 		Help: "Counter to track number of blocks inserted by Log Poller",
 	}, []string{"evmChainID"})
+	lpFilterBackfillRemainingBlocks = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "log_poller_filter_backfill_remaining_blocks",
+		Help: "Number of blocks left to backfill for a filter registered with a start block",
+	}, []string{"evmChainID", "filterName"})
 )
 
 // ObservedORM is a decorator layer for ORM used by LogPoller, responsible for pushing Prometheus metrics reporting duration and size of result set for the queries.
@@ -124,6 +128,24 @@ func (o *ObservedORM) DeleteFilter(ctx context.Context, name string) error {
 	})
 }
 
+func (o *ObservedORM) ScheduleFilterBackfills(ctx context.Context, toBlock int64) error {
+	return withObservedExec(o, "ScheduleFilterBackfills", create, func() error {
+		return o.ORM.ScheduleFilterBackfills(ctx, toBlock)
+	})
+}
+
+func (o *ObservedORM) SelectPendingFilterBackfills(ctx context.Context) ([]FilterBackfill, error) {
+	return withObservedQuery(o, "SelectPendingFilterBackfills", func() ([]FilterBackfill, error) {
+		return o.ORM.SelectPendingFilterBackfills(ctx)
+	})
+}
+
+func (o *ObservedORM) UpdateFilterBackfillProgress(ctx context.Context, name string, nextBlock int64) error {
+	return withObservedExec(o, "UpdateFilterBackfillProgress", create, func() error {
+		return o.ORM.UpdateFilterBackfillProgress(ctx, name, nextBlock)
+	})
+}
+
 func (o *ObservedORM) DeleteBlocksBefore(ctx context.Context, end int64, limit int64) (int64, error) {
 	return withObservedExecAndRowsAffected(o, "DeleteBlocksBefore", del, func() (int64, error) {
 		return o.ORM.DeleteBlocksBefore(ctx, end, limit)
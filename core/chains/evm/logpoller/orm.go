@@ -32,6 +32,10 @@ type ORM interface {
 	LoadFilters(ctx context.Context) (map[string]Filter, error)
 	DeleteFilter(ctx context.Context, name string) error
 
+	ScheduleFilterBackfills(ctx context.Context, toBlock int64) error
+	SelectPendingFilterBackfills(ctx context.Context) ([]FilterBackfill, error)
+	UpdateFilterBackfillProgress(ctx context.Context, name string, nextBlock int64) error
+
 	InsertBlock(ctx context.Context, blockHash common.Hash, blockNumber int64, blockTimestamp time.Time, finalizedBlock int64) error
 	DeleteBlocksBefore(ctx context.Context, end int64, limit int64) (int64, error)
 	DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error
@@ -113,13 +117,28 @@ func (o *DSORM) InsertBlock(ctx context.Context, blockHash common.Hash, blockNum
 //
 // Each address/event pair must have a unique job id, so it may be removed when the job is deleted.
 // If a second job tries to overwrite the same pair, this should fail.
+// InsertFilter is idempotent. If the filter has a StartBlock, its backfill is scheduled in the same transaction,
+// see insertFilterBackfill.
 func (o *DSORM) InsertFilter(ctx context.Context, filter Filter) (err error) {
+	if filter.StartBlock > 0 {
+		return o.Transact(ctx, func(orm *DSORM) error {
+			if err := orm.insertFilter(ctx, filter); err != nil {
+				return err
+			}
+			return orm.insertFilterBackfill(ctx, filter.Name, filter.StartBlock)
+		})
+	}
+	return o.insertFilter(ctx, filter)
+}
+
+func (o *DSORM) insertFilter(ctx context.Context, filter Filter) (err error) {
 	topicArrays := []types.HashArray{filter.Topic2, filter.Topic3, filter.Topic4}
 	args, err := newQueryArgs(o.chainID).
 		withField("name", filter.Name).
 		withRetention(filter.Retention).
 		withMaxLogsKept(filter.MaxLogsKept).
 		withLogsPerBlock(filter.LogsPerBlock).
+		withField("start_block", filter.StartBlock).
 		withAddressArray(filter.Addresses).
 		withEventSigArray(filter.EventSigs).
 		withTopicArrays(filter.Topic2, filter.Topic3, filter.Topic4).
@@ -139,14 +158,14 @@ func (o *DSORM) InsertFilter(ctx context.Context, filter Filter) (err error) {
 	// https://github.com/jmoiron/sqlx/issues/91, https://github.com/jmoiron/sqlx/issues/428
 	query := fmt.Sprintf(`
 		INSERT INTO evm.log_poller_filters
-	  		(name, evm_chain_id, retention, max_logs_kept, logs_per_block, created_at, address, event %s)
+	  		(name, evm_chain_id, retention, max_logs_kept, logs_per_block, start_block, created_at, address, event %s)
 		SELECT * FROM
-			(SELECT :name, :evm_chain_id ::::NUMERIC, :retention ::::BIGINT, :max_logs_kept ::::NUMERIC, :logs_per_block ::::NUMERIC, NOW()) x,
+			(SELECT :name, :evm_chain_id ::::NUMERIC, :retention ::::BIGINT, :max_logs_kept ::::NUMERIC, :logs_per_block ::::NUMERIC, :start_block ::::BIGINT, NOW()) x,
 			(SELECT unnest(:address_array ::::BYTEA[]) addr) a,
 			(SELECT unnest(:event_sig_array ::::BYTEA[]) ev) e
 			%s
 		ON CONFLICT  (evm.f_log_poller_filter_hash(name, evm_chain_id, address, event, topic2, topic3, topic4))
-		DO UPDATE SET retention=:retention ::::BIGINT, max_logs_kept=:max_logs_kept ::::NUMERIC, logs_per_block=:logs_per_block ::::NUMERIC`,
+		DO UPDATE SET retention=:retention ::::BIGINT, max_logs_kept=:max_logs_kept ::::NUMERIC, logs_per_block=:logs_per_block ::::NUMERIC, start_block=:start_block ::::BIGINT`,
 		topicsColumns.String(),
 		topicsSql.String())
 
@@ -154,14 +173,66 @@ func (o *DSORM) InsertFilter(ctx context.Context, filter Filter) (err error) {
 	return err
 }
 
-// DeleteFilter removes all events,address pairs associated with the Filter
+// DeleteFilter removes all events,address pairs associated with the Filter, along with its backfill progress
 func (o *DSORM) DeleteFilter(ctx context.Context, name string) error {
 	_, err := o.ds.ExecContext(ctx,
-		`DELETE FROM evm.log_poller_filters WHERE name = $1 AND evm_chain_id = $2`,
+		`WITH deleted_backfills AS (
+			DELETE FROM evm.log_poller_filter_backfills WHERE filter_name = $1 AND evm_chain_id = $2
+		)
+		DELETE FROM evm.log_poller_filters WHERE name = $1 AND evm_chain_id = $2`,
 		name, ubig.New(o.chainID))
 	return err
 }
 
+// insertFilterBackfill adds a backfill from fromBlock for the filter. Its last block is only set by
+// ScheduleFilterBackfills. A backfill already tracked for the filter is only restarted if it started from a
+// different block, so that re-registering the same filter on startup doesn't repeat its backfill.
+func (o *DSORM) insertFilterBackfill(ctx context.Context, name string, fromBlock int64) error {
+	_, err := o.ds.ExecContext(ctx, `
+		INSERT INTO evm.log_poller_filter_backfills
+			(evm_chain_id, filter_name, from_block, next_block, created_at, updated_at)
+		VALUES ($1, $2, $3, $3, NOW(), NOW())
+		ON CONFLICT (evm_chain_id, filter_name) DO UPDATE SET
+			from_block = EXCLUDED.from_block, to_block = NULL, next_block = EXCLUDED.next_block,
+			completed_at = NULL, updated_at = NOW()
+		WHERE evm.log_poller_filter_backfills.from_block <> EXCLUDED.from_block`,
+		ubig.New(o.chainID), name, fromBlock)
+	return err
+}
+
+// ScheduleFilterBackfills sets toBlock as the last block of every backfill which doesn't have one yet.
+// Backfills starting after toBlock have nothing to backfill, and are completed right away.
+func (o *DSORM) ScheduleFilterBackfills(ctx context.Context, toBlock int64) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE evm.log_poller_filter_backfills SET
+			to_block = $2,
+			completed_at = CASE WHEN from_block > $2 THEN NOW() ELSE NULL END,
+			updated_at = NOW()
+		WHERE evm_chain_id = $1 AND to_block IS NULL AND completed_at IS NULL`,
+		ubig.New(o.chainID), toBlock)
+	return err
+}
+
+// SelectPendingFilterBackfills returns the scheduled backfills which have not completed yet, oldest first
+func (o *DSORM) SelectPendingFilterBackfills(ctx context.Context) ([]FilterBackfill, error) {
+	var backfills []FilterBackfill
+	err := o.ds.SelectContext(ctx, &backfills, `SELECT * FROM evm.log_poller_filter_backfills
+		WHERE evm_chain_id = $1 AND to_block IS NOT NULL AND completed_at IS NULL
+		ORDER BY created_at, filter_name`, ubig.New(o.chainID))
+	return backfills, err
+}
+
+// UpdateFilterBackfillProgress records that all blocks before nextBlock have been backfilled for the filter.
+// The backfill is marked as completed once nextBlock is past its last block.
+func (o *DSORM) UpdateFilterBackfillProgress(ctx context.Context, name string, nextBlock int64) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE evm.log_poller_filter_backfills SET
+			next_block = $3,
+			completed_at = CASE WHEN $3 > to_block THEN NOW() ELSE NULL END,
+			updated_at = NOW()
+		WHERE evm_chain_id = $1 AND filter_name = $2`,
+		ubig.New(o.chainID), name, nextBlock)
+	return err
+}
+
 // LoadFilters returns all filters for this chain
 func (o *DSORM) LoadFilters(ctx context.Context) (map[string]Filter, error) {
 	query := `SELECT name,
@@ -172,7 +243,8 @@ func (o *DSORM) LoadFilters(ctx context.Context) (map[string]Filter, error) {
 			ARRAY_AGG(DISTINCT topic4 ORDER BY topic4) FILTER(WHERE topic4 IS NOT NULL) AS topic4,
 			MAX(logs_per_block) AS logs_per_block,
 			MAX(retention) AS retention,
-			MAX(max_logs_kept) AS max_logs_kept
+			MAX(max_logs_kept) AS max_logs_kept,
+			MAX(start_block) AS start_block
 		FROM evm.log_poller_filters WHERE evm_chain_id = $1
 		GROUP BY name`
 	var rows []Filter
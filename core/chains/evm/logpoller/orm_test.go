@@ -1130,6 +1130,92 @@ func TestORM_DeleteBlocksBefore(t *testing.T) {
 	require.Equal(t, err, sql.ErrNoRows)
 }
 
+func TestORM_FilterBackfills(t *testing.T) {
+	th := SetupTH(t, lpOpts)
+	o1, o2 := th.ORM, th.ORM2
+	ctx := testutils.Context(t)
+
+	filter := logpoller.Filter{
+		Name:       "backfilled",
+		EventSigs:  []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses:  []common.Address{th.EmitterAddress1},
+		StartBlock: 10,
+	}
+	require.NoError(t, o1.InsertFilter(ctx, filter))
+	otherFilter := filter
+	otherFilter.StartBlock = 1
+	require.NoError(t, o2.InsertFilter(ctx, otherFilter))
+
+	// The start block is persisted with the filter
+	filters, err := o1.LoadFilters(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), filters[filter.Name].StartBlock)
+
+	// Backfills are only pending once scheduled
+	backfills, err := o1.SelectPendingFilterBackfills(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, backfills)
+
+	require.NoError(t, o1.ScheduleFilterBackfills(ctx, 20))
+	backfills, err = o1.SelectPendingFilterBackfills(ctx)
+	require.NoError(t, err)
+	require.Len(t, backfills, 1)
+	assert.Equal(t, filter.Name, backfills[0].FilterName)
+	assert.Equal(t, th.ChainID.String(), backfills[0].EvmChainId.String())
+	assert.Equal(t, int64(10), backfills[0].FromBlock)
+	assert.Equal(t, int64(20), backfills[0].ToBlock)
+	assert.Equal(t, int64(10), backfills[0].NextBlock)
+	assert.Nil(t, backfills[0].CompletedAt)
+
+	// Scheduled backfills keep their last block
+	require.NoError(t, o1.ScheduleFilterBackfills(ctx, 25))
+	require.NoError(t, o1.UpdateFilterBackfillProgress(ctx, filter.Name, 15))
+	backfills, err = o1.SelectPendingFilterBackfills(ctx)
+	require.NoError(t, err)
+	require.Len(t, backfills, 1)
+	assert.Equal(t, int64(15), backfills[0].NextBlock)
+	assert.Equal(t, int64(20), backfills[0].ToBlock)
+
+	// Re-inserting from the same block doesn't restart the backfill
+	require.NoError(t, o1.InsertFilter(ctx, filter))
+	backfills, err = o1.SelectPendingFilterBackfills(ctx)
+	require.NoError(t, err)
+	require.Len(t, backfills, 1)
+	assert.Equal(t, int64(15), backfills[0].NextBlock)
+	assert.Equal(t, int64(20), backfills[0].ToBlock)
+
+	// Completed once past the last block
+	require.NoError(t, o1.UpdateFilterBackfillProgress(ctx, filter.Name, 21))
+	backfills, err = o1.SelectPendingFilterBackfills(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, backfills)
+
+	// Re-inserting from a different block restarts it, up to the block it is scheduled to
+	filter.StartBlock = 5
+	require.NoError(t, o1.InsertFilter(ctx, filter))
+	require.NoError(t, o1.ScheduleFilterBackfills(ctx, 30))
+	backfills, err = o1.SelectPendingFilterBackfills(ctx)
+	require.NoError(t, err)
+	require.Len(t, backfills, 1)
+	assert.Equal(t, int64(5), backfills[0].NextBlock)
+	assert.Equal(t, int64(30), backfills[0].ToBlock)
+
+	// A backfill starting after the block it is scheduled to is completed right away
+	filter.StartBlock = 50
+	require.NoError(t, o1.InsertFilter(ctx, filter))
+	require.NoError(t, o1.ScheduleFilterBackfills(ctx, 30))
+	backfills, err = o1.SelectPendingFilterBackfills(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, backfills)
+
+	// Deleting the filter deletes its backfill, but not the one of the other chain
+	require.NoError(t, o1.DeleteFilter(ctx, filter.Name))
+	require.NoError(t, o2.ScheduleFilterBackfills(ctx, 2))
+	backfills, err = o2.SelectPendingFilterBackfills(ctx)
+	require.NoError(t, err)
+	assert.Len(t, backfills, 1)
+}
+
 func TestLogPoller_Logs(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
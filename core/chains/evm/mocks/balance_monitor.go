@@ -76,6 +76,24 @@ func (_m *BalanceMonitor) HealthReport() map[string]error {
 	return r0
 }
 
+// IsBelowMinBalance provides a mock function with given fields: _a0
+func (_m *BalanceMonitor) IsBelowMinBalance(_a0 common.Address) bool {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsBelowMinBalance")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(common.Address) bool); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // Name provides a mock function with given fields:
 func (_m *BalanceMonitor) Name() string {
 	ret := _m.Called()
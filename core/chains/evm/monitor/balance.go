@@ -2,6 +2,7 @@ package monitor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -30,6 +32,8 @@ type (
 	BalanceMonitor interface {
 		httypes.HeadTrackable
 		GetEthBalance(gethCommon.Address) *assets.Eth
+		// IsBelowMinBalance returns true if the last known balance of the key is below the configured minimum balance.
+		IsBelowMinBalance(gethCommon.Address) bool
 		services.Service
 	}
 
@@ -40,6 +44,7 @@ type (
 		chainID        *big.Int
 		chainIDStr     string
 		ethKeyStore    keystore.Eth
+		minBalance     *assets.Wei
 		ethBalances    map[gethCommon.Address]*assets.Eth
 		ethBalancesMtx *sync.RWMutex
 		sleeperTask    *utils.SleeperTask
@@ -51,8 +56,12 @@ type (
 var _ BalanceMonitor = (*balanceMonitor)(nil)
 
 // NewBalanceMonitor returns a new balanceMonitor
-func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, lggr logger.Logger) *balanceMonitor {
+func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, cfg config.BalanceMonitor, lggr logger.Logger) *balanceMonitor {
 	chainId := ethClient.ConfiguredChainID()
+	minBalance := cfg.MinBalance()
+	if minBalance == nil {
+		minBalance = assets.NewWeiI(0)
+	}
 	bm := &balanceMonitor{
 		services.StateMachine{},
 		logger.Named(lggr, "BalanceMonitor"),
@@ -60,6 +69,7 @@ func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, lgg
 		chainId,
 		chainId.String(),
 		ethKeyStore,
+		minBalance,
 		make(map[gethCommon.Address]*assets.Eth),
 		new(sync.RWMutex),
 		nil,
@@ -92,7 +102,24 @@ func (bm *balanceMonitor) Name() string {
 }
 
 func (bm *balanceMonitor) HealthReport() map[string]error {
-	return map[string]error{bm.Name(): bm.Healthy()}
+	return map[string]error{bm.Name(): errors.Join(bm.Healthy(), bm.minBalanceErr())}
+}
+
+// minBalanceErr returns an error listing the keys below the minimum balance, if any.
+func (bm *balanceMonitor) minBalanceErr() error {
+	bm.ethBalancesMtx.RLock()
+	defer bm.ethBalancesMtx.RUnlock()
+	var errs []error
+	for address, bal := range bm.ethBalances {
+		if bm.belowMinBalance(bal) {
+			errs = append(errs, fmt.Errorf("key %s has balance %s, below the minimum balance of %s", address.Hex(), bal.String(), bm.minBalance.String()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (bm *balanceMonitor) belowMinBalance(bal *assets.Eth) bool {
+	return bal != nil && bal.ToInt().Cmp(bm.minBalance.ToInt()) < 0
 }
 
 // OnNewLongestChain checks the balance for each key
@@ -126,11 +153,19 @@ func (bm *balanceMonitor) updateBalance(ethBal assets.Eth, address gethCommon.Ad
 
 	if oldBal == nil {
 		lgr.Infof("ETH balance for %s: %s", address.Hex(), ethBal.String())
-		return
+	} else if ethBal.Cmp(oldBal) != 0 {
+		lgr.Infof("New ETH balance for %s: %s", address.Hex(), ethBal.String())
 	}
 
-	if ethBal.Cmp(oldBal) != 0 {
-		lgr.Infof("New ETH balance for %s: %s", address.Hex(), ethBal.String())
+	wasBelow, isBelow := bm.belowMinBalance(oldBal), bm.belowMinBalance(&ethBal)
+	if isBelow && !wasBelow {
+		lgr.Warnw(fmt.Sprintf("ETH balance for %s is below the minimum balance of %s", address.Hex(), bm.minBalance.String()),
+			"minBalance", bm.minBalance.String(),
+			"minWeiBalance", bm.minBalance.ToInt())
+	} else if wasBelow && !isBelow {
+		lgr.Infow(fmt.Sprintf("ETH balance for %s is back above the minimum balance of %s", address.Hex(), bm.minBalance.String()),
+			"minBalance", bm.minBalance.String(),
+			"minWeiBalance", bm.minBalance.ToInt())
 	}
 }
 
@@ -140,6 +175,12 @@ func (bm *balanceMonitor) GetEthBalance(address gethCommon.Address) *assets.Eth
 	return bm.ethBalances[address]
 }
 
+func (bm *balanceMonitor) IsBelowMinBalance(address gethCommon.Address) bool {
+	bm.ethBalancesMtx.RLock()
+	defer bm.ethBalancesMtx.RUnlock()
+	return bm.belowMinBalance(bm.ethBalances[address])
+}
+
 var promETHBalance = promauto.NewGaugeVec(
 	prometheus.GaugeOpts{
 		Name: "eth_balance",
@@ -218,6 +259,10 @@ func (*NullBalanceMonitor) GetEthBalance(gethCommon.Address) *assets.Eth {
 	return nil
 }
 
+func (*NullBalanceMonitor) IsBelowMinBalance(gethCommon.Address) bool {
+	return false
+}
+
 // Start does noop for NullBalanceMonitor.
 func (*NullBalanceMonitor) Start(context.Context) error                                { return nil }
 func (*NullBalanceMonitor) Close() error                                               { return nil }
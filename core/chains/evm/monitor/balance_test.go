@@ -20,6 +20,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	ksmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/monitor"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
@@ -44,7 +45,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr, k1Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, testutils.NewTestChainScopedConfig(t, nil).EVM().BalanceMonitor(), logger.Test(t))
 
 		k0bal := big.NewInt(42)
 		k1bal := big.NewInt(43)
@@ -71,7 +72,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, testutils.NewTestChainScopedConfig(t, nil).EVM().BalanceMonitor(), logger.Test(t))
 		k0bal := big.NewInt(42)
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(k0bal, nil)
@@ -90,7 +91,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, testutils.NewTestChainScopedConfig(t, nil).EVM().BalanceMonitor(), logger.Test(t))
 		ctxCancelledAwaiter := testutils.NewAwaiter()
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Run(func(args mock.Arguments) {
@@ -119,7 +120,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, testutils.NewTestChainScopedConfig(t, nil).EVM().BalanceMonitor(), logger.Test(t))
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).
 			Once().
@@ -144,7 +145,7 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 			Return([]common.Address{k0Addr, k1Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, testutils.NewTestChainScopedConfig(t, nil).EVM().BalanceMonitor(), logger.Test(t))
 		k0bal := big.NewInt(42)
 		// Deliberately larger than a 64 bit unsigned integer to test overflow
 		k1bal := big.NewInt(0)
@@ -193,7 +194,7 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 
 	ethClient := newEthClientMock(t)
 
-	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, testutils.NewTestChainScopedConfig(t, nil).EVM().BalanceMonitor(), logger.Test(t))
 	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).
 		Once().
 		Return(big.NewInt(1), nil)
@@ -230,6 +231,43 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 	assert.LessOrEqual(t, callCount.Load(), int32(1))
 }
 
+func TestBalanceMonitor_MinBalance(t *testing.T) {
+	t.Parallel()
+
+	ethKeyStore := ksmocks.NewEth(t)
+	k0Addr := testutils.NewAddress()
+	k1Addr := testutils.NewAddress()
+	ethKeyStore.On("EnabledAddressesForChain", mock.Anything, mock.Anything).
+		Return([]common.Address{k0Addr, k1Addr}, nil)
+	ethClient := newEthClientMock(t)
+	cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+		c.BalanceMonitor.MinBalance = assets.NewWeiI(100)
+	})
+
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, cfg.EVM().BalanceMonitor(), logger.Test(t))
+	assert.False(t, bm.IsBelowMinBalance(k0Addr), "unknown balances are not below the minimum")
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(99), nil)
+	ethClient.On("BalanceAt", mock.Anything, k1Addr, nilBigInt).Once().Return(big.NewInt(100), nil)
+	servicetest.Run(t, bm)
+
+	assert.True(t, bm.IsBelowMinBalance(k0Addr))
+	assert.False(t, bm.IsBelowMinBalance(k1Addr))
+	err := bm.HealthReport()[bm.Name()]
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), k0Addr.Hex())
+	assert.NotContains(t, err.Error(), k1Addr.Hex())
+
+	// The key is healthy again once it's funded
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(1000), nil)
+	ethClient.On("BalanceAt", mock.Anything, k1Addr, nilBigInt).Once().Return(big.NewInt(100), nil)
+	bm.OnNewLongestChain(tests.Context(t), testutils.Head(0))
+	<-bm.WorkDone()
+
+	assert.False(t, bm.IsBelowMinBalance(k0Addr))
+	assert.NoError(t, bm.HealthReport()[bm.Name()])
+}
+
 func Test_ApproximateFloat64(t *testing.T) {
 	t.Parallel()
 
@@ -1721,6 +1721,78 @@ func TestEthBroadcaster_Trigger(t *testing.T) {
 	eb.Trigger(testutils.NewAddress())
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_KeyPaused(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	ctx := testutils.Context(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(0), nil).Once()
+	lggr, observed := logger.TestObserved(t, zapcore.DebugLevel)
+	nonceTracker := txmgr.NewNonceTracker(lggr, txStore, txmgr.NewEvmTxmClient(ethClient, nil))
+	ge := evmcfg.EVM().GasEstimator()
+	estimator := gas.NewEvmFeeEstimator(lggr, func(lggr logger.Logger) gas.EvmEstimator {
+		return gas.NewFixedPriceEstimator(ge, nil, ge.BlockHistory(), lggr, nil)
+	}, ge.EIP1559DynamicFees(), ge)
+	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ethKeyStore, estimator)
+	eb := txmgrcommon.NewBroadcaster(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(ge), evmcfg.EVM().Transactions(), cfg.Database().Listener(), ethKeyStore, txBuilder, nonceTracker, lggr, &testCheckerFactory{}, false)
+	eb.XXXTestDisableUnstartedTxAutoProcessing()
+	servicetest.Run(t, eb)
+
+	paused := true
+	eb.SetKeyPausedChecker(func(addr gethCommon.Address) bool {
+		require.Equal(t, fromAddress, addr)
+		return paused
+	})
+
+	withGasLimit := func(tx *txmgr.TxRequest) { tx.FeeLimit = 242 }
+	normal := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, withGasLimit)
+	critical := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, withGasLimit, txRequestWithPriority(txmgrtypes.TxPriorityCritical))
+
+	t.Run("only broadcasts critical txs while the key is paused", func(t *testing.T) {
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == uint64(0)
+		}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+		retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+
+		etx, err := txStore.FindTxWithAttempts(ctx, critical.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+
+		etx, err = txStore.FindTxWithAttempts(ctx, normal.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnstarted, etx.State)
+
+		// Pausing is only logged once
+		_, err = eb.ProcessUnstartedTxs(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.Equal(t, 1, observed.FilterMessage("Key is paused, only critical priority transactions are broadcast until it's funded").Len())
+	})
+
+	t.Run("broadcasts the remaining txs once the key is unpaused", func(t *testing.T) {
+		paused = false
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == uint64(1)
+		}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+		retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+
+		etx, err := txStore.FindTxWithAttempts(ctx, normal.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+		assert.Equal(t, 1, observed.FilterMessage("Key is resumed, broadcasting transactions of all priorities").Len())
+	})
+}
+
 func TestEthBroadcaster_SyncNonce(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	ctx := testutils.Context(t)
@@ -130,7 +130,8 @@ func TestEthConfirmer_Lifecycle(t *testing.T) {
 	feeEstimator := gas.NewEvmFeeEstimator(lggr, newEst, ge.EIP1559DynamicFees(), ge)
 	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ethKeyStore, feeEstimator)
 	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), config.EVM().Transactions().AutoPurge(), feeEstimator, txStore, ethClient)
-	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(ge), config.EVM().Transactions(), gconfig.Database(), ethKeyStore, txBuilder, lggr, stuckTxDetector)
+	feeBumpBudget := txmgr.NewFeeBumpBudget(lggr, testutils.FixtureChainID, config.EVM().Transactions().FeeBumpBudget(), txStore)
+	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(ge), config.EVM().Transactions(), gconfig.Database(), ethKeyStore, txBuilder, lggr, stuckTxDetector, feeBumpBudget)
 	ctx := testutils.Context(t)
 
 	// Can't close unstarted instance
@@ -1648,7 +1649,8 @@ func TestEthConfirmer_RebroadcastWhereNecessary_WithConnectivityCheck(t *testing
 		kst.On("EnabledAddressesForChain", mock.Anything, &cltest.FixtureChainID).Return(addresses, nil).Maybe()
 		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), ccfg.EVM().Transactions().AutoPurge(), feeEstimator, txStore, ethClient)
 		// Create confirmer with necessary state
-		ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), ccfg.EVM(), txmgr.NewEvmTxmFeeConfig(ccfg.EVM().GasEstimator()), ccfg.EVM().Transactions(), cfg.Database(), kst, txBuilder, lggr, stuckTxDetector)
+		feeBumpBudget := txmgr.NewFeeBumpBudget(lggr, testutils.FixtureChainID, ccfg.EVM().Transactions().FeeBumpBudget(), txStore)
+		ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), ccfg.EVM(), txmgr.NewEvmTxmFeeConfig(ccfg.EVM().GasEstimator()), ccfg.EVM().Transactions(), cfg.Database(), kst, txBuilder, lggr, stuckTxDetector, feeBumpBudget)
 		servicetest.Run(t, ec)
 		currentHead := int64(30)
 		oldEnough := int64(15)
@@ -1696,7 +1698,8 @@ func TestEthConfirmer_RebroadcastWhereNecessary_WithConnectivityCheck(t *testing
 		addresses := []gethCommon.Address{fromAddress}
 		kst.On("EnabledAddressesForChain", mock.Anything, &cltest.FixtureChainID).Return(addresses, nil).Maybe()
 		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), ccfg.EVM().Transactions().AutoPurge(), feeEstimator, txStore, ethClient)
-		ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), ccfg.EVM(), txmgr.NewEvmTxmFeeConfig(ccfg.EVM().GasEstimator()), ccfg.EVM().Transactions(), cfg.Database(), kst, txBuilder, lggr, stuckTxDetector)
+		feeBumpBudget := txmgr.NewFeeBumpBudget(lggr, testutils.FixtureChainID, ccfg.EVM().Transactions().FeeBumpBudget(), txStore)
+		ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), ccfg.EVM(), txmgr.NewEvmTxmFeeConfig(ccfg.EVM().GasEstimator()), ccfg.EVM().Transactions(), cfg.Database(), kst, txBuilder, lggr, stuckTxDetector, feeBumpBudget)
 		servicetest.Run(t, ec)
 		currentHead := int64(30)
 		oldEnough := int64(15)
@@ -1721,6 +1724,74 @@ func TestEthConfirmer_RebroadcastWhereNecessary_WithConnectivityCheck(t *testing
 	})
 }
 
+func TestEthConfirmer_RebroadcastWhereNecessary_WithFeeBumpBudget(t *testing.T) {
+	t.Parallel()
+	lggr := logger.Test(t)
+
+	db := pgtest.NewSqlxDB(t)
+
+	for _, tc := range []struct {
+		name             string
+		maxPerKey        *assets.Wei
+		expectedAttempts int
+	}{
+		{"should retry previous attempt if the bump would exceed the budget of the key", assets.NewWeiI(100), 1},
+		{"should bump and account for the spend if the bump fits within the budget of the key", assets.NewWeiI(1000), 2},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+				c.EVM[0].GasEstimator.EIP1559DynamicFees = ptr(false)
+				c.EVM[0].Transactions.FeeBumpBudget.Enabled = ptr(true)
+				c.EVM[0].Transactions.FeeBumpBudget.MaxPerKey = tc.maxPerKey
+			})
+			ccfg := evmtest.NewChainScopedConfig(t, cfg)
+
+			ctx := testutils.Context(t)
+			ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+			txStore := cltest.NewTestTxStore(t, db)
+			ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+			_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+			estimator := gasmocks.NewEvmEstimator(t)
+			newEst := func(logger.Logger) gas.EvmEstimator { return estimator }
+			estimator.On("BumpLegacyGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(assets.NewWeiI(10), uint64(42), nil)
+			ge := ccfg.EVM().GasEstimator()
+			feeEstimator := gas.NewEvmFeeEstimator(lggr, newEst, ge.EIP1559DynamicFees(), ge)
+			txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ethKeyStore, feeEstimator)
+			stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), ccfg.EVM().Transactions().AutoPurge(), feeEstimator, txStore, ethClient)
+			feeBumpBudget := txmgr.NewFeeBumpBudget(lggr, testutils.FixtureChainID, ccfg.EVM().Transactions().FeeBumpBudget(), txStore)
+			ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), ccfg.EVM(), txmgr.NewEvmTxmFeeConfig(ge), ccfg.EVM().Transactions(), cfg.Database(), ethKeyStore, txBuilder, lggr, stuckTxDetector, feeBumpBudget)
+			servicetest.Run(t, ec)
+			currentHead := int64(30)
+			oldEnough := int64(15)
+			originalBroadcastAt := time.Unix(1616509100, 0)
+
+			// The original attempt has a max fee of 1 wei * 42 gas, the bumped attempt of 10 wei * 42 gas
+			etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress, originalBroadcastAt)
+			attempt1 := etx.TxAttempts[0]
+			var dbAttempt txmgr.DbEthTxAttempt
+			dbAttempt.FromTxAttempt(&attempt1)
+			require.NoError(t, db.Get(&dbAttempt, `UPDATE evm.tx_attempts SET broadcast_before_block_num=$1 WHERE id=$2 RETURNING *`, oldEnough, attempt1.ID))
+
+			ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, fromAddress).Return(commonclient.Successful, nil).Once()
+
+			require.NoError(t, ec.RebroadcastWhereNecessary(ctx, currentHead))
+
+			etx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+			require.NoError(t, err)
+			require.Len(t, etx.TxAttempts, tc.expectedAttempts)
+
+			spends, err := txStore.FeeBumpSpendsByAddresses(ctx, []gethCommon.Address{fromAddress}, time.Now().Add(-time.Hour), testutils.FixtureChainID)
+			require.NoError(t, err)
+			if tc.expectedAttempts == 1 {
+				assert.Empty(t, spends)
+				return
+			}
+			assert.Equal(t, big.NewInt(10*42-1*42), spends[fromAddress])
+		})
+	}
+}
+
 func TestEthConfirmer_RebroadcastWhereNecessary_MaxFeeScenario(t *testing.T) {
 	t.Parallel()
 
@@ -3154,7 +3225,8 @@ func TestEthConfirmer_ProcessStuckTransactions(t *testing.T) {
 	ge := evmcfg.EVM().GasEstimator()
 	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ethKeyStore, feeEstimator)
 	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), evmcfg.EVM().Transactions().AutoPurge(), feeEstimator, txStore, ethClient)
-	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(ge), evmcfg.EVM().Transactions(), cfg.Database(), ethKeyStore, txBuilder, lggr, stuckTxDetector)
+	feeBumpBudget := txmgr.NewFeeBumpBudget(lggr, testutils.FixtureChainID, evmcfg.EVM().Transactions().FeeBumpBudget(), txStore)
+	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(ge), evmcfg.EVM().Transactions(), cfg.Database(), ethKeyStore, txBuilder, lggr, stuckTxDetector, feeBumpBudget)
 	servicetest.Run(t, ec)
 
 	ctx := testutils.Context(t)
@@ -3233,7 +3305,8 @@ func newEthConfirmer(t testing.TB, txStore txmgr.EvmTxStore, ethClient client.Cl
 	}, ge.EIP1559DynamicFees(), ge)
 	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ks, estimator)
 	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), config.EVM().Transactions().AutoPurge(), estimator, txStore, ethClient)
-	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(ge), config.EVM().Transactions(), gconfig.Database(), ks, txBuilder, lggr, stuckTxDetector)
+	feeBumpBudget := txmgr.NewFeeBumpBudget(lggr, testutils.FixtureChainID, config.EVM().Transactions().FeeBumpBudget(), txStore)
+	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(ge), config.EVM().Transactions(), gconfig.Database(), ks, txBuilder, lggr, stuckTxDetector, feeBumpBudget)
 	ec.SetResumeCallback(fn)
 	servicetest.Run(t, ec)
 	return ec
@@ -58,6 +58,10 @@ type TxStoreWebApi interface {
 	FindTxAttempt(ctx context.Context, hash common.Hash) (*TxAttempt, error)
 	FindTxWithAttempts(ctx context.Context, etxID int64) (etx Tx, err error)
 	FindTxsByStateAndFromAddresses(ctx context.Context, addresses []common.Address, state txmgrtypes.TxState, chainID *big.Int) (txs []*Tx, err error)
+	FeeBumpSpendsByAddresses(ctx context.Context, addresses []common.Address, since time.Time, chainID *big.Int) (map[common.Address]*big.Int, error)
+	FeeBumpSpendByJob(ctx context.Context, jobID int32, since time.Time, chainID *big.Int) (*big.Int, error)
+	InsertFeeBumpSpend(ctx context.Context, fromAddress common.Address, etxID int64, jobID *int32, amount *big.Int, chainID *big.Int) error
+	PruneFeeBumpSpends(ctx context.Context, before time.Time, chainID *big.Int) error
 }
 
 type TestEvmTxStore interface {
@@ -197,6 +201,7 @@ type DbEthTx struct {
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+	Priority          txmgrtypes.TxPriority
 }
 
 func (db *DbEthTx) FromTx(tx *Tx) {
@@ -219,6 +224,7 @@ func (db *DbEthTx) FromTx(tx *Tx) {
 	db.InitialBroadcastAt = tx.InitialBroadcastAt
 	db.SignalCallback = tx.SignalCallback
 	db.CallbackCompleted = tx.CallbackCompleted
+	db.Priority = tx.Priority
 
 	if tx.ChainID != nil {
 		db.EVMChainID = *ubig.New(tx.ChainID)
@@ -254,6 +260,7 @@ func (db DbEthTx) ToTx(tx *Tx) {
 	tx.InitialBroadcastAt = db.InitialBroadcastAt
 	tx.SignalCallback = db.SignalCallback
 	tx.CallbackCompleted = db.CallbackCompleted
+	tx.Priority = db.Priority
 }
 
 func dbEthTxsToEvmEthTxs(dbEthTxs []DbEthTx) []Tx {
@@ -529,8 +536,8 @@ func (o *evmTxStore) InsertTx(ctx context.Context, etx *Tx) error {
 	if etx.CreatedAt == (time.Time{}) {
 		etx.CreatedAt = time.Now()
 	}
-	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed) VALUES (
-:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed
+	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed, priority) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed, :priority
 ) RETURNING *`
 	var dbTx DbEthTx
 	dbTx.FromTx(etx)
@@ -1302,13 +1309,18 @@ func (o *evmTxStore) SaveInProgressAttempt(ctx context.Context, attempt *TxAttem
 	dbAttempt.FromTxAttempt(attempt)
 	// Insert is the usual mode because the attempt is new
 	if attempt.ID == 0 {
-		query, args, e := o.q.BindNamed(insertIntoEthTxAttemptsQuery, &dbAttempt)
-		if e != nil {
-			return pkgerrors.Wrap(e, "SaveInProgressAttempt failed to BindNamed")
-		}
-		e = o.q.GetContext(ctx, &dbAttempt, query, args...)
-		dbAttempt.ToTxAttempt(attempt)
-		return pkgerrors.Wrap(e, "SaveInProgressAttempt failed to insert into evm.tx_attempts")
+		return o.Transact(ctx, false, func(orm *evmTxStore) error {
+			query, args, e := orm.q.BindNamed(insertIntoEthTxAttemptsQuery, &dbAttempt)
+			if e != nil {
+				return pkgerrors.Wrap(e, "SaveInProgressAttempt failed to BindNamed")
+			}
+			e = orm.q.GetContext(ctx, &dbAttempt, query, args...)
+			dbAttempt.ToTxAttempt(attempt)
+			if e != nil {
+				return pkgerrors.Wrap(e, "SaveInProgressAttempt failed to insert into evm.tx_attempts")
+			}
+			return orm.insertAttemptFeeBumpSpend(ctx, attempt)
+		})
 	}
 	// Update only applies to case of insufficient eth and simply changes the state to in_progress
 	res, err := o.q.ExecContext(ctx, `UPDATE evm.tx_attempts SET state=$1, broadcast_before_block_num=$2 WHERE id=$3`, dbAttempt.State, dbAttempt.BroadcastBeforeBlockNum, dbAttempt.ID)
@@ -1556,17 +1568,20 @@ func (o *evmTxStore) SaveReplacementInProgressAttempt(ctx context.Context, oldAt
 		}
 		e = orm.q.GetContext(ctx, &dbAttempt, query, args...)
 		dbAttempt.ToTxAttempt(replacementAttempt)
-		return pkgerrors.Wrap(e, "saveReplacementInProgressAttempt failed to insert replacement attempt")
+		if e != nil {
+			return pkgerrors.Wrap(e, "saveReplacementInProgressAttempt failed to insert replacement attempt")
+		}
+		return orm.insertAttemptFeeBumpSpend(ctx, replacementAttempt)
 	})
 }
 
-// Finds earliest saved transaction that has yet to be broadcast from the given address
+// Finds earliest saved transaction of the highest priority class that has yet to be broadcast from the given address
 func (o *evmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int) (*Tx, error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
 	var dbEtx DbEthTx
-	err := o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
+	err := o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY priority DESC, value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
 	etx := new(Tx)
 	dbEtx.ToTx(etx)
 	if err != nil {
@@ -1784,6 +1799,27 @@ func (o *evmTxStore) CountUnstartedTransactions(ctx context.Context, fromAddress
 	return o.countTransactionsWithState(ctx, fromAddress, txmgr.TxUnstarted, chainID)
 }
 
+// CountUnstartedTransactionsByPriority returns the number of unstarted transactions for each priority class
+func (o *evmTxStore) CountUnstartedTransactionsByPriority(ctx context.Context, fromAddress common.Address, chainID *big.Int) (counts map[txmgrtypes.TxPriority]uint32, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	var rows []struct {
+		Priority txmgrtypes.TxPriority
+		Count    uint32
+	}
+	err = o.q.SelectContext(ctx, &rows, `SELECT priority, count(*) FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 GROUP BY priority`,
+		fromAddress, chainID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to CountUnstartedTransactionsByPriority: %w", err)
+	}
+	counts = make(map[txmgrtypes.TxPriority]uint32, len(rows))
+	for _, r := range rows {
+		counts[r.Priority] = r.Count
+	}
+	return counts, nil
+}
+
 func (o *evmTxStore) CheckTxQueueCapacity(ctx context.Context, fromAddress common.Address, maxQueuedTransactions uint64, chainID *big.Int) (err error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
@@ -1822,12 +1858,12 @@ func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest,
 			}
 		}
 		err = orm.q.GetContext(ctx, &dbEtx, `
-INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback)
+INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback, priority)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13,$14
 )
 RETURNING "txes".*
-`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback)
+`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback, txRequest.Priority)
 		if err != nil {
 			return pkgerrors.Wrap(err, "CreateEthTransaction failed to insert evm tx")
 		}
@@ -1866,6 +1902,34 @@ id < (
 	return
 }
 
+func (o *evmTxStore) PruneUnstartedTxQueueByPriority(ctx context.Context, queueSize uint32, subject uuid.UUID, priority txmgrtypes.TxPriority) (ids []int64, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.Transact(ctx, false, func(orm *evmTxStore) error {
+		err := orm.q.SelectContext(ctx, &ids, `
+DELETE FROM evm.txes
+WHERE state = 'unstarted' AND subject = $1 AND priority = $2 AND
+id < (
+	SELECT min(id) FROM (
+		SELECT id
+		FROM evm.txes
+		WHERE state = 'unstarted' AND subject = $1 AND priority = $2
+		ORDER BY id DESC
+		LIMIT $3
+	) numbers
+) RETURNING id`, subject, priority, queueSize)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("PruneUnstartedTxQueueByPriority failed: %w", err)
+		}
+		return err
+	})
+	return
+}
+
 func (o *evmTxStore) ReapTxHistory(ctx context.Context, minBlockNumberToKeep int64, timeThreshold time.Time, chainID *big.Int) error {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
@@ -2049,3 +2113,72 @@ func (o *evmTxStore) UpdateTxAttemptBroadcastBeforeBlockNum(ctx context.Context,
 	_, err := o.q.ExecContext(ctx, sql, blockNum, id)
 	return err
 }
+
+// FeeBumpSpendsByAddresses returns the fees spent on bumping txs from each of the addresses since the given time.
+// Addresses without any spend are omitted.
+func (o *evmTxStore) FeeBumpSpendsByAddresses(ctx context.Context, addresses []common.Address, since time.Time, chainID *big.Int) (map[common.Address]*big.Int, error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	addrsBytea := make([][]byte, len(addresses))
+	for i, addr := range addresses {
+		addrsBytea[i] = addr.Bytes()
+	}
+	var rows []struct {
+		FromAddress common.Address
+		Spent       ubig.Big
+	}
+	err := o.q.SelectContext(ctx, &rows, `SELECT from_address, SUM(amount) AS spent FROM evm.fee_bump_spends
+WHERE from_address = ANY($1) AND created_at > $2 AND evm_chain_id = $3
+GROUP BY from_address`, addrsBytea, since, chainID.String())
+	if err != nil {
+		return nil, fmt.Errorf("FeeBumpSpendsByAddresses failed: %w", err)
+	}
+	spends := make(map[common.Address]*big.Int, len(rows))
+	for _, r := range rows {
+		spends[r.FromAddress] = r.Spent.ToInt()
+	}
+	return spends, nil
+}
+
+// FeeBumpSpendByJob returns the fees spent on bumping txs of the job, from any address, since the given time.
+func (o *evmTxStore) FeeBumpSpendByJob(ctx context.Context, jobID int32, since time.Time, chainID *big.Int) (*big.Int, error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	var spent ubig.Big
+	err := o.q.GetContext(ctx, &spent, `SELECT COALESCE(SUM(amount), 0) FROM evm.fee_bump_spends WHERE job_id = $1 AND created_at > $2 AND evm_chain_id = $3`, jobID, since, chainID.String())
+	if err != nil {
+		return nil, fmt.Errorf("FeeBumpSpendByJob failed: %w", err)
+	}
+	return spent.ToInt(), nil
+}
+
+// InsertFeeBumpSpend records the fees spent on bumping a tx.
+func (o *evmTxStore) InsertFeeBumpSpend(ctx context.Context, fromAddress common.Address, etxID int64, jobID *int32, amount *big.Int, chainID *big.Int) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	_, err := o.q.ExecContext(ctx, `INSERT INTO evm.fee_bump_spends (evm_chain_id, from_address, eth_tx_id, job_id, amount, created_at)
+VALUES ($1, $2, $3, $4, $5, NOW())`, chainID.String(), fromAddress, etxID, jobID, amount.String())
+	return pkgerrors.Wrap(err, "InsertFeeBumpSpend failed")
+}
+
+// insertAttemptFeeBumpSpend records the fee bump spend carried by a bumped attempt, if any. It must be called in the
+// transaction saving the attempt, so that only spends of saved attempts count against the budget.
+func (o *evmTxStore) insertAttemptFeeBumpSpend(ctx context.Context, attempt *TxAttempt) error {
+	if attempt.FeeBumpSpend == nil {
+		return nil
+	}
+	spend := attempt.FeeBumpSpend
+	return o.InsertFeeBumpSpend(ctx, attempt.Tx.FromAddress, attempt.TxID, spend.JobID, spend.Amount, attempt.Tx.ChainID)
+}
+
+// PruneFeeBumpSpends deletes the fee bump spends recorded before the given time.
+func (o *evmTxStore) PruneFeeBumpSpends(ctx context.Context, before time.Time, chainID *big.Int) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	_, err := o.q.ExecContext(ctx, `DELETE FROM evm.fee_bump_spends WHERE created_at <= $1 AND evm_chain_id = $2`, before, chainID.String())
+	return pkgerrors.Wrap(err, "PruneFeeBumpSpends failed")
+}
@@ -1028,6 +1028,26 @@ func TestORM_SaveInProgressAttempt(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, txmgrtypes.TxAttemptInProgress, attemptResult.State)
 	})
+
+	t.Run("records the fee bump spend of the attempt only if the attempt is saved", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTx(t, txStore, 2, fromAddress)
+
+		attempt := cltest.NewLegacyEthTxAttempt(t, etx.ID)
+		attempt.Tx = etx
+		attempt.FeeBumpSpend = &txmgr.FeeBumpSpend{Amount: big.NewInt(42)}
+		require.NoError(t, txStore.SaveInProgressAttempt(ctx, &attempt))
+
+		// an attempt with the same hash cannot be saved
+		duplicate := cltest.NewLegacyEthTxAttempt(t, etx.ID)
+		duplicate.Hash = attempt.Hash
+		duplicate.Tx = etx
+		duplicate.FeeBumpSpend = &txmgr.FeeBumpSpend{Amount: big.NewInt(100)}
+		require.Error(t, txStore.SaveInProgressAttempt(ctx, &duplicate))
+
+		spends, err := txStore.FeeBumpSpendsByAddresses(ctx, []common.Address{fromAddress}, time.Now().Add(-time.Hour), testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), spends[fromAddress])
+	})
 }
 
 func TestORM_FindTxsRequiringGasBump(t *testing.T) {
@@ -1241,6 +1261,21 @@ func TestORM_SaveReplacementInProgressAttempt(t *testing.T) {
 		assert.Len(t, etx.TxAttempts, 1)
 		require.Equal(t, etx.TxAttempts[0].Hash, newAttempt.Hash)
 	})
+
+	t.Run("records the fee bump spend of the replacement attempt", func(t *testing.T) {
+		etx := mustInsertInProgressEthTxWithAttempt(t, txStore, 124, fromAddress)
+		oldAttempt := etx.TxAttempts[0]
+
+		jobID := int32(testutils.NewRandomPositiveInt64() % 1_000_000)
+		newAttempt := cltest.NewDynamicFeeEthTxAttempt(t, etx.ID)
+		newAttempt.Tx = etx
+		newAttempt.FeeBumpSpend = &txmgr.FeeBumpSpend{JobID: &jobID, Amount: big.NewInt(42)}
+		require.NoError(t, txStore.SaveReplacementInProgressAttempt(ctx, oldAttempt, &newAttempt))
+
+		spent, err := txStore.FeeBumpSpendByJob(ctx, jobID, time.Now().Add(-time.Hour), etx.ChainID)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), spent)
+	})
 }
 
 func TestORM_FindNextUnstartedTransactionFromAddress(t *testing.T) {
@@ -1267,6 +1302,19 @@ func TestORM_FindNextUnstartedTransactionFromAddress(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, resultEtx)
 	})
+
+	t.Run("finds unstarted tx of the highest priority first", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, txRequestWithPriority(txmgrtypes.TxPriorityLow))
+		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID)
+		critical := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, txRequestWithPriority(txmgrtypes.TxPriorityCritical))
+		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, txRequestWithPriority(txmgrtypes.TxPriorityHigh))
+
+		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(testutils.Context(t), fromAddress, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		assert.Equal(t, critical.ID, resultEtx.ID)
+		assert.Equal(t, txmgrtypes.TxPriorityCritical, resultEtx.Priority)
+	})
 }
 
 func TestORM_UpdateTxFatalError(t *testing.T) {
@@ -1620,6 +1668,30 @@ func TestORM_CountUnstartedTransactions(t *testing.T) {
 	assert.Equal(t, int(count), 2)
 }
 
+func TestORM_CountUnstartedTransactionsByPriority(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, otherAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID)
+	mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, txRequestWithPriority(txmgrtypes.TxPriorityLow))
+	mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, txRequestWithPriority(txmgrtypes.TxPriorityLow))
+	mustCreateUnstartedGeneratedTx(t, txStore, otherAddress, &cltest.FixtureChainID, txRequestWithPriority(txmgrtypes.TxPriorityCritical))
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 2, fromAddress)
+
+	counts, err := txStore.CountUnstartedTransactionsByPriority(testutils.Context(t), fromAddress, &cltest.FixtureChainID)
+	require.NoError(t, err)
+	assert.Equal(t, map[txmgrtypes.TxPriority]uint32{
+		txmgrtypes.TxPriorityLow:    2,
+		txmgrtypes.TxPriorityNormal: 1,
+	}, counts)
+}
+
 func TestORM_CheckTxQueueCapacity(t *testing.T) {
 	t.Parallel()
 
@@ -1842,6 +1914,26 @@ func TestORM_PruneUnstartedTxQueue(t *testing.T) {
 	})
 }
 
+func TestORM_PruneUnstartedTxQueueByPriority(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := txmgr.NewTxStore(db, logger.Test(t))
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	subject := uuid.New()
+	low := txmgrcommon.NewPriorityStrategy(subject, txmgrtypes.TxPriorityLow, uint32(2))
+	high := txmgrcommon.NewPriorityStrategy(subject, txmgrtypes.TxPriorityHigh, uint32(4))
+	for i := 0; i < 5; i++ {
+		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, txRequestWithStrategy(low), txRequestWithPriority(low.Priority()))
+		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, txRequestWithStrategy(high), txRequestWithPriority(high.Priority()))
+	}
+
+	// each class is pruned to its own queue size-1, without affecting the other class
+	AssertCountPerSubject(t, txStore, int64(1+3), subject)
+}
+
 func TestORM_FindTxesWithAttemptsAndReceiptsByIdsAndState(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,149 @@
+package txmgr
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+)
+
+type feeBumpBudgetTxStore interface {
+	FeeBumpSpendsByAddresses(ctx context.Context, addresses []common.Address, since time.Time, chainID *big.Int) (map[common.Address]*big.Int, error)
+	FeeBumpSpendByJob(ctx context.Context, jobID int32, since time.Time, chainID *big.Int) (*big.Int, error)
+	PruneFeeBumpSpends(ctx context.Context, before time.Time, chainID *big.Int) error
+}
+
+type feeBumpBudgetConfig interface {
+	Enabled() bool
+	Window() time.Duration
+	MaxPerKey() *assets.Wei
+}
+
+type feeBumpBudget struct {
+	lggr    logger.SugaredLogger
+	chainID *big.Int
+	cfg     feeBumpBudgetConfig
+	txStore feeBumpBudgetTxStore
+
+	pruneMu    sync.Mutex
+	lastPruned time.Time
+}
+
+var _ FeeBumpBudget = (*feeBumpBudget)(nil)
+
+func NewFeeBumpBudget(lggr logger.Logger, chainID *big.Int, cfg feeBumpBudgetConfig, txStore feeBumpBudgetTxStore) *feeBumpBudget {
+	return &feeBumpBudget{
+		lggr:    logger.Sugared(logger.Named(lggr, "FeeBumpBudget")),
+		chainID: chainID,
+		cfg:     cfg,
+		txStore: txStore,
+	}
+}
+
+// CheckBump checks the bump against the budget of the sending key and, if the tx meta sets one, the budget of the job
+// which created the tx. The returned spend is recorded once the bumped attempt carrying it is saved.
+func (b *feeBumpBudget) CheckBump(ctx context.Context, etx Tx, previousAttempt, bumpedAttempt TxAttempt) (*FeeBumpSpend, error) {
+	if !b.cfg.Enabled() {
+		return nil, nil
+	}
+	spend := BumpSpend(previousAttempt, bumpedAttempt)
+	if spend.Sign() <= 0 {
+		return nil, nil
+	}
+	window := b.cfg.Window()
+	since := time.Now().Add(-window)
+	b.pruneOldSpends(ctx, window)
+
+	if maxPerKey := b.cfg.MaxPerKey(); maxPerKey != nil && !maxPerKey.IsZero() {
+		spends, err := b.txStore.FeeBumpSpendsByAddresses(ctx, []common.Address{etx.FromAddress}, since, b.chainID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load fee bump spend of key: %w", err)
+		}
+		spent := spends[etx.FromAddress]
+		if spent == nil {
+			spent = big.NewInt(0)
+		}
+		if new(big.Int).Add(spent, spend).Cmp(maxPerKey.ToInt()) > 0 {
+			return nil, fmt.Errorf("bump would spend %s on top of the %s key %s spent in the last %s, exceeding its budget of %s: %w",
+				assets.NewWei(spend), assets.NewWei(spent), etx.FromAddress, window, maxPerKey, commonfee.ErrBumpFeeExceedsBudget)
+		}
+	}
+
+	meta, err := etx.GetMeta()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode tx meta: %w", err)
+	}
+	var jobID *int32
+	if meta != nil {
+		jobID = meta.JobID
+	}
+	if jobBudget := b.jobBudget(etx, meta); jobID != nil && jobBudget != nil {
+		spent, err := b.txStore.FeeBumpSpendByJob(ctx, *jobID, since, b.chainID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load fee bump spend of job: %w", err)
+		}
+		if new(big.Int).Add(spent, spend).Cmp(jobBudget) > 0 {
+			return nil, fmt.Errorf("bump would spend %s on top of the %s job %d spent in the last %s, exceeding its budget of %s: %w",
+				assets.NewWei(spend), assets.NewWei(spent), *jobID, window, assets.NewWei(jobBudget), commonfee.ErrBumpFeeExceedsBudget)
+		}
+	}
+
+	return &FeeBumpSpend{JobID: jobID, Amount: spend}, nil
+}
+
+// jobBudget returns the budget set in the tx meta, or nil if there is none
+func (b *feeBumpBudget) jobBudget(etx Tx, meta *TxMeta) *big.Int {
+	if meta == nil || meta.FeeBumpBudget == nil {
+		return nil
+	}
+	if meta.JobID == nil {
+		b.lggr.Warnw("Ignoring FeeBumpBudget in tx meta of tx without a JobID", "etxID", etx.ID)
+		return nil
+	}
+	budget, ok := new(big.Int).SetString(*meta.FeeBumpBudget, 10)
+	if !ok || budget.Sign() < 0 {
+		b.lggr.Errorw("Ignoring invalid FeeBumpBudget in tx meta, it must be a non-negative amount of wei", "etxID", etx.ID, "jobID", *meta.JobID, "feeBumpBudget", *meta.FeeBumpBudget)
+		return nil
+	}
+	return budget
+}
+
+// pruneOldSpends deletes the spends which fell out of the window, at most once per window
+func (b *feeBumpBudget) pruneOldSpends(ctx context.Context, window time.Duration) {
+	b.pruneMu.Lock()
+	defer b.pruneMu.Unlock()
+	now := time.Now()
+	if now.Sub(b.lastPruned) < window {
+		return
+	}
+	if err := b.txStore.PruneFeeBumpSpends(ctx, now.Add(-window), b.chainID); err != nil {
+		b.lggr.Warnw("Failed to prune old fee bump spends", "err", err)
+		return
+	}
+	b.lastPruned = now
+}
+
+// BumpSpend returns the increase in the maximum fee of a tx, i.e. its gas price (or fee cap) multiplied by its gas
+// limit, from replacing the previous attempt with the bumped attempt.
+func BumpSpend(previousAttempt, bumpedAttempt TxAttempt) *big.Int {
+	spend := maxFee(bumpedAttempt)
+	return spend.Sub(spend, maxFee(previousAttempt))
+}
+
+func maxFee(attempt TxAttempt) *big.Int {
+	price := attempt.TxFee.Legacy
+	if attempt.TxFee.ValidDynamic() {
+		price = attempt.TxFee.DynamicFeeCap
+	}
+	if price == nil {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Mul(price.ToInt(), new(big.Int).SetUint64(attempt.ChainSpecificFeeLimit))
+}
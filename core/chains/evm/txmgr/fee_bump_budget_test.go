@@ -0,0 +1,169 @@
+package txmgr_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func TestFeeBumpBudget_Disabled(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ctx := testutils.Context(t)
+	fromAddress := testutils.NewAddress()
+
+	budget := txmgr.NewFeeBumpBudget(logger.Test(t), testutils.FixtureChainID, testFeeBumpBudgetConfig{enabled: false, window: time.Hour, maxPerKey: assets.NewWeiI(1)}, txStore)
+
+	etx := txmgr.Tx{ID: 1, FromAddress: fromAddress}
+	spend, err := budget.CheckBump(ctx, etx, legacyAttempt(1, 42), legacyAttempt(10, 42))
+	require.NoError(t, err)
+	assert.Nil(t, spend)
+
+	spends, err := txStore.FeeBumpSpendsByAddresses(ctx, []common.Address{fromAddress}, time.Now().Add(-time.Hour), testutils.FixtureChainID)
+	require.NoError(t, err)
+	assert.Empty(t, spends)
+}
+
+func TestFeeBumpBudget_CheckBump(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+
+	t.Run("accounts for bumps within the budget of the key, and rejects those exceeding it", func(t *testing.T) {
+		txStore := cltest.NewTestTxStore(t, db)
+		fromAddress := testutils.NewAddress()
+		budget := txmgr.NewFeeBumpBudget(logger.Test(t), testutils.FixtureChainID, testFeeBumpBudgetConfig{enabled: true, window: time.Hour, maxPerKey: assets.NewWeiI(500)}, txStore)
+
+		etx := txmgr.Tx{ID: 1, FromAddress: fromAddress}
+		// spends 9 wei * 42 gas
+		spend, err := budget.CheckBump(ctx, etx, legacyAttempt(1, 42), legacyAttempt(10, 42))
+		require.NoError(t, err)
+		assert.Equal(t, &txmgr.FeeBumpSpend{Amount: big.NewInt(378)}, spend)
+
+		// nothing is accounted for until the bumped attempt is saved
+		spends, err := txStore.FeeBumpSpendsByAddresses(ctx, []common.Address{fromAddress}, time.Now().Add(-time.Hour), testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.Empty(t, spends)
+		recordSpend(t, txStore, etx, spend)
+
+		// spends another 9 wei * 42 gas, which exceeds the budget of 500 wei
+		spend, err = budget.CheckBump(ctx, etx, legacyAttempt(10, 42), legacyAttempt(19, 42))
+		require.ErrorIs(t, err, commonfee.ErrBumpFeeExceedsBudget)
+		assert.True(t, commonfee.IsBumpErr(err))
+		assert.Nil(t, spend)
+
+		// other keys have their own budget
+		otherEtx := txmgr.Tx{ID: 2, FromAddress: testutils.NewAddress()}
+		_, err = budget.CheckBump(ctx, otherEtx, legacyAttempt(10, 42), legacyAttempt(19, 42))
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects bumps exceeding the budget of the job set in the tx meta", func(t *testing.T) {
+		txStore := cltest.NewTestTxStore(t, db)
+		// keys have no budget, so only the budget of the job applies
+		budget := txmgr.NewFeeBumpBudget(logger.Test(t), testutils.FixtureChainID, testFeeBumpBudgetConfig{enabled: true, window: time.Hour, maxPerKey: assets.NewWeiI(0)}, txStore)
+
+		jobID := int32(testutils.NewRandomPositiveInt64() % 1_000_000)
+		jobBudget := "500"
+		etx := txmgr.Tx{ID: 1, FromAddress: testutils.NewAddress(), Meta: txMetaJSON(t, txmgr.TxMeta{JobID: &jobID, FeeBumpBudget: &jobBudget})}
+		spend, err := budget.CheckBump(ctx, etx, legacyAttempt(1, 42), legacyAttempt(10, 42))
+		require.NoError(t, err)
+		assert.Equal(t, &txmgr.FeeBumpSpend{JobID: &jobID, Amount: big.NewInt(378)}, spend)
+		recordSpend(t, txStore, etx, spend)
+
+		// the budget of the job is shared by all keys
+		otherEtx := txmgr.Tx{ID: 2, FromAddress: testutils.NewAddress(), Meta: etx.Meta}
+		_, err = budget.CheckBump(ctx, otherEtx, legacyAttempt(10, 42), legacyAttempt(19, 42))
+		require.ErrorIs(t, err, commonfee.ErrBumpFeeExceedsBudget)
+	})
+
+	t.Run("ignores invalid budgets in the tx meta", func(t *testing.T) {
+		txStore := cltest.NewTestTxStore(t, db)
+		budget := txmgr.NewFeeBumpBudget(logger.Test(t), testutils.FixtureChainID, testFeeBumpBudgetConfig{enabled: true, window: time.Hour, maxPerKey: assets.NewWeiI(0)}, txStore)
+
+		jobID := int32(testutils.NewRandomPositiveInt64() % 1_000_000)
+		jobBudget := "1 ether"
+		etx := txmgr.Tx{ID: 1, FromAddress: testutils.NewAddress(), Meta: txMetaJSON(t, txmgr.TxMeta{JobID: &jobID, FeeBumpBudget: &jobBudget})}
+		_, err := budget.CheckBump(ctx, etx, legacyAttempt(1, 42), legacyAttempt(10, 42))
+		require.NoError(t, err)
+	})
+
+	t.Run("only accounts for spends within the window", func(t *testing.T) {
+		txStore := cltest.NewTestTxStore(t, db)
+		fromAddress := testutils.NewAddress()
+		budget := txmgr.NewFeeBumpBudget(logger.Test(t), testutils.FixtureChainID, testFeeBumpBudgetConfig{enabled: true, window: time.Hour, maxPerKey: assets.NewWeiI(500)}, txStore)
+
+		require.NoError(t, txStore.InsertFeeBumpSpend(ctx, fromAddress, 1, nil, big.NewInt(400), testutils.FixtureChainID))
+		_, err := db.ExecContext(ctx, `UPDATE evm.fee_bump_spends SET created_at = NOW() - interval '2 hours' WHERE from_address = $1`, fromAddress)
+		require.NoError(t, err)
+
+		etx := txmgr.Tx{ID: 2, FromAddress: fromAddress}
+		_, err = budget.CheckBump(ctx, etx, legacyAttempt(1, 42), legacyAttempt(10, 42))
+		require.NoError(t, err)
+
+		// the spend which fell out of the window was pruned
+		var count int
+		require.NoError(t, db.GetContext(ctx, &count, `SELECT count(*) FROM evm.fee_bump_spends WHERE from_address = $1`, fromAddress))
+		assert.Equal(t, 0, count)
+	})
+}
+
+func TestBumpSpend(t *testing.T) {
+	t.Parallel()
+
+	t.Run("legacy", func(t *testing.T) {
+		assert.Equal(t, big.NewInt(9*42), txmgr.BumpSpend(legacyAttempt(1, 42), legacyAttempt(10, 42)))
+		// a higher gas limit is accounted for as well
+		assert.Equal(t, big.NewInt(10*50-1*42), txmgr.BumpSpend(legacyAttempt(1, 42), legacyAttempt(10, 50)))
+	})
+
+	t.Run("dynamic", func(t *testing.T) {
+		previous := txmgr.TxAttempt{TxType: 0x2, ChainSpecificFeeLimit: 42, TxFee: gas.EvmFee{DynamicFeeCap: assets.NewWeiI(20), DynamicTipCap: assets.NewWeiI(1)}}
+		bumped := txmgr.TxAttempt{TxType: 0x2, ChainSpecificFeeLimit: 42, TxFee: gas.EvmFee{DynamicFeeCap: assets.NewWeiI(30), DynamicTipCap: assets.NewWeiI(2)}}
+		assert.Equal(t, big.NewInt(10*42), txmgr.BumpSpend(previous, bumped))
+	})
+}
+
+// recordSpend records the spend as saving the bumped attempt carrying it does
+func recordSpend(t *testing.T, txStore txmgr.TestEvmTxStore, etx txmgr.Tx, spend *txmgr.FeeBumpSpend) {
+	require.NoError(t, txStore.InsertFeeBumpSpend(testutils.Context(t), etx.FromAddress, etx.ID, spend.JobID, spend.Amount, testutils.FixtureChainID))
+}
+
+func legacyAttempt(gasPrice int64, gasLimit uint64) txmgr.TxAttempt {
+	return txmgr.TxAttempt{ChainSpecificFeeLimit: gasLimit, TxFee: gas.EvmFee{Legacy: assets.NewWeiI(gasPrice)}}
+}
+
+func txMetaJSON(t *testing.T, meta txmgr.TxMeta) *sqlutil.JSON {
+	b, err := json.Marshal(meta)
+	require.NoError(t, err)
+	j := sqlutil.JSON(b)
+	return &j
+}
+
+type testFeeBumpBudgetConfig struct {
+	enabled   bool
+	window    time.Duration
+	maxPerKey *assets.Wei
+}
+
+func (t testFeeBumpBudgetConfig) Enabled() bool          { return t.enabled }
+func (t testFeeBumpBudgetConfig) Window() time.Duration  { return t.window }
+func (t testFeeBumpBudgetConfig) MaxPerKey() *assets.Wei { return t.maxPerKey }
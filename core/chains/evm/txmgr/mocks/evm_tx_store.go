@@ -153,6 +153,36 @@ func (_m *EvmTxStore) CountUnstartedTransactions(ctx context.Context, fromAddres
 	return r0, r1
 }
 
+// CountUnstartedTransactionsByPriority provides a mock function with given fields: ctx, fromAddress, chainID
+func (_m *EvmTxStore) CountUnstartedTransactionsByPriority(ctx context.Context, fromAddress common.Address, chainID *big.Int) (map[types.TxPriority]uint32, error) {
+	ret := _m.Called(ctx, fromAddress, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnstartedTransactionsByPriority")
+	}
+
+	var r0 map[types.TxPriority]uint32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) (map[types.TxPriority]uint32, error)); ok {
+		return rf(ctx, fromAddress, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) map[types.TxPriority]uint32); ok {
+		r0 = rf(ctx, fromAddress, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[types.TxPriority]uint32)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int) error); ok {
+		r1 = rf(ctx, fromAddress, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateTransaction provides a mock function with given fields: ctx, txRequest, chainID
 func (_m *EvmTxStore) CreateTransaction(ctx context.Context, txRequest types.TxRequest[common.Address, common.Hash], chainID *big.Int) (types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, txRequest, chainID)
@@ -199,6 +229,66 @@ func (_m *EvmTxStore) DeleteInProgressAttempt(ctx context.Context, attempt types
 	return r0
 }
 
+// FeeBumpSpendByJob provides a mock function with given fields: ctx, jobID, since, chainID
+func (_m *EvmTxStore) FeeBumpSpendByJob(ctx context.Context, jobID int32, since time.Time, chainID *big.Int) (*big.Int, error) {
+	ret := _m.Called(ctx, jobID, since, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeeBumpSpendByJob")
+	}
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, time.Time, *big.Int) (*big.Int, error)); ok {
+		return rf(ctx, jobID, since, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, time.Time, *big.Int) *big.Int); ok {
+		r0 = rf(ctx, jobID, since, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, time.Time, *big.Int) error); ok {
+		r1 = rf(ctx, jobID, since, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeeBumpSpendsByAddresses provides a mock function with given fields: ctx, addresses, since, chainID
+func (_m *EvmTxStore) FeeBumpSpendsByAddresses(ctx context.Context, addresses []common.Address, since time.Time, chainID *big.Int) (map[common.Address]*big.Int, error) {
+	ret := _m.Called(ctx, addresses, since, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeeBumpSpendsByAddresses")
+	}
+
+	var r0 map[common.Address]*big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []common.Address, time.Time, *big.Int) (map[common.Address]*big.Int, error)); ok {
+		return rf(ctx, addresses, since, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []common.Address, time.Time, *big.Int) map[common.Address]*big.Int); ok {
+		r0 = rf(ctx, addresses, since, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[common.Address]*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []common.Address, time.Time, *big.Int) error); ok {
+		r1 = rf(ctx, addresses, since, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindEarliestUnconfirmedBroadcastTime provides a mock function with given fields: ctx, chainID
 func (_m *EvmTxStore) FindEarliestUnconfirmedBroadcastTime(ctx context.Context, chainID *big.Int) (null.Time, error) {
 	ret := _m.Called(ctx, chainID)
@@ -999,6 +1089,24 @@ func (_m *EvmTxStore) HasInProgressTransaction(ctx context.Context, account comm
 	return r0, r1
 }
 
+// InsertFeeBumpSpend provides a mock function with given fields: ctx, fromAddress, etxID, jobID, amount, chainID
+func (_m *EvmTxStore) InsertFeeBumpSpend(ctx context.Context, fromAddress common.Address, etxID int64, jobID *int32, amount *big.Int, chainID *big.Int) error {
+	ret := _m.Called(ctx, fromAddress, etxID, jobID, amount, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertFeeBumpSpend")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, int64, *int32, *big.Int, *big.Int) error); ok {
+		r0 = rf(ctx, fromAddress, etxID, jobID, amount, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // IsTxFinalized provides a mock function with given fields: ctx, blockHeight, txID, chainID
 func (_m *EvmTxStore) IsTxFinalized(ctx context.Context, blockHeight int64, txID int64, chainID *big.Int) (bool, error) {
 	ret := _m.Called(ctx, blockHeight, txID, chainID)
@@ -1099,6 +1207,24 @@ func (_m *EvmTxStore) PreloadTxes(ctx context.Context, attempts []types.TxAttemp
 	return r0
 }
 
+// PruneFeeBumpSpends provides a mock function with given fields: ctx, before, chainID
+func (_m *EvmTxStore) PruneFeeBumpSpends(ctx context.Context, before time.Time, chainID *big.Int) error {
+	ret := _m.Called(ctx, before, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneFeeBumpSpends")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, *big.Int) error); ok {
+		r0 = rf(ctx, before, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PruneUnstartedTxQueue provides a mock function with given fields: ctx, queueSize, subject
 func (_m *EvmTxStore) PruneUnstartedTxQueue(ctx context.Context, queueSize uint32, subject uuid.UUID) ([]int64, error) {
 	ret := _m.Called(ctx, queueSize, subject)
@@ -1129,6 +1255,36 @@ func (_m *EvmTxStore) PruneUnstartedTxQueue(ctx context.Context, queueSize uint3
 	return r0, r1
 }
 
+// PruneUnstartedTxQueueByPriority provides a mock function with given fields: ctx, queueSize, subject, priority
+func (_m *EvmTxStore) PruneUnstartedTxQueueByPriority(ctx context.Context, queueSize uint32, subject uuid.UUID, priority types.TxPriority) ([]int64, error) {
+	ret := _m.Called(ctx, queueSize, subject, priority)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneUnstartedTxQueueByPriority")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uuid.UUID, types.TxPriority) ([]int64, error)); ok {
+		return rf(ctx, queueSize, subject, priority)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uuid.UUID, types.TxPriority) []int64); ok {
+		r0 = rf(ctx, queueSize, subject, priority)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uuid.UUID, types.TxPriority) error); ok {
+		r1 = rf(ctx, queueSize, subject, priority)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReapTxHistory provides a mock function with given fields: ctx, minBlockNumberToKeep, timeThreshold, chainID
 func (_m *EvmTxStore) ReapTxHistory(ctx context.Context, minBlockNumberToKeep int64, timeThreshold time.Time, chainID *big.Int) error {
 	ret := _m.Called(ctx, minBlockNumberToKeep, timeThreshold, chainID)
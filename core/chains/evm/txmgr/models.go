@@ -39,6 +39,8 @@ type (
 	Receipt                = dbReceipt // EvmReceipt is the exported DB table model for receipts
 	ReceiptPlus            = txmgrtypes.ReceiptPlus[*evmtypes.Receipt]
 	StuckTxDetector        = txmgrtypes.StuckTxDetector[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	FeeBumpBudget          = txmgrtypes.FeeBumpBudget[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	FeeBumpSpend           = txmgrtypes.FeeBumpSpend
 	TxmClient              = txmgrtypes.TxmClient[*big.Int, common.Address, common.Hash, common.Hash, *evmtypes.Receipt, evmtypes.Nonce, gas.EvmFee]
 	TransactionClient      = txmgrtypes.TransactionClient[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
 	ChainReceipt           = txmgrtypes.ChainReceipt[common.Hash, common.Hash]
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 )
@@ -49,3 +50,37 @@ func Test_DropOldestStrategy_PruneQueue(t *testing.T) {
 		assert.Equal(t, []int64{1, 2}, ids)
 	})
 }
+
+func Test_PriorityStrategy(t *testing.T) {
+	t.Parallel()
+
+	subject := uuid.New()
+	s := txmgrcommon.NewPriorityStrategy(subject, txmgrtypes.TxPriorityHigh, 1)
+
+	assert.True(t, s.Subject().Valid)
+	assert.Equal(t, subject, s.Subject().UUID)
+	assert.Equal(t, txmgrtypes.TxPriorityHigh, s.Priority())
+}
+
+func Test_PriorityStrategy_PruneQueue(t *testing.T) {
+	t.Parallel()
+	subject := uuid.New()
+	queueSize := uint32(3)
+
+	t.Run("calls PruneUnstartedTxQueueByPriority for the given subject, priority and queueSize", func(t *testing.T) {
+		mockTxStore := mocks.NewEvmTxStore(t)
+		strategy := txmgrcommon.NewPriorityStrategy(subject, txmgrtypes.TxPriorityLow, queueSize)
+		mockTxStore.On("PruneUnstartedTxQueueByPriority", mock.Anything, queueSize-1, subject, txmgrtypes.TxPriorityLow).Once().Return([]int64{3}, nil)
+		ids, err := strategy.PruneQueue(testutils.Context(t), mockTxStore)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3}, ids)
+	})
+
+	t.Run("does not prune if the queue is unbounded", func(t *testing.T) {
+		mockTxStore := mocks.NewEvmTxStore(t)
+		strategy := txmgrcommon.NewPriorityStrategy(subject, txmgrtypes.TxPriorityLow, 0)
+		ids, err := strategy.PruneQueue(testutils.Context(t), mockTxStore)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+}
@@ -56,7 +56,7 @@ type TestEvmConfig struct {
 }
 
 func (e *TestEvmConfig) Transactions() evmconfig.Transactions {
-	return &transactionsConfig{e: e, autoPurge: &autoPurgeConfig{}}
+	return &transactionsConfig{e: e, autoPurge: &autoPurgeConfig{}, feeBumpBudget: &feeBumpBudgetConfig{}}
 }
 
 func (e *TestEvmConfig) NonceAutoSync() bool { return true }
@@ -73,6 +73,10 @@ func (g *TestGasEstimatorConfig) BlockHistory() evmconfig.BlockHistory {
 	return &TestBlockHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
+	return &TestFeeHistoryConfig{}
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 42 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 42 }
@@ -120,19 +124,28 @@ func (b *TestBlockHistoryConfig) BlockHistorySize() uint16          { return 42
 func (b *TestBlockHistoryConfig) EIP1559FeeCapBufferBlocks() uint16 { return 42 }
 func (b *TestBlockHistoryConfig) TransactionPercentile() uint16     { return 42 }
 
+type TestFeeHistoryConfig struct {
+	evmconfig.FeeHistory
+}
+
+func (f *TestFeeHistoryConfig) BlockWindow() uint16      { return 42 }
+func (f *TestFeeHistoryConfig) RewardPercentile() uint16 { return 42 }
+
 type transactionsConfig struct {
 	evmconfig.Transactions
-	e         *TestEvmConfig
-	autoPurge evmconfig.AutoPurgeConfig
+	e             *TestEvmConfig
+	autoPurge     evmconfig.AutoPurgeConfig
+	feeBumpBudget evmconfig.FeeBumpBudgetConfig
 }
 
-func (*transactionsConfig) ForwardersEnabled() bool                { return true }
-func (t *transactionsConfig) MaxInFlight() uint32                  { return t.e.MaxInFlight }
-func (t *transactionsConfig) MaxQueued() uint64                    { return t.e.MaxQueued }
-func (t *transactionsConfig) ReaperInterval() time.Duration        { return t.e.ReaperInterval }
-func (t *transactionsConfig) ReaperThreshold() time.Duration       { return t.e.ReaperThreshold }
-func (t *transactionsConfig) ResendAfterThreshold() time.Duration  { return t.e.ResendAfterThreshold }
-func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig { return t.autoPurge }
+func (*transactionsConfig) ForwardersEnabled() bool                        { return true }
+func (t *transactionsConfig) MaxInFlight() uint32                          { return t.e.MaxInFlight }
+func (t *transactionsConfig) MaxQueued() uint64                            { return t.e.MaxQueued }
+func (t *transactionsConfig) ReaperInterval() time.Duration                { return t.e.ReaperInterval }
+func (t *transactionsConfig) ReaperThreshold() time.Duration               { return t.e.ReaperThreshold }
+func (t *transactionsConfig) ResendAfterThreshold() time.Duration          { return t.e.ResendAfterThreshold }
+func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig         { return t.autoPurge }
+func (t *transactionsConfig) FeeBumpBudget() evmconfig.FeeBumpBudgetConfig { return t.feeBumpBudget }
 
 type autoPurgeConfig struct {
 	evmconfig.AutoPurgeConfig
@@ -140,6 +153,12 @@ type autoPurgeConfig struct {
 
 func (a *autoPurgeConfig) Enabled() bool { return false }
 
+type feeBumpBudgetConfig struct {
+	evmconfig.FeeBumpBudgetConfig
+}
+
+func (b *feeBumpBudgetConfig) Enabled() bool { return false }
+
 type MockConfig struct {
 	EvmConfig           *TestEvmConfig
 	RpcDefaultBatchSize uint32
@@ -203,6 +203,24 @@ func TestTxm_CreateTransaction(t *testing.T) {
 		assert.Equal(t, tx1.GetID(), tx2.GetID())
 	})
 
+	t.Run("assigns the priority of a priority strategy over the requested priority", func(t *testing.T) {
+		evmConfig.MaxQueued = uint64(0)
+		etx, err := txm.CreateTransaction(testutils.Context(t), txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			FeeLimit:       21000,
+			Priority:       txmgrtypes.TxPriorityLow,
+			Strategy:       txmgrcommon.NewPriorityStrategy(uuid.New(), txmgrtypes.TxPriorityCritical, 0),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, txmgrtypes.TxPriorityCritical, etx.Priority)
+
+		var dbEtx txmgr.DbEthTx
+		require.NoError(t, db.Get(&dbEtx, `SELECT * FROM evm.txes WHERE id = $1`, etx.ID))
+		assert.Equal(t, txmgrtypes.TxPriorityCritical, dbEtx.Priority)
+	})
+
 	t.Run("returns error if eth key state is missing or doesn't match chain ID", func(t *testing.T) {
 		rndAddr := testutils.NewAddress()
 		_, err := txm.CreateTransaction(testutils.Context(t), txmgr.TxRequest{
@@ -822,6 +840,12 @@ func txRequestWithStrategy(strategy txmgrtypes.TxStrategy) func(*txmgr.TxRequest
 	}
 }
 
+func txRequestWithPriority(priority txmgrtypes.TxPriority) func(*txmgr.TxRequest) {
+	return func(tx *txmgr.TxRequest) {
+		tx.Priority = priority
+	}
+}
+
 func txRequestWithChecker(checker txmgr.TransmitCheckerSpec) func(*txmgr.TxRequest) {
 	return func(tx *txmgr.TxRequest) {
 		tx.Checker = checker
@@ -245,7 +245,9 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 				LogPrunePageSize:         int64(cfg.EVM().LogPrunePageSize()),
 				BackupPollerBlockDelay:   int64(cfg.EVM().BackupLogPollerBlockDelay()),
 			}
-			logPoller = logpoller.NewLogPoller(logpoller.NewObservedORM(chainID, opts.DS, l), client, l, lpOpts)
+			lp := logpoller.NewLogPoller(logpoller.NewObservedORM(chainID, opts.DS, l), client, l, lpOpts)
+			headTracker.SubscribeReorgs(lp)
+			logPoller = lp
 		}
 	}
 
@@ -265,8 +267,11 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 
 	var balanceMonitor monitor.BalanceMonitor
 	if opts.AppConfig.EVMRPCEnabled() && cfg.EVM().BalanceMonitor().Enabled() {
-		balanceMonitor = monitor.NewBalanceMonitor(client, opts.KeyStore, l)
+		balanceMonitor = monitor.NewBalanceMonitor(client, opts.KeyStore, cfg.EVM().BalanceMonitor(), l)
 		headBroadcaster.Subscribe(balanceMonitor)
+		if cfg.EVM().BalanceMonitor().PauseBelowMinBalance() {
+			txm.RegisterKeyPausedChecker(balanceMonitor.IsBelowMinBalance)
+		}
 	}
 
 	var logBroadcaster log.Broadcaster
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -21,6 +22,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -74,6 +76,50 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 			Action: s.Status,
 			Flags:  []cli.Flag{},
 		},
+		{
+			Name:  "tokens",
+			Usage: "Create, list, or revoke scoped API tokens",
+			Subcommands: cli.Commands{
+				{
+					Name:   "list",
+					Usage:  "Lists all scoped API tokens and their permissions",
+					Action: s.ListAPITokens,
+				},
+				{
+					Name:   "create",
+					Usage:  "Create a new scoped API token",
+					Action: s.CreateAPIToken,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "Name of the new token",
+							Required: true,
+						},
+						cli.StringSliceFlag{
+							Name:     "permission",
+							Usage:    "Permission granted to the token. May be repeated or comma separated. Options: " + strings.Join(apiTokenPermissionOptions(), ", ") + ".",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:  "expires-in",
+							Usage: "Duration after which the token expires, e.g. 720h. Tokens without an expiry never expire.",
+						},
+					},
+				},
+				{
+					Name:   "revoke",
+					Usage:  "Revoke a scoped API token",
+					Action: s.RevokeAPIToken,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "Name of the token to revoke",
+							Required: true,
+						},
+					},
+				},
+			},
+		},
 		{
 			Name:  "users",
 			Usage: "Create, edit permissions, or delete API users",
@@ -302,6 +348,158 @@ func (s *Shell) DeleteUser(c *cli.Context) (err error) {
 	return s.renderAPIResponse(response, &AdminUsersPresenter{}, "Successfully deleted API user")
 }
 
+func apiTokenPermissionOptions() []string {
+	options := make([]string, len(sessions.AllPermissions))
+	for i, p := range sessions.AllPermissions {
+		options[i] = fmt.Sprintf("'%s'", p)
+	}
+	return options
+}
+
+type AdminAPITokenPresenter struct {
+	JAID
+	presenters.APITokenResource
+}
+
+var adminAPITokensTableHeaders = []string{"Name", "Access key", "Permissions", "Created by", "Expires at", "Last used at", "Created at"}
+
+func (p *AdminAPITokenPresenter) ToRow() []string {
+	row := []string{
+		p.ID,
+		p.AccessKey,
+		strings.Join(p.Permissions, ", "),
+		p.CreatedBy,
+		formatOptionalTime(p.ExpiresAt),
+		formatOptionalTime(p.LastUsedAt),
+		p.CreatedAt.String(),
+	}
+	return row
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.String()
+}
+
+// RenderTable implements TableRenderer
+func (p *AdminAPITokenPresenter) RenderTable(rt RendererTable) error {
+	rows := [][]string{p.ToRow()}
+
+	renderList(adminAPITokensTableHeaders, rows, rt.Writer)
+	if p.Secret != "" {
+		secret := fmt.Sprintf("Secret: %s\nThe secret can't be retrieved later, store it now.\n", p.Secret)
+		if _, err := rt.Write([]byte(secret)); err != nil {
+			return err
+		}
+	}
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type AdminAPITokenPresenters []AdminAPITokenPresenter
+
+// RenderTable implements TableRenderer
+func (ps AdminAPITokenPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("API Tokens\n")); err != nil {
+		return err
+	}
+	renderList(adminAPITokensTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListAPITokens renders all scoped API tokens and their permissions
+func (s *Shell) ListAPITokens(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/api_tokens", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &AdminAPITokenPresenters{})
+}
+
+// CreateAPIToken creates a new scoped API token and renders its secret
+func (s *Shell) CreateAPIToken(c *cli.Context) (err error) {
+	var permissions []string
+	for _, p := range c.StringSlice("permission") {
+		permissions = append(permissions, strings.Split(p, ",")...)
+	}
+	if _, err = sessions.ParsePermissions(permissions); err != nil {
+		return s.errorOut(err)
+	}
+
+	request := web.CreateAPITokenRequest{
+		Name:        c.String("name"),
+		Permissions: permissions,
+	}
+	if c.IsSet("expires-in") {
+		expiresIn, perr := time.ParseDuration(c.String("expires-in"))
+		if perr != nil {
+			return s.errorOut(fmt.Errorf("invalid expires-in: %w", perr))
+		}
+		if expiresIn <= 0 {
+			return s.errorOut(errors.New("expires-in must be positive"))
+		}
+		expiresAt := time.Now().Add(expiresIn)
+		request.ExpiresAt = &expiresAt
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	buf := bytes.NewBuffer(requestData)
+	response, err := s.HTTP.Post(s.ctx(), "/v2/api_tokens", buf)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(response, &AdminAPITokenPresenter{}, "Successfully created new API token")
+}
+
+// RevokeAPIToken revokes a scoped API token by name
+func (s *Shell) RevokeAPIToken(c *cli.Context) (err error) {
+	name := c.String("name")
+	if name == "" {
+		return s.errorOut(errors.New("name flag is empty, must specify a name"))
+	}
+
+	response, err := s.HTTP.Delete(s.ctx(), "/v2/api_tokens/"+url.PathEscape(name))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	if _, err = s.parseResponse(response); err != nil {
+		return s.errorOut(err)
+	}
+
+	fmt.Printf("API token %s revoked\n", name)
+	return nil
+}
+
 // Status will display the health of various services
 func (s *Shell) Status(c *cli.Context) error {
 	resp, err := s.HTTP.Get(s.ctx(), "/health?full=1", nil)
@@ -208,3 +208,102 @@ func (t *testRenderer) Render(i interface{}, s ...string) error {
 	t.presenters = *adminPresenters
 	return nil
 }
+
+func TestShell_APITokens(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	tests := []struct {
+		name        string
+		tokenName   string
+		permissions []string
+		expiresIn   string
+		err         string
+	}{
+		{"Unknown permission", "ci", []string{"users:write"}, "", `unknown permission "users:write"`},
+		{"Invalid expiry", "ci", []string{"jobs:read"}, "soon", "invalid expires-in"},
+		{"Negative expiry", "ci", []string{"jobs:read"}, "-1h", "expires-in must be positive"},
+		{"Valid params", "ci", []string{"jobs:read,jobs:run", "keys:read"}, "24h", ""},
+		{"Duplicate name", "ci", []string{"jobs:read"}, "", "API token ci already exists"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			set := flag.NewFlagSet("test", 0)
+			flagSetApplyFromAction(client.CreateAPIToken, set, "")
+
+			require.NoError(t, set.Set("name", test.tokenName))
+			for _, p := range test.permissions {
+				require.NoError(t, set.Set("permission", p))
+			}
+			if test.expiresIn != "" {
+				require.NoError(t, set.Set("expires-in", test.expiresIn))
+			}
+			c := cli.NewContext(nil, set, nil)
+			if test.err != "" {
+				assert.ErrorContains(t, client.CreateAPIToken(c), test.err)
+			} else {
+				assert.NoError(t, client.CreateAPIToken(c))
+			}
+		})
+	}
+
+	tokens, err := app.APITokenORM().ListAPITokens(ctx)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, sessions.Permissions{sessions.PermissionJobsRead, sessions.PermissionJobsRun, sessions.PermissionKeysRead}, tokens[0].Permissions)
+	assert.True(t, tokens[0].ExpiresAt.Valid)
+
+	created := r.Renders[len(r.Renders)-1].(*cmd.AdminAPITokenPresenter)
+	assert.Equal(t, "ci", created.Name)
+	assert.NotEmpty(t, created.Secret)
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ListAPITokens, set, "")
+	require.NoError(t, client.ListAPITokens(cli.NewContext(nil, set, nil)))
+	listed := *r.Renders[len(r.Renders)-1].(*cmd.AdminAPITokenPresenters)
+	require.Len(t, listed, 1)
+	assert.Empty(t, listed[0].Secret)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.RevokeAPIToken, set, "")
+	require.NoError(t, set.Set("name", "ci"))
+	require.NoError(t, client.RevokeAPIToken(cli.NewContext(nil, set, nil)))
+	assert.ErrorContains(t, client.RevokeAPIToken(cli.NewContext(nil, set, nil)), "api token not found")
+
+	tokens, err = app.APITokenORM().ListAPITokens(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+}
+
+func TestAdminAPITokenPresenter_RenderTable(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	presenter := cmd.AdminAPITokenPresenter{
+		JAID: cmd.JAID{ID: "ci"},
+		APITokenResource: presenters.APITokenResource{
+			JAID:        presenters.JAID{ID: "ci"},
+			Name:        "ci",
+			AccessKey:   "accessKey",
+			Secret:      "secret",
+			Permissions: []string{"jobs:read", "jobs:run"},
+			CreatedBy:   "foo@bar.com",
+			ExpiresAt:   &expiresAt,
+			CreatedAt:   time.Now(),
+		},
+	}
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	require.NoError(t, presenter.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "ci")
+	assert.Contains(t, output, "accessKey")
+	assert.Contains(t, output, "jobs:read, jobs:run")
+	assert.Contains(t, output, "foo@bar.com")
+	assert.Contains(t, output, expiresAt.String())
+	assert.Contains(t, output, "Secret: secret")
+}
@@ -158,6 +158,11 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for Bridges communicating with External Adapters",
 			Subcommands: initBrideSubCmds(s),
 		},
+		{
+			Name:        "capabilities",
+			Usage:       "Commands for inspecting the capabilities registry",
+			Subcommands: initCapabilitiesSubCmds(s),
+		},
 		{
 			Name:        "config",
 			Usage:       "Commands for the node's configuration",
@@ -310,6 +315,11 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for managing forwarder addresses.",
 			Subcommands: initFowardersSubCmds(s),
 		},
+		{
+			Name:        "workflows",
+			Usage:       "Commands for inspecting workflows",
+			Subcommands: initWorkflowsSubCmds(s),
+		},
 		{
 			Name:  "help-all",
 			Usage: "Shows a list of all commands and sub-commands",
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initCapabilitiesSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "list",
+			Usage:  "List all capabilities of the capabilities registry",
+			Action: s.ListCapabilities,
+		},
+	}
+}
+
+type CapabilityPresenter struct {
+	presenters.CapabilityResource
+}
+
+var capabilityHeaders = []string{"ID", "Type", "Version", "Local", "DON", "Workflows"}
+
+// ToRow presents the CapabilityResource as a slice of strings.
+func (p *CapabilityPresenter) ToRow() []string {
+	var don string
+	if p.DON != nil {
+		don = p.DON.ID
+	}
+	return []string{
+		p.ID,
+		p.CapabilityType,
+		p.Version,
+		strconv.FormatBool(p.Local),
+		don,
+		strings.Join(p.Workflows, "\n"),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *CapabilityPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable(capabilityHeaders)
+	table.Append(p.ToRow())
+	render("Capability", table)
+	return nil
+}
+
+type CapabilityPresenters []CapabilityPresenter
+
+// RenderTable implements TableRenderer
+func (ps CapabilityPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable(capabilityHeaders)
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+
+	render("Capabilities", table)
+	return nil
+}
+
+// ListCapabilities lists the capabilities of the capabilities registry.
+func (s *Shell) ListCapabilities(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/capabilities")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &CapabilityPresenters{})
+}
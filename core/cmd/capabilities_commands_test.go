@@ -0,0 +1,37 @@
+package cmd_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities/triggers"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestShell_ListCapabilities(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	trigger := triggers.NewOnDemand(logger.TestLogger(t))
+	info, err := trigger.Info(ctx)
+	require.NoError(t, err)
+	require.NoError(t, app.GetCapabilitiesRegistry().Add(ctx, trigger))
+
+	require.NoError(t, client.ListCapabilities(cli.NewContext(nil, flag.NewFlagSet("test", 0), nil)))
+	capabilities := *r.Renders[0].(*cmd.CapabilityPresenters)
+	require.Len(t, capabilities, 1)
+	assert.Equal(t, info.ID, capabilities[0].ID)
+	assert.Equal(t, "trigger", capabilities[0].CapabilityType)
+	assert.True(t, capabilities[0].Local)
+	assert.Empty(t, capabilities[0].Workflows)
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net/url"
 
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -62,6 +63,17 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 				Usage:  "get information on a specific Ethereum Transaction",
 				Action: s.ShowTransaction,
 			},
+			{
+				Name:   "budgets",
+				Usage:  "List the remaining fee bump budgets of the EVM keys",
+				Action: s.IndexFeeBumpBudgets,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "id",
+						Usage: "chain ID, defaults to all chains",
+					},
+				},
+			},
 		},
 	}
 }
@@ -131,6 +143,65 @@ func (s *Shell) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+type EVMFeeBumpBudgetPresenter struct {
+	JAID
+	presenters.EVMFeeBumpBudgetResource
+}
+
+// ToRow presents the EVMFeeBumpBudgetResource as a slice of strings.
+func (p *EVMFeeBumpBudgetPresenter) ToRow() []string {
+	budget, remaining := "unlimited", "unlimited"
+	if p.Budget != nil {
+		budget = p.Budget.String()
+	}
+	if p.Remaining != nil {
+		remaining = p.Remaining.String()
+	}
+	return []string{
+		p.EVMChainID.String(),
+		p.Address,
+		p.Window,
+		budget,
+		p.Spent.String(),
+		remaining,
+	}
+}
+
+type EVMFeeBumpBudgetPresenters []EVMFeeBumpBudgetPresenter
+
+// RenderTable implements TableRenderer
+func (ps EVMFeeBumpBudgetPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Chain ID", "Address", "Window", "Budget", "Spent", "Remaining"})
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+
+	render("EVM Fee Bump Budgets", table)
+	return nil
+}
+
+// IndexFeeBumpBudgets lists the remaining fee bump budgets of the EVM keys,
+// taking an optional chain ID
+func (s *Shell) IndexFeeBumpBudgets(c *cli.Context) (err error) {
+	query := url.Values{}
+	if c.IsSet("id") {
+		query.Set("evmChainID", c.String("id"))
+	}
+	requestURL := url.URL{Path: "/v2/transactions/evm/fee_bump_budgets", RawQuery: query.Encode()}
+
+	resp, err := s.HTTP.Get(s.ctx(), requestURL.String())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &EVMFeeBumpBudgetPresenters{})
+}
+
 // SendEther transfers ETH from the node's account to a specified address.
 func (s *Shell) SendEther(c *cli.Context) (err error) {
 	if c.NArg() < 3 {
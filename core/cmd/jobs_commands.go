@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -50,6 +52,42 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "simulate",
+			Usage:  "Execute the pipeline of a job spec without creating the job or saving the run",
+			Action: s.SimulateJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "vars",
+					Usage: "JSON object of pipeline variables, e.g. '{\"jobRun\": {\"requestBody\": \"...\"}}'",
+				},
+			},
+		},
+		{
+			Name:   "history",
+			Usage:  "List the spec versions of a job",
+			Action: s.ListJobSpecVersions,
+		},
+		{
+			Name:   "diff",
+			Usage:  "Show the differences between two spec versions of a job",
+			Action: s.DiffJobSpecVersions,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "from",
+					Usage: "version to compare from, defaults to the version before --to",
+				},
+				cli.IntFlag{
+					Name:  "to",
+					Usage: "version to compare to, defaults to the latest version",
+				},
+			},
+		},
+		{
+			Name:   "rollback",
+			Usage:  "Recreate a job from one of its prior spec versions",
+			Action: s.RollbackJob,
+		},
 	}
 }
 
@@ -140,6 +178,10 @@ func (p JobPresenter) FriendlyCreatedAt() string {
 		if p.CronSpec != nil {
 			return p.CronSpec.CreatedAt.Format(time.RFC3339)
 		}
+	case presenters.EVMLogJobSpec:
+		if p.EVMLogSpec != nil {
+			return p.EVMLogSpec.CreatedAt.Format(time.RFC3339)
+		}
 	case presenters.VRFJobSpec:
 		if p.VRFSpec != nil {
 			return p.VRFSpec.CreatedAt.Format(time.RFC3339)
@@ -209,6 +251,84 @@ func (ps JobPresenters) RenderTable(rt RendererTable) error {
 	return nil
 }
 
+// JobSimulationPresenter wraps the JSONAPI pipeline run resource returned by a job simulation
+type JobSimulationPresenter struct {
+	presenters.PipelineRunResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobSimulationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Output", "Error", "Duration"})
+	for _, tr := range p.TaskRuns {
+		var output, errString, duration string
+		if tr.Output != nil {
+			output = *tr.Output
+		}
+		if tr.Error != nil {
+			errString = *tr.Error
+		}
+		if tr.FinishedAt.Valid {
+			duration = tr.FinishedAt.Time.Sub(tr.CreatedAt).String()
+		}
+		table.Append([]string{tr.DotID, string(tr.Type), output, errString, duration})
+	}
+
+	render("Simulated Run", table)
+	return nil
+}
+
+// JobSpecVersionPresenter wraps the JSONAPI job spec version resource
+type JobSpecVersionPresenter struct {
+	JAID
+	presenters.JobSpecVersionResource
+}
+
+// ToRow presents the JobSpecVersionResource as a slice of strings.
+func (p *JobSpecVersionPresenter) ToRow() []string {
+	return []string{
+		strconv.Itoa(int(p.Version)),
+		p.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// JobSpecVersionPresenters implements TableRenderer for a slice of JobSpecVersionPresenter
+type JobSpecVersionPresenters []JobSpecVersionPresenter
+
+// RenderTable implements TableRenderer
+func (ps JobSpecVersionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Version", "Created At"})
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+
+	render("Job Spec Versions", table)
+	return nil
+}
+
+// JobSpecDiffPresenter wraps the JSONAPI job spec diff resource
+type JobSpecDiffPresenter struct {
+	JAID
+	presenters.JobSpecDiffResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobSpecDiffPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Key", "Change", "From", "To"})
+	for _, c := range p.Changes {
+		table.Append([]string{c.Key, string(c.Type), formatSpecValue(c.From), formatSpecValue(c.To)})
+	}
+
+	render(fmt.Sprintf("Job %d Spec Diff (v%d -> v%d)", p.JobID, p.FromVersion, p.ToVersion), table)
+	return nil
+}
+
+func formatSpecValue(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", v)
+}
+
 // ListJobs lists all jobs
 func (s *Shell) ListJobs(c *cli.Context) (err error) {
 	return s.getPage("/v2/jobs", c.Int("page"), &JobPresenters{})
@@ -276,6 +396,46 @@ func (s *Shell) CreateJob(c *cli.Context) (err error) {
 	return err
 }
 
+// SimulateJob executes the pipeline of a job spec with the given vars without creating the job.
+// Valid input is a TOML string or a path to TOML file
+func (s *Shell) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	var vars map[string]interface{}
+	if v := c.String("vars"); v != "" {
+		if err = json.Unmarshal([]byte(v), &vars); err != nil {
+			return s.errorOut(errors.Wrap(err, "invalid vars JSON"))
+		}
+	}
+
+	request, err := json.Marshal(web.SimulateJobRequest{
+		TOML: tomlString,
+		Vars: vars,
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/simulate", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobSimulationPresenter{})
+}
+
 // DeleteJob deletes a job
 func (s *Shell) DeleteJob(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -294,6 +454,81 @@ func (s *Shell) DeleteJob(c *cli.Context) error {
 	return nil
 }
 
+// ListJobSpecVersions lists the spec versions of a job, most recent first
+func (s *Shell) ListJobSpecVersions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the job"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/jobs/"+c.Args().First()+"/versions")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobSpecVersionPresenters{})
+}
+
+// DiffJobSpecVersions displays the differences between two spec versions of a job
+func (s *Shell) DiffJobSpecVersions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the job"))
+	}
+	query := url.Values{}
+	if c.IsSet("from") {
+		query.Set("from", strconv.Itoa(c.Int("from")))
+	}
+	if c.IsSet("to") {
+		query.Set("to", strconv.Itoa(c.Int("to")))
+	}
+	path := "/v2/jobs/" + c.Args().First() + "/diff"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	resp, err := s.HTTP.Get(s.ctx(), path)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobSpecDiffPresenter{})
+}
+
+// RollbackJob recreates a job from one of its prior spec versions
+func (s *Shell) RollbackJob(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the job id and the version to roll back to"))
+	}
+	version, err := strconv.ParseInt(c.Args().Get(1), 10, 32)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "invalid version"))
+	}
+
+	request, err := json.Marshal(web.RollbackJobRequest{Version: int32(version)})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().First()+"/rollback", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, fmt.Sprintf("Job rolled back to version %d", version))
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -403,6 +403,84 @@ func TestShell_CreateJobV2(t *testing.T) {
 	assert.Equal(t, "0x27548a32b9aD5D64c5945EaE9Da5337bc3169D15", output.OffChainReportingSpec.ContractAddress.String())
 }
 
+func TestShell_SimulateJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	spec := fmt.Sprintf(`
+type = "webhook"
+schemaVersion = 1
+externalJobID = "%s"
+observationSource = """
+    ds_multiply [type=multiply input="$(price)" times=2];
+"""
+`, uuid.New())
+
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	flagSetApplyFromAction(client.SimulateJob, fs, "")
+	require.NoError(t, fs.Parse([]string{"--vars", `{"price": "21"}`, spec}))
+
+	require.NoError(t, client.SimulateJob(cli.NewContext(nil, fs, nil)))
+	requireJobsCount(t, app.JobORM(), 0)
+
+	output := *r.Renders[0].(*cmd.JobSimulationPresenter)
+	require.Len(t, output.TaskRuns, 1)
+	assert.Equal(t, "ds_multiply", output.TaskRuns[0].DotID)
+	require.NotNil(t, output.TaskRuns[0].Output)
+	assert.Equal(t, `"42"`, *output.TaskRuns[0].Output)
+
+	fs = flag.NewFlagSet("", flag.ExitOnError)
+	flagSetApplyFromAction(client.SimulateJob, fs, "")
+	require.NoError(t, fs.Parse([]string{"--vars", `{`, spec}))
+	assert.ErrorContains(t, client.SimulateJob(cli.NewContext(nil, fs, nil)), "invalid vars JSON")
+}
+
+func TestShell_JobSpecVersions(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Enabled = ptr(true)
+	})
+	client, r := app.NewShellAndRenderer()
+
+	// Create the job
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	flagSetApplyFromAction(client.CreateJob, fs, "")
+	require.NoError(t, fs.Parse([]string{getDirectRequestSpec()}))
+	require.NoError(t, client.CreateJob(cli.NewContext(nil, fs, nil)))
+	createOutput := *r.Renders[0].(*cmd.JobPresenter)
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ListJobSpecVersions, set, "")
+	require.NoError(t, set.Parse([]string{createOutput.ID}))
+	require.NoError(t, client.ListJobSpecVersions(cli.NewContext(nil, set, nil)))
+	versions := *r.Renders[1].(*cmd.JobSpecVersionPresenters)
+	require.Len(t, versions, 1)
+	assert.Equal(t, int32(1), versions[0].Version)
+
+	// Comparing a version to itself yields no changes
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.DiffJobSpecVersions, set, "")
+	require.NoError(t, set.Parse([]string{"--from", "1", "--to", "1", createOutput.ID}))
+	require.NoError(t, client.DiffJobSpecVersions(cli.NewContext(nil, set, nil)))
+	diff := *r.Renders[2].(*cmd.JobSpecDiffPresenter)
+	assert.Empty(t, diff.Changes)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.RollbackJob, set, "")
+	require.NoError(t, set.Parse([]string{createOutput.ID}))
+	require.Equal(t, "must pass the job id and the version to roll back to", client.RollbackJob(cli.NewContext(nil, set, nil)).Error())
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.RollbackJob, set, "")
+	require.NoError(t, set.Parse([]string{createOutput.ID, "1"}))
+	require.NoError(t, client.RollbackJob(cli.NewContext(nil, set, nil)))
+	rolledBack := *r.Renders[3].(*cmd.JobPresenter)
+	assert.Equal(t, createOutput.ID, rolledBack.ID)
+}
+
 func TestShell_DeleteJob(t *testing.T) {
 	t.Parallel()
 
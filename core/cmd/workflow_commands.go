@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initWorkflowsSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "executions",
+			Usage: "Commands for inspecting workflow execution history",
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List workflow executions, most recent first",
+					Action: s.ListWorkflowExecutions,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+						cli.StringFlag{
+							Name:  "workflow-id",
+							Usage: "only show executions of this workflow",
+						},
+						cli.StringFlag{
+							Name:  "status",
+							Usage: "only show executions with this status (started, errored, timeout, completed)",
+						},
+						cli.StringFlag{
+							Name:  "from",
+							Usage: "only show executions created at or after this RFC3339 timestamp",
+						},
+						cli.StringFlag{
+							Name:  "to",
+							Usage: "only show executions created at or before this RFC3339 timestamp",
+						},
+					},
+				},
+				{
+					Name:   "show",
+					Usage:  "Show a workflow execution, including the inputs, outputs and errors of each step",
+					Action: s.ShowWorkflowExecution,
+				},
+			},
+		},
+	}
+}
+
+type WorkflowExecutionPresenter struct {
+	presenters.WorkflowExecutionResource
+}
+
+// ToRow presents the WorkflowExecutionResource as a slice of strings.
+func (p *WorkflowExecutionPresenter) ToRow() []string {
+	return []string{
+		p.ID,
+		p.WorkflowID,
+		p.Status,
+		formatTimePtr(p.CreatedAt),
+		formatTimePtr(p.FinishedAt),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *WorkflowExecutionPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "Workflow ID", "Status", "Created", "Finished"})
+	table.Append(p.ToRow())
+	render("Workflow Execution", table)
+
+	steps := rt.newTable([]string{"Step", "Status", "Inputs", "Outputs", "Error"})
+	for _, step := range p.Steps {
+		var errString string
+		if step.Error != nil {
+			errString = *step.Error
+		}
+		steps.Append([]string{
+			step.Ref,
+			step.Status,
+			toJSONString(step.Inputs),
+			toJSONString(step.Outputs),
+			errString,
+		})
+	}
+	render("Steps", steps)
+	return nil
+}
+
+type WorkflowExecutionPresenters []WorkflowExecutionPresenter
+
+// RenderTable implements TableRenderer
+func (ps WorkflowExecutionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "Workflow ID", "Status", "Created", "Finished"})
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+
+	render("Workflow Executions", table)
+	return nil
+}
+
+// ListWorkflowExecutions lists workflow executions matching the given filters.
+func (s *Shell) ListWorkflowExecutions(c *cli.Context) (err error) {
+	q := url.Values{}
+	for flag, param := range map[string]string{
+		"workflow-id": "workflowID",
+		"status":      "status",
+		"from":        "from",
+		"to":          "to",
+	} {
+		if v := strings.TrimSpace(c.String(flag)); v != "" {
+			q.Set(param, v)
+		}
+	}
+	return s.getPage("/v2/workflows/executions?"+q.Encode(), c.Int("page"), &WorkflowExecutionPresenters{})
+}
+
+// ShowWorkflowExecution displays the details of a workflow execution.
+func (s *Shell) ShowWorkflowExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the workflow execution"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/workflows/executions/"+url.PathEscape(c.Args().First()))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{})
+}
+
+func formatTimePtr(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func toJSONString(v any) string {
+	if v == nil {
+		return ""
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
@@ -0,0 +1,52 @@
+package cmd_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+func TestShell_WorkflowExecutions(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	completed, started := uuid.NewString(), uuid.NewString()
+	for _, id := range []string{completed, started} {
+		require.NoError(t, app.WorkflowORM().Add(ctx, &store.WorkflowExecution{
+			ExecutionID: id,
+			Status:      store.StatusStarted,
+			Steps: map[string]*store.WorkflowExecutionStep{
+				"step1": {ExecutionID: id, Ref: "step1", Status: store.StatusCompleted},
+			},
+		}))
+	}
+	require.NoError(t, app.WorkflowORM().UpdateStatus(ctx, completed, store.StatusCompleted))
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ListWorkflowExecutions, set, "")
+	require.NoError(t, set.Set("status", store.StatusCompleted))
+
+	require.NoError(t, client.ListWorkflowExecutions(cli.NewContext(nil, set, nil)))
+	executions := *r.Renders[0].(*cmd.WorkflowExecutionPresenters)
+	require.Len(t, executions, 1)
+	assert.Equal(t, completed, executions[0].ID)
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{completed}))
+	require.NoError(t, client.ShowWorkflowExecution(cli.NewContext(nil, set, nil)))
+	execution := *r.Renders[1].(*cmd.WorkflowExecutionPresenter)
+	assert.Equal(t, completed, execution.ID)
+	require.Len(t, execution.Steps, 1)
+	assert.Equal(t, "step1", execution.Steps[0].Ref)
+}
@@ -55,6 +55,7 @@ type AppConfig interface {
 	Threshold() Threshold
 	WebServer() WebServer
 	Tracing() Tracing
+	Workflows() Workflows
 }
 
 type DatabaseBackupMode string
@@ -18,6 +18,14 @@ var (
 	PyroscopeAuthToken           = Secret("CL_PYROSCOPE_AUTH_TOKEN")
 	PrometheusAuthToken          = Secret("CL_PROMETHEUS_AUTH_TOKEN")
 	ThresholdKeyShare            = Secret("CL_THRESHOLD_KEY_SHARE")
+	// External secrets provider env vars
+	SecretsProvider             = Var("CL_SECRETS_PROVIDER")
+	SecretsProviderPollInterval = Var("CL_SECRETS_PROVIDER_POLL_INTERVAL")
+	SecretsVaultAddr            = Var("CL_SECRETS_VAULT_ADDR")
+	SecretsVaultToken           = Secret("CL_SECRETS_VAULT_TOKEN")
+	SecretsVaultPath            = Var("CL_SECRETS_VAULT_PATH")
+	SecretsVaultNamespace       = Var("CL_SECRETS_VAULT_NAMESPACE")
+	SecretsFileDir              = Var("CL_SECRETS_FILE_DIR")
 	// Migrations env vars
 	EVMChainIDNotNullMigration0195 = "CL_EVM_CHAINID_NOT_NULL_MIGRATION_0195"
 )
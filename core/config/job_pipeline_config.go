@@ -9,6 +9,7 @@ import (
 type JobPipeline interface {
 	DefaultHTTPLimit() int64
 	DefaultHTTPTimeout() commonconfig.Duration
+	MaxHTTPCacheSize() int64
 	MaxRunDuration() time.Duration
 	MaxSuccessfulRuns() uint64
 	ReaperInterval() time.Duration
@@ -52,6 +52,26 @@ func (_m *TelemetryIngress) Endpoints() []config.TelemetryIngressEndpoint {
 	return r0
 }
 
+// FileSink provides a mock function with given fields:
+func (_m *TelemetryIngress) FileSink() config.TelemetryFileSink {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FileSink")
+	}
+
+	var r0 config.TelemetryFileSink
+	if rf, ok := ret.Get(0).(func() config.TelemetryFileSink); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.TelemetryFileSink)
+		}
+	}
+
+	return r0
+}
+
 // Logging provides a mock function with given fields:
 func (_m *TelemetryIngress) Logging() bool {
 	ret := _m.Called()
@@ -88,6 +108,26 @@ func (_m *TelemetryIngress) MaxBatchSize() uint {
 	return r0
 }
 
+// OTLPSink provides a mock function with given fields:
+func (_m *TelemetryIngress) OTLPSink() config.TelemetryOTLPSink {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for OTLPSink")
+	}
+
+	var r0 config.TelemetryOTLPSink
+	if rf, ok := ret.Get(0).(func() config.TelemetryOTLPSink); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.TelemetryOTLPSink)
+		}
+	}
+
+	return r0
+}
+
 // SendInterval provides a mock function with given fields:
 func (_m *TelemetryIngress) SendInterval() time.Duration {
 	ret := _m.Called()
@@ -0,0 +1,65 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type fileProvider struct {
+	dir string
+}
+
+// NewFileProvider returns a Provider reading one secret per file from dir, where each file name is the
+// Secrets TOML key, e.g. /run/secrets/chainlink/Database.URL. This is the layout of mounted Kubernetes and
+// Docker secrets. Hidden files are ignored and a single trailing newline is trimmed from each value.
+func NewFileProvider(dir string) (Provider, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("file secrets provider: directory is required")
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("file secrets provider: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("file secrets provider: %s is not a directory", dir)
+	}
+	return &fileProvider{dir: dir}, nil
+}
+
+func (f *fileProvider) Name() string { return "file" }
+
+func (f *fileProvider) Fetch(ctx context.Context) (map[string]string, error) {
+	entries, err := os.ReadDir(f.dir)
+	if err != nil {
+		return nil, fmt.Errorf("file secrets provider: %w", err)
+	}
+	values := make(map[string]string, len(entries))
+	for _, e := range entries {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		name := e.Name()
+		if strings.HasPrefix(name, ".") {
+			continue
+		}
+		path := filepath.Join(f.dir, name)
+		// Stat rather than e.IsDir, so symlinked secrets (as mounted by Kubernetes) are followed.
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("file secrets provider: %w", err)
+		}
+		if info.IsDir() {
+			continue
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("file secrets provider: %w", err)
+		}
+		v := strings.TrimSuffix(string(b), "\n")
+		values[name] = strings.TrimSuffix(v, "\r")
+	}
+	return values, nil
+}
@@ -0,0 +1,57 @@
+package secrets
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestFileProvider_Fetch(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Database.URL"), []byte("postgresql://db\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Password.Keystore"), []byte("p4ss\nw0rd\r\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".hidden"), []byte("ignored"), 0600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "..data"), 0700))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "subdir"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "..data", "Password.VRF"), []byte("vrf"), 0600))
+	require.NoError(t, os.Symlink(filepath.Join(dir, "..data", "Password.VRF"), filepath.Join(dir, "Password.VRF")))
+
+	p, err := NewFileProvider(dir)
+	require.NoError(t, err)
+
+	got, err := p.Fetch(testutils.Context(t))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"Database.URL":      "postgresql://db",
+		"Password.Keystore": "p4ss\nw0rd",
+		"Password.VRF":      "vrf",
+	}, got)
+
+	// rotation is picked up on the next fetch
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Database.URL"), []byte("postgresql://db2"), 0600))
+	got, err = p.Fetch(testutils.Context(t))
+	require.NoError(t, err)
+	assert.Equal(t, "postgresql://db2", got["Database.URL"])
+}
+
+func TestNewFileProvider_Validation(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewFileProvider("")
+	assert.ErrorContains(t, err, "directory is required")
+
+	_, err = NewFileProvider(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+
+	f := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(f, nil, 0600))
+	_, err = NewFileProvider(f)
+	assert.ErrorContains(t, err, "is not a directory")
+}
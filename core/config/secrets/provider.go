@@ -0,0 +1,58 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config/env"
+)
+
+// DefaultPollInterval is how often a Watcher re-reads its Provider when CL_SECRETS_PROVIDER_POLL_INTERVAL is unset.
+const DefaultPollInterval = time.Minute
+
+// Provider is an external backend holding node secrets. Secrets are resolved once at boot and then
+// periodically re-read by a Watcher, so they can be rotated without re-writing the secrets TOML files.
+type Provider interface {
+	// Name identifies the backend in logs and errors.
+	Name() string
+	// Fetch returns every secret held by the backend, keyed by its dotted Secrets TOML path,
+	// e.g. "Database.URL", "Mercury.Credentials.cred1.Password" or "Bridges.Credentials.bridge1.Password".
+	Fetch(ctx context.Context) (map[string]string, error)
+}
+
+// NewProviderFromEnv returns the Provider selected by CL_SECRETS_PROVIDER, or nil if none is configured.
+func NewProviderFromEnv() (Provider, error) {
+	switch kind := env.SecretsProvider.Get(); kind {
+	case "":
+		return nil, nil
+	case "vault":
+		return NewVaultProvider(VaultConfig{
+			Addr:      env.SecretsVaultAddr.Get(),
+			Token:     env.SecretsVaultToken.Get(),
+			Path:      env.SecretsVaultPath.Get(),
+			Namespace: env.SecretsVaultNamespace.Get(),
+		}, &http.Client{Timeout: 30 * time.Second})
+	case "file":
+		return NewFileProvider(env.SecretsFileDir.Get())
+	default:
+		return nil, fmt.Errorf("unknown secrets provider %q: must be one of vault, file", kind)
+	}
+}
+
+// PollIntervalFromEnv returns the interval configured by CL_SECRETS_PROVIDER_POLL_INTERVAL, or DefaultPollInterval.
+func PollIntervalFromEnv() (time.Duration, error) {
+	v := env.SecretsProviderPollInterval.Get()
+	if v == "" {
+		return DefaultPollInterval, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", env.SecretsProviderPollInterval, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid %s: must be positive", env.SecretsProviderPollInterval)
+	}
+	return d, nil
+}
@@ -0,0 +1,120 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+// VaultConfig configures a Provider backed by a HashiCorp Vault compatible HTTP API.
+type VaultConfig struct {
+	// Addr is the base URL of the server, e.g. https://vault.example.com:8200
+	Addr string
+	// Token is sent as the X-Vault-Token header.
+	Token models.Secret
+	// Path is the API path of the secret below /v1/, e.g. secret/data/chainlink for a KV v2 mount.
+	Path string
+	// Namespace is optional and sent as the X-Vault-Namespace header.
+	Namespace string
+}
+
+type vaultProvider struct {
+	url       string
+	token     models.Secret
+	namespace string
+	client    *http.Client
+}
+
+// NewVaultProvider returns a Provider reading a single KV (v1 or v2) secret, whose fields are the Secrets TOML keys.
+func NewVaultProvider(cfg VaultConfig, client *http.Client) (Provider, error) {
+	if cfg.Addr == "" {
+		return nil, fmt.Errorf("vault secrets provider: address is required")
+	}
+	base, err := url.Parse(cfg.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("vault secrets provider: invalid address: %w", err)
+	}
+	if base.Scheme != "http" && base.Scheme != "https" {
+		return nil, fmt.Errorf("vault secrets provider: address must be http or https, got %q", base.Scheme)
+	}
+	path := strings.Trim(cfg.Path, "/")
+	if path == "" {
+		return nil, fmt.Errorf("vault secrets provider: path is required")
+	}
+	if cfg.Token == "" {
+		return nil, fmt.Errorf("vault secrets provider: token is required")
+	}
+	return &vaultProvider{
+		url:       base.JoinPath("v1", path).String(),
+		token:     cfg.Token,
+		namespace: cfg.Namespace,
+		client:    client,
+	}, nil
+}
+
+func (v *vaultProvider) Name() string { return "vault" }
+
+func (v *vaultProvider) Fetch(ctx context.Context) (map[string]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", string(v.token))
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("vault secrets provider: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("vault secrets provider: failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var errResp struct {
+			Errors []string `json:"errors"`
+		}
+		_ = json.Unmarshal(body, &errResp)
+		return nil, fmt.Errorf("vault secrets provider: unexpected status %d: %s", resp.StatusCode, strings.Join(errResp.Errors, "; "))
+	}
+	return parseVaultResponse(body)
+}
+
+// parseVaultResponse extracts the secret fields from both KV v1 ({"data": {...}}) and
+// KV v2 ({"data": {"data": {...}, "metadata": {...}}}) responses.
+func parseVaultResponse(body []byte) (map[string]string, error) {
+	var resp struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("vault secrets provider: invalid response: %w", err)
+	}
+	fields := resp.Data
+	if inner, ok := resp.Data["data"]; ok {
+		if _, isV2 := resp.Data["metadata"]; isV2 {
+			fields = nil
+			if err := json.Unmarshal(inner, &fields); err != nil {
+				return nil, fmt.Errorf("vault secrets provider: invalid KV v2 data: %w", err)
+			}
+		}
+	}
+
+	values := make(map[string]string, len(fields))
+	for k, raw := range fields {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, fmt.Errorf("vault secrets provider: value of %q must be a string", k)
+		}
+		values[k] = s
+	}
+	return values, nil
+}
@@ -0,0 +1,96 @@
+package secrets
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func newVaultStub(t *testing.T, status int, body string) *httptest.Server {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/secret/data/chainlink", r.URL.Path)
+		if r.Header.Get("X-Vault-Token") != "s.token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		assert.Equal(t, "ns1", r.Header.Get("X-Vault-Namespace"))
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestVaultProvider_Fetch(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name string
+		body string
+		exp  map[string]string
+	}{
+		{"kv v2", `{"data":{"data":{"Database.URL":"postgresql://db","Password.Keystore":"p4ssw0rd"},"metadata":{"version":3}}}`,
+			map[string]string{"Database.URL": "postgresql://db", "Password.Keystore": "p4ssw0rd"}},
+		{"kv v1", `{"data":{"Database.URL":"postgresql://db"}}`,
+			map[string]string{"Database.URL": "postgresql://db"}},
+		{"kv v1 with a data field", `{"data":{"data":"value"}}`,
+			map[string]string{"data": "value"}},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := newVaultStub(t, http.StatusOK, tt.body)
+			p, err := NewVaultProvider(VaultConfig{Addr: s.URL, Token: "s.token", Path: "/secret/data/chainlink/", Namespace: "ns1"}, s.Client())
+			require.NoError(t, err)
+
+			got, err := p.Fetch(testutils.Context(t))
+			require.NoError(t, err)
+			assert.Equal(t, tt.exp, got)
+		})
+	}
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+		s := newVaultStub(t, http.StatusOK, `{}`)
+		p, err := NewVaultProvider(VaultConfig{Addr: s.URL, Token: "wrong", Path: "secret/data/chainlink"}, s.Client())
+		require.NoError(t, err)
+
+		_, err = p.Fetch(testutils.Context(t))
+		assert.ErrorContains(t, err, "unexpected status 403: permission denied")
+	})
+
+	t.Run("non-string value", func(t *testing.T) {
+		t.Parallel()
+		s := newVaultStub(t, http.StatusOK, `{"data":{"data":{"Password.VRF":42},"metadata":{}}}`)
+		p, err := NewVaultProvider(VaultConfig{Addr: s.URL, Token: "s.token", Path: "secret/data/chainlink", Namespace: "ns1"}, s.Client())
+		require.NoError(t, err)
+
+		_, err = p.Fetch(testutils.Context(t))
+		assert.ErrorContains(t, err, `value of "Password.VRF" must be a string`)
+	})
+}
+
+func TestNewVaultProvider_Validation(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name string
+		cfg  VaultConfig
+		err  string
+	}{
+		{"no address", VaultConfig{Token: "t", Path: "p"}, "address is required"},
+		{"bad scheme", VaultConfig{Addr: "ftp://vault", Token: "t", Path: "p"}, "must be http or https"},
+		{"no path", VaultConfig{Addr: "http://vault", Token: "t"}, "path is required"},
+		{"no token", VaultConfig{Addr: "http://vault", Path: "p"}, "token is required"},
+	} {
+		_, err := NewVaultProvider(tt.cfg, http.DefaultClient)
+		assert.ErrorContains(t, err, tt.err, tt.name)
+	}
+}
@@ -0,0 +1,124 @@
+package secrets
+
+import (
+	"context"
+	"maps"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Watcher periodically re-reads a Provider and hands changed snapshots to a callback,
+// so rotated secrets are picked up without restarting the node.
+type Watcher struct {
+	services.StateMachine
+	lggr     logger.Logger
+	provider Provider
+	interval time.Duration
+	onChange func(map[string]string) error
+
+	last map[string]string
+
+	chStop services.StopChan
+	wgDone sync.WaitGroup
+}
+
+// NewWatcher returns a Watcher polling provider every interval. onChange is called with the full
+// snapshot of secrets whenever it differs from the previously applied one, including on the first poll.
+func NewWatcher(lggr logger.Logger, provider Provider, interval time.Duration, onChange func(map[string]string) error) *Watcher {
+	return &Watcher{
+		lggr:     lggr.Named("SecretsWatcher"),
+		provider: provider,
+		interval: interval,
+		onChange: onChange,
+		chStop:   make(chan struct{}),
+	}
+}
+
+func (w *Watcher) Start(context.Context) error {
+	return w.StartOnce("SecretsWatcher", func() error {
+		w.wgDone.Add(1)
+		go w.run()
+		return nil
+	})
+}
+
+func (w *Watcher) Close() error {
+	return w.StopOnce("SecretsWatcher", func() error {
+		close(w.chStop)
+		w.wgDone.Wait()
+		return nil
+	})
+}
+
+func (w *Watcher) Name() string {
+	return w.lggr.Name()
+}
+
+func (w *Watcher) HealthReport() map[string]error {
+	return map[string]error{w.Name(): w.Healthy()}
+}
+
+func (w *Watcher) run() {
+	defer w.wgDone.Done()
+	ctx, cancel := w.chStop.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		w.poll(ctx)
+		select {
+		case <-w.chStop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Watcher) poll(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, w.interval)
+	defer cancel()
+
+	values, err := w.provider.Fetch(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.lggr.Errorw("Failed to fetch secrets", "provider", w.provider.Name(), "err", err)
+			w.SvcErrBuffer.Append(err)
+		}
+		return
+	}
+	if w.last != nil && maps.Equal(w.last, values) {
+		return
+	}
+	if err = w.onChange(values); err != nil {
+		w.lggr.Errorw("Failed to apply rotated secrets, keeping previous values", "provider", w.provider.Name(), "err", err)
+		w.SvcErrBuffer.Append(err)
+		return
+	}
+	if w.last != nil {
+		// never log values
+		w.lggr.Infow("Secrets rotated", "provider", w.provider.Name(), "keys", changedKeys(w.last, values))
+	}
+	w.last = values
+}
+
+func changedKeys(prev, next map[string]string) []string {
+	var keys []string
+	for k, v := range next {
+		if old, ok := prev[k]; !ok || old != v {
+			keys = append(keys, k)
+		}
+	}
+	for k := range prev {
+		if _, ok := next[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,91 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type fakeProvider struct {
+	mu     sync.Mutex
+	values map[string]string
+	err    error
+}
+
+func (f *fakeProvider) Name() string { return "fake" }
+
+func (f *fakeProvider) Fetch(context.Context) (map[string]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return maps.Clone(f.values), f.err
+}
+
+func (f *fakeProvider) set(values map[string]string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.values, f.err = values, err
+}
+
+func TestWatcher(t *testing.T) {
+	t.Parallel()
+
+	p := &fakeProvider{values: map[string]string{"Password.VRF": "a"}}
+	applied := make(chan map[string]string, 10)
+	var reject bool
+	var mu sync.Mutex
+	w := NewWatcher(logger.TestLogger(t), p, 10*time.Millisecond, func(values map[string]string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if reject {
+			return errors.New("invalid")
+		}
+		applied <- values
+		return nil
+	})
+	require.NoError(t, w.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, w.Close()) })
+
+	// first poll always applies
+	assert.Equal(t, map[string]string{"Password.VRF": "a"}, <-applied)
+
+	// unchanged snapshots are not re-applied
+	select {
+	case v := <-applied:
+		t.Fatalf("unexpected update %v", v)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// fetch errors are surfaced in health
+	p.set(nil, errors.New("unavailable"))
+	require.Eventually(t, func() bool { return w.Healthy() != nil }, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+	// rejected snapshots are retried
+	mu.Lock()
+	reject = true
+	mu.Unlock()
+	p.set(map[string]string{"Password.VRF": "b"}, nil)
+	time.Sleep(30 * time.Millisecond)
+	mu.Lock()
+	reject = false
+	mu.Unlock()
+	assert.Equal(t, map[string]string{"Password.VRF": "b"}, <-applied)
+}
+
+func Test_changedKeys(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"a", "c", "d"}, changedKeys(
+		map[string]string{"a": "1", "b": "2", "d": "4"},
+		map[string]string{"a": "x", "b": "2", "c": "3"},
+	))
+	assert.Empty(t, changedKeys(map[string]string{"a": "1"}, map[string]string{"a": "1"}))
+}
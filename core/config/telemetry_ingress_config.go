@@ -3,6 +3,8 @@ package config
 import (
 	"net/url"
 	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 //go:generate mockery --quiet --name TelemetryIngress --output ./mocks/ --case=underscore --filename telemetry_ingress.go
@@ -16,6 +18,8 @@ type TelemetryIngress interface {
 	SendTimeout() time.Duration
 	UseBatchSend() bool
 	Endpoints() []TelemetryIngressEndpoint
+	FileSink() TelemetryFileSink
+	OTLPSink() TelemetryOTLPSink
 }
 
 //go:generate mockery --quiet --name TelemetryIngressEndpoint --output ./mocks/ --case=underscore --filename telemetry_ingress_endpoint.go
@@ -25,3 +29,19 @@ type TelemetryIngressEndpoint interface {
 	ServerPubKey() string
 	URL() *url.URL
 }
+
+//go:generate mockery --quiet --name TelemetryFileSink --output ./mocks/ --case=underscore --filename telemetry_file_sink.go
+type TelemetryFileSink interface {
+	Enabled() bool
+	Path() string
+	MaxSize() utils.FileSize
+	MaxBackups() int64
+}
+
+//go:generate mockery --quiet --name TelemetryOTLPSink --output ./mocks/ --case=underscore --filename telemetry_otlp_sink.go
+type TelemetryOTLPSink interface {
+	Enabled() bool
+	CollectorTarget() string
+	Mode() string
+	TLSCertPath() string
+}
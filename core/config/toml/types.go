@@ -57,6 +57,7 @@ type Core struct {
 	Tracing          Tracing          `toml:",omitempty"`
 	Mercury          Mercury          `toml:",omitempty"`
 	Capabilities     Capabilities     `toml:",omitempty"`
+	Workflows        Workflows        `toml:",omitempty"`
 }
 
 // SetFrom updates c with any non-nil values from f. (currently TOML field only!)
@@ -87,6 +88,7 @@ func (c *Core) SetFrom(f *Core) {
 	c.Keeper.setFrom(&f.Keeper)
 	c.Mercury.setFrom(&f.Mercury)
 	c.Capabilities.setFrom(&f.Capabilities)
+	c.Workflows.setFrom(&f.Workflows)
 
 	c.AutoPprof.setFrom(&f.AutoPprof)
 	c.Pyroscope.setFrom(&f.Pyroscope)
@@ -115,6 +117,7 @@ type Secrets struct {
 	Pyroscope  PyroscopeSecrets         `toml:",omitempty"`
 	Prometheus PrometheusSecrets        `toml:",omitempty"`
 	Mercury    MercurySecrets           `toml:",omitempty"`
+	Bridges    BridgeSecrets            `toml:",omitempty"`
 	Threshold  ThresholdKeyShareSecrets `toml:",omitempty"`
 }
 
@@ -444,6 +447,8 @@ type TelemetryIngress struct {
 	SendInterval *commonconfig.Duration
 	SendTimeout  *commonconfig.Duration
 	UseBatchSend *bool
+	FileSink     TelemetryFileSink          `toml:",omitempty"`
+	OTLPSink     TelemetryOTLPSink          `toml:",omitempty"`
 	Endpoints    []TelemetryIngressEndpoint `toml:",omitempty"`
 }
 
@@ -476,11 +481,91 @@ func (t *TelemetryIngress) setFrom(f *TelemetryIngress) {
 	if v := f.UseBatchSend; v != nil {
 		t.UseBatchSend = v
 	}
+	t.FileSink.setFrom(&f.FileSink)
+	t.OTLPSink.setFrom(&f.OTLPSink)
 	if v := f.Endpoints; v != nil {
 		t.Endpoints = v
 	}
 }
 
+type TelemetryFileSink struct {
+	Enabled    *bool
+	Path       *string
+	MaxSize    *utils.FileSize
+	MaxBackups *int64
+}
+
+func (t *TelemetryFileSink) setFrom(f *TelemetryFileSink) {
+	if v := f.Enabled; v != nil {
+		t.Enabled = v
+	}
+	if v := f.Path; v != nil {
+		t.Path = v
+	}
+	if v := f.MaxSize; v != nil {
+		t.MaxSize = v
+	}
+	if v := f.MaxBackups; v != nil {
+		t.MaxBackups = v
+	}
+}
+
+func (t *TelemetryFileSink) ValidateConfig() (err error) {
+	if t.MaxBackups != nil && *t.MaxBackups < 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "MaxBackups", Value: *t.MaxBackups, Msg: "must not be negative"})
+	}
+	return
+}
+
+type TelemetryOTLPSink struct {
+	Enabled         *bool
+	CollectorTarget *string
+	Mode            *string
+	TLSCertPath     *string
+}
+
+func (t *TelemetryOTLPSink) setFrom(f *TelemetryOTLPSink) {
+	if v := f.Enabled; v != nil {
+		t.Enabled = v
+	}
+	if v := f.CollectorTarget; v != nil {
+		t.CollectorTarget = v
+	}
+	if v := f.Mode; v != nil {
+		t.Mode = v
+	}
+	if v := f.TLSCertPath; v != nil {
+		t.TLSCertPath = v
+	}
+}
+
+func (t *TelemetryOTLPSink) ValidateConfig() (err error) {
+	if t.Enabled == nil || !*t.Enabled {
+		return
+	}
+
+	if t.CollectorTarget == nil || *t.CollectorTarget == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "CollectorTarget", Msg: "must be set when OTLPSink is enabled"})
+	}
+
+	if t.Mode != nil {
+		switch *t.Mode {
+		case "tls":
+			if t.TLSCertPath != nil && *t.TLSCertPath != "" && !isValidFilePath(*t.TLSCertPath) {
+				err = multierr.Append(err, configutils.ErrInvalid{Name: "TLSCertPath", Value: *t.TLSCertPath, Msg: "must be a valid file path"})
+			}
+		case "unencrypted":
+			if t.TLSCertPath != nil && *t.TLSCertPath != "" {
+				err = multierr.Append(err, configutils.ErrInvalid{Name: "TLSCertPath", Value: *t.TLSCertPath, Msg: "must be empty when Mode is 'unencrypted'"})
+			}
+		default:
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "Mode", Value: *t.Mode, Msg: "must be either 'tls' or 'unencrypted'"})
+		}
+	}
+
+	return
+}
+
 type AuditLogger struct {
 	Enabled        *bool
 	ForwardToUrl   *commonconfig.URL
@@ -886,6 +971,7 @@ func (j *JobPipeline) setFrom(f *JobPipeline) {
 type JobPipelineHTTPRequest struct {
 	DefaultTimeout *commonconfig.Duration
 	MaxSize        *utils.FileSize
+	MaxCacheSize   *utils.FileSize
 }
 
 func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
@@ -895,6 +981,9 @@ func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
 	if v := f.MaxSize; v != nil {
 		j.MaxSize = v
 	}
+	if v := f.MaxCacheSize; v != nil {
+		j.MaxCacheSize = v
+	}
 }
 
 type FluxMonitor struct {
@@ -1406,6 +1495,61 @@ func (m *MercurySecrets) ValidateConfig() (err error) {
 	return err
 }
 
+type BridgeCredentials struct {
+	// Username is the user id for bridge basic auth
+	Username *models.Secret
+	// Password is the user secret key for bridge basic auth
+	Password *models.Secret
+}
+
+type BridgeSecrets struct {
+	Credentials map[string]BridgeCredentials
+}
+
+func (b *BridgeSecrets) SetFrom(f *BridgeSecrets) (err error) {
+	err = b.validateMerge(f)
+	if err != nil {
+		return err
+	}
+
+	if b.Credentials != nil && f.Credentials != nil {
+		for k, v := range f.Credentials {
+			b.Credentials[k] = v
+		}
+	} else if v := f.Credentials; v != nil {
+		b.Credentials = v
+	}
+
+	return nil
+}
+
+func (b *BridgeSecrets) validateMerge(f *BridgeSecrets) (err error) {
+	if b.Credentials != nil && f.Credentials != nil {
+		for k := range f.Credentials {
+			if _, exists := b.Credentials[k]; exists {
+				err = multierr.Append(err, configutils.ErrOverride{Name: fmt.Sprintf("Credentials[\"%s\"]", k)})
+			}
+		}
+	}
+
+	return err
+}
+
+func (b *BridgeSecrets) ValidateConfig() (err error) {
+	for name, creds := range b.Credentials {
+		if name == "" {
+			err = multierr.Append(err, configutils.ErrEmpty{Name: "Name", Msg: "must be provided and non-empty"})
+		}
+		if creds.Username == nil || *creds.Username == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: "Username", Msg: "must be provided and non-empty"})
+		}
+		if creds.Password == nil || *creds.Password == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: "Password", Msg: "must be provided and non-empty"})
+		}
+	}
+	return err
+}
+
 type Capabilities struct {
 	Peering P2P `toml:",omitempty"`
 }
@@ -1414,6 +1558,20 @@ func (c *Capabilities) setFrom(f *Capabilities) {
 	c.Peering.setFrom(&f.Peering)
 }
 
+type Workflows struct {
+	ExecutionReaperInterval  *commonconfig.Duration
+	ExecutionReaperThreshold *commonconfig.Duration
+}
+
+func (w *Workflows) setFrom(f *Workflows) {
+	if v := f.ExecutionReaperInterval; v != nil {
+		w.ExecutionReaperInterval = v
+	}
+	if v := f.ExecutionReaperThreshold; v != nil {
+		w.ExecutionReaperThreshold = v
+	}
+}
+
 type ThresholdKeyShareSecrets struct {
 	ThresholdKeyShare *models.Secret
 }
@@ -79,6 +79,34 @@ func TestMercurySecrets_emptyURL(t *testing.T) {
 	assert.Equal(t, "URL: missing: must be provided and non-empty", err.Error())
 }
 
+func TestBridgeSecrets_valid(t *testing.T) {
+	bs := BridgeSecrets{
+		Credentials: map[string]BridgeCredentials{
+			"bridge1": {
+				Username: models.NewSecret("user1"),
+				Password: models.NewSecret("password1"),
+			},
+		},
+	}
+
+	err := bs.ValidateConfig()
+	assert.NoError(t, err)
+}
+
+func TestBridgeSecrets_missingPassword(t *testing.T) {
+	bs := BridgeSecrets{
+		Credentials: map[string]BridgeCredentials{
+			"bridge1": {
+				Username: models.NewSecret("user1"),
+			},
+		},
+	}
+
+	err := bs.ValidateConfig()
+	assert.Error(t, err)
+	assert.Equal(t, "Password: missing: must be provided and non-empty", err.Error())
+}
+
 func Test_validateDBURL(t *testing.T) {
 	t.Parallel()
 
@@ -577,5 +605,74 @@ func TestMercuryTLS_ValidateTLSCertPath(t *testing.T) {
 	}
 }
 
+func TestTelemetryOTLPSink_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name            string
+		enabled         bool
+		collectorTarget *string
+		mode            *string
+		tlsCertPath     *string
+		errMsg          string
+	}{
+		{
+			name:    "disabled",
+			enabled: false,
+			mode:    ptr("unknown"),
+		},
+		{
+			name:            "tls mode without TLS path",
+			enabled:         true,
+			collectorTarget: ptr("otel-collector:4317"),
+			mode:            ptr("tls"),
+		},
+		{
+			name:            "unencrypted mode",
+			enabled:         true,
+			collectorTarget: ptr("localhost:4317"),
+			mode:            ptr("unencrypted"),
+		},
+		{
+			name:    "missing collector target",
+			enabled: true,
+			mode:    ptr("tls"),
+			errMsg:  "CollectorTarget: missing: must be set when OTLPSink is enabled",
+		},
+		{
+			name:            "unencrypted mode with TLS path",
+			enabled:         true,
+			collectorTarget: ptr("localhost:4317"),
+			mode:            ptr("unencrypted"),
+			tlsCertPath:     ptr("/path/to/cert.pem"),
+			errMsg:          "TLSCertPath: invalid value (/path/to/cert.pem): must be empty when Mode is 'unencrypted'",
+		},
+		{
+			name:            "invalid mode",
+			enabled:         true,
+			collectorTarget: ptr("localhost:4317"),
+			mode:            ptr("unknown"),
+			errMsg:          "Mode: invalid value (unknown): must be either 'tls' or 'unencrypted'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := &TelemetryOTLPSink{
+				Enabled:         ptr(tt.enabled),
+				CollectorTarget: tt.collectorTarget,
+				Mode:            tt.mode,
+				TLSCertPath:     tt.tlsCertPath,
+			}
+
+			err := sink.ValidateConfig()
+
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 // ptr is a utility function for converting a value to a pointer to the value.
 func ptr[T any](t T) *T { return &t }
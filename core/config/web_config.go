@@ -55,10 +55,17 @@ type LDAP interface {
 	UpstreamSyncRateLimit() commonconfig.Duration
 }
 
+// BridgeCredentials are sent as basic auth in requests to a bridge.
+type BridgeCredentials struct {
+	Username string
+	Password string
+}
+
 type WebServer interface {
 	AuthenticationMethod() string
 	AllowOrigins() string
 	BridgeCacheTTL() time.Duration
+	BridgeCredentials(name string) *BridgeCredentials
 	BridgeResponseURL() *url.URL
 	HTTPMaxSize() int64
 	StartTimeout() time.Duration
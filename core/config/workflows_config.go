@@ -0,0 +1,8 @@
+package config
+
+import "time"
+
+type Workflows interface {
+	ExecutionReaperInterval() time.Duration
+	ExecutionReaperThreshold() time.Duration
+}
@@ -13,8 +13,12 @@ import (
 
 	context "context"
 
+	core "github.com/smartcontractkit/chainlink-common/pkg/types/core"
+
 	feeds "github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 
+	http "net/http"
+
 	job "github.com/smartcontractkit/chainlink/v2/core/services/job"
 
 	jsonserializable "github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
@@ -37,6 +41,8 @@ import (
 
 	sqlutil "github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
+	store "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+
 	txmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 
 	types "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -53,6 +59,26 @@ type Application struct {
 	mock.Mock
 }
 
+// APITokenORM provides a mock function with given fields:
+func (_m *Application) APITokenORM() sessions.APITokenORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for APITokenORM")
+	}
+
+	var r0 sessions.APITokenORM
+	if rf, ok := ret.Get(0).(func() sessions.APITokenORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(sessions.APITokenORM)
+		}
+	}
+
+	return r0
+}
+
 // AddJobV2 provides a mock function with given fields: ctx, _a1
 func (_m *Application) AddJobV2(ctx context.Context, _a1 *job.Job) error {
 	ret := _m.Called(ctx, _a1)
@@ -237,6 +263,26 @@ func (_m *Application) GetAuditLogger() audit.AuditLogger {
 	return r0
 }
 
+// GetCapabilitiesRegistry provides a mock function with given fields:
+func (_m *Application) GetCapabilitiesRegistry() core.CapabilitiesRegistry {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCapabilitiesRegistry")
+	}
+
+	var r0 core.CapabilitiesRegistry
+	if rf, ok := ret.Get(0).(func() core.CapabilitiesRegistry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.CapabilitiesRegistry)
+		}
+	}
+
+	return r0
+}
+
 // GetConfig provides a mock function with given fields:
 func (_m *Application) GetConfig() chainlink.GeneralConfig {
 	ret := _m.Called()
@@ -665,6 +711,45 @@ func (_m *Application) SetLogLevel(lvl zapcore.Level) error {
 	return r0
 }
 
+// SimulateJobV2 provides a mock function with given fields: ctx, jb, vars
+func (_m *Application) SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]interface{}) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, jb, vars)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateJobV2")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, jb, vars)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}) *pipeline.Run); ok {
+		r0 = rf(ctx, jb, vars)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, job.Job, map[string]interface{}) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, jb, vars)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, job.Job, map[string]interface{}) error); ok {
+		r2 = rf(ctx, jb, vars)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -721,11 +806,49 @@ func (_m *Application) TxmStorageService() txmgr.EvmTxStore {
 	return r0
 }
 
+// VerifyWebhookRequest provides a mock function with given fields: ctx, jobUUID, header, requestBody
+func (_m *Application) VerifyWebhookRequest(ctx context.Context, jobUUID uuid.UUID, header http.Header, requestBody []byte) error {
+	ret := _m.Called(ctx, jobUUID, header, requestBody)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyWebhookRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, http.Header, []byte) error); ok {
+		r0 = rf(ctx, jobUUID, header, requestBody)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WakeSessionReaper provides a mock function with given fields:
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
 }
 
+// WorkflowORM provides a mock function with given fields:
+func (_m *Application) WorkflowORM() store.Store {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowORM")
+	}
+
+	var r0 store.Store
+	if rf, ok := ret.Get(0).(func() store.Store); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.Store)
+		}
+	}
+
+	return r0
+}
+
 // NewApplication creates a new instance of Application. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewApplication(t interface {
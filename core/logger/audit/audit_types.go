@@ -52,8 +52,9 @@ const (
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
-	JobCreated EventID = "JOB_CREATED"
-	JobDeleted EventID = "JOB_DELETED"
+	JobCreated    EventID = "JOB_CREATED"
+	JobDeleted    EventID = "JOB_DELETED"
+	JobRolledBack EventID = "JOB_ROLLED_BACK"
 
 	ChainAdded       EventID = "CHAIN_ADDED"
 	ChainSpecUpdated EventID = "CHAIN_SPEC_UPDATED"
@@ -36,6 +36,7 @@ import (
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	evmutils "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/secrets"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services"
@@ -43,6 +44,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
 	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
 	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/evmlog"
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
@@ -92,6 +94,7 @@ type Application interface {
 	GetRelayers() RelayerChainInteroperators
 	GetLoopRegistry() *plugins.LoopRegistry
 	GetLoopRegistrarConfig() plugins.RegistrarConfig
+	GetCapabilitiesRegistry() coretypes.CapabilitiesRegistry
 
 	// V2 Jobs (TOML specified)
 	JobSpawner() job.Spawner
@@ -99,13 +102,19 @@ type Application interface {
 	EVMORM() evmtypes.Configs
 	PipelineORM() pipeline.ORM
 	BridgeORM() bridges.ORM
+	WorkflowORM() workflowstore.Store
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
+	APITokenORM() sessions.APITokenORM
 	TxmStorageService() txmgr.EvmTxStore
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
+	// VerifyWebhookRequest checks a request to run a webhook job against the signature and rate limit settings of its spec.
+	VerifyWebhookRequest(ctx context.Context, jobUUID uuid.UUID, header http.Header, requestBody []byte) error
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	// SimulateJobV2 executes the pipeline of an unsaved job in-memory, stubbing out side-effecting tasks.
+	SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]interface{}) (*pipeline.Run, pipeline.TaskRunResults, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 
@@ -137,8 +146,10 @@ type ChainlinkApplication struct {
 	pipelineORM              pipeline.ORM
 	pipelineRunner           pipeline.Runner
 	bridgeORM                bridges.ORM
+	workflowORM              workflowstore.Store
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider
+	apiTokenORM              sessions.APITokenORM
 	txmStorageService        txmgr.EvmTxStore
 	FeedsService             feeds.Service
 	webhookJobRunner         webhook.JobRunner
@@ -158,6 +169,7 @@ type ChainlinkApplication struct {
 	profiler                 *pyroscope.Profiler
 	loopRegistry             *plugins.LoopRegistry
 	loopRegistrarConfig      plugins.RegistrarConfig
+	capabilitiesRegistry     coretypes.CapabilitiesRegistry
 
 	started     bool
 	startStopMu sync.Mutex
@@ -266,6 +278,15 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	telemetryManager := telemetry.NewManager(cfg.TelemetryIngress(), keyStore.CSA(), globalLogger)
 	srvcs = append(srvcs, telemetryManager)
 
+	if provider := cfg.SecretsProvider(); provider != nil {
+		pollInterval, err := secrets.PollIntervalFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		globalLogger.Infow("SecretsWatcher: secrets will be refreshed from external provider", "provider", provider.Name(), "pollInterval", pollInterval)
+		srvcs = append(srvcs, secrets.NewWatcher(globalLogger, provider, pollInterval, cfg.UpdateSecrets))
+	}
+
 	backupCfg := cfg.Database().Backup()
 	if backupCfg.Mode() != config.DatabaseBackupModeNone && backupCfg.Frequency() > 0 {
 		globalLogger.Infow("DatabaseBackup: periodic database backups are enabled", "frequency", backupCfg.Frequency())
@@ -341,6 +362,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	}
 
 	srvcs = append(srvcs, pipelineORM)
+	srvcs = append(srvcs, workflowstore.NewReaper(globalLogger, workflowORM, clockwork.NewRealClock(), cfg.Workflows().ExecutionReaperInterval(), cfg.Workflows().ExecutionReaperThreshold()))
 
 	loopRegistrarConfig := plugins.NewRegistrarConfig(opts.GRPCOpts, opts.LoopRegistry.Register, opts.LoopRegistry.Unregister)
 
@@ -352,6 +374,11 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				pipelineORM,
 				legacyEVMChains,
 				mailMon),
+			job.EVMLog: evmlog.NewDelegate(
+				globalLogger,
+				pipelineRunner,
+				legacyEVMChains,
+				opts.DS),
 			job.Keeper: keeper.NewDelegate(
 				cfg,
 				opts.DS,
@@ -371,9 +398,11 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			job.Webhook: webhook.NewDelegate(
 				pipelineRunner,
 				externalInitiatorManager,
+				jobORM,
 				globalLogger),
 			job.Cron: cron.NewDelegate(
 				pipelineRunner,
+				opts.DS,
 				globalLogger),
 			job.BlockhashStore: blockhashstore.NewDelegate(
 				cfg,
@@ -562,8 +591,10 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		pipelineRunner:           pipelineRunner,
 		pipelineORM:              pipelineORM,
 		bridgeORM:                bridgeORM,
+		workflowORM:              workflowORM,
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
+		apiTokenORM:              localauth.NewAPITokenORM(opts.DS),
 		txmStorageService:        txmORM,
 		FeedsService:             feedsService,
 		Config:                   cfg,
@@ -580,6 +611,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		profiler:                 profiler,
 		loopRegistry:             loopRegistry,
 		loopRegistrarConfig:      loopRegistrarConfig,
+		capabilitiesRegistry:     opts.CapabilitiesRegistry,
 
 		ds: opts.DS,
 
@@ -653,6 +685,10 @@ func (app *ChainlinkApplication) GetLoopRegistrarConfig() plugins.RegistrarConfi
 	return app.loopRegistrarConfig
 }
 
+func (app *ChainlinkApplication) GetCapabilitiesRegistry() coretypes.CapabilitiesRegistry {
+	return app.capabilitiesRegistry
+}
+
 // Stop allows the application to exit by halting schedules, closing
 // logs, and closing the DB connection.
 func (app *ChainlinkApplication) Stop() error {
@@ -739,6 +775,10 @@ func (app *ChainlinkApplication) BridgeORM() bridges.ORM {
 	return app.bridgeORM
 }
 
+func (app *ChainlinkApplication) WorkflowORM() workflowstore.Store {
+	return app.workflowORM
+}
+
 func (app *ChainlinkApplication) BasicAdminUsersORM() sessions.BasicAdminUsersORM {
 	return app.localAdminUsersORM
 }
@@ -747,6 +787,10 @@ func (app *ChainlinkApplication) AuthenticationProvider() sessions.Authenticatio
 	return app.authenticationProvider
 }
 
+func (app *ChainlinkApplication) APITokenORM() sessions.APITokenORM {
+	return app.apiTokenORM
+}
+
 // TODO BCF-2516 remove this all together remove EVM specifics
 func (app *ChainlinkApplication) EVMORM() evmtypes.Configs {
 	return app.GetRelayers().LegacyEVMChains().ChainNodeConfigs()
@@ -795,6 +839,10 @@ func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uu
 	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
 }
 
+func (app *ChainlinkApplication) VerifyWebhookRequest(ctx context.Context, jobUUID uuid.UUID, header http.Header, requestBody []byte) error {
+	return app.webhookJobRunner.VerifyRequest(ctx, jobUUID, header, requestBody)
+}
+
 // Only used for local testing, not supported by the UI.
 func (app *ChainlinkApplication) RunJobV2(
 	ctx context.Context,
@@ -860,6 +908,29 @@ func (app *ChainlinkApplication) RunJobV2(
 	return runID, err
 }
 
+// SimulateJobV2 runs the observation source of a validated (but not yet created) job with the given vars.
+// ethtx and bridge side effects are stubbed out and neither the run nor the spec is persisted.
+func (app *ChainlinkApplication) SimulateJobV2(
+	ctx context.Context,
+	jb job.Job,
+	vars map[string]interface{},
+) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	if jb.Pipeline.Source == "" {
+		return nil, nil, errors.Errorf("job type %s has no observation source to simulate", jb.Type)
+	}
+	spec := pipeline.Spec{
+		DotDagSource:      jb.Pipeline.Source,
+		MaxTaskDuration:   jb.MaxTaskDuration,
+		JobName:           jb.Name.ValueOrZero(),
+		JobType:           jb.Type.String(),
+		ForwardingAllowed: jb.ForwardingAllowed,
+	}
+	if jb.GasLimit.Valid {
+		spec.GasLimit = &jb.GasLimit.Uint32
+	}
+	return app.pipelineRunner.SimulateRun(ctx, spec, pipeline.NewVarsFrom(vars), app.logger)
+}
+
 func (app *ChainlinkApplication) ResumeJobV2(
 	ctx context.Context,
 	taskID uuid.UUID,
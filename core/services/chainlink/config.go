@@ -3,6 +3,8 @@ package chainlink
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"strings"
 
 	"go.uber.org/multierr"
 
@@ -178,6 +180,10 @@ func (s *Secrets) SetFrom(f *Secrets) (err error) {
 		err = multierr.Append(err, commonconfig.NamedMultiErrorList(err2, "Mercury"))
 	}
 
+	if err2 := s.Bridges.SetFrom(&f.Bridges); err2 != nil {
+		err = multierr.Append(err, commonconfig.NamedMultiErrorList(err2, "Bridges"))
+	}
+
 	if err2 := s.Threshold.SetFrom(&f.Threshold); err2 != nil {
 		err = multierr.Append(err, commonconfig.NamedMultiErrorList(err2, "Threshold"))
 	}
@@ -269,3 +275,101 @@ func (s *Secrets) setEnv() error {
 	}
 	return nil
 }
+
+// setProvided sets the secrets resolved from an external secrets.Provider, keyed by their dotted TOML path.
+// Provided values take precedence over both TOML and env vars. The Mercury and Bridges credentials maps are copied
+// before modification, since they may still be shared with previously returned config values.
+func (s *Secrets) setProvided(values map[string]string) (err error) {
+	setSecret := func(dst **models.Secret, v string) {
+		*dst = models.NewSecret(v)
+	}
+	setURL := func(name string, dst **models.SecretURL, v string) {
+		u := new(models.SecretURL)
+		if err2 := u.UnmarshalText([]byte(v)); err2 != nil {
+			err = multierr.Append(err, fmt.Errorf("%s: invalid URL", name))
+			return
+		}
+		*dst = u
+	}
+	// cutCredentials splits keys of the form <prefix><name>.<field>
+	cutCredentials := func(k, prefix string) (name, field string, ok bool) {
+		rest, ok := strings.CutPrefix(k, prefix)
+		i := strings.LastIndex(rest, ".")
+		if !ok || i <= 0 {
+			return "", "", false
+		}
+		return rest[:i], rest[i+1:], true
+	}
+
+	var mercuryCreds map[string]toml.MercuryCredentials
+	var bridgeCreds map[string]toml.BridgeCredentials
+	for k, v := range values {
+		switch k {
+		case "Database.URL":
+			setURL(k, &s.Database.URL, v)
+		case "Database.BackupURL":
+			setURL(k, &s.Database.BackupURL, v)
+		case "Password.Keystore":
+			setSecret(&s.Password.Keystore, v)
+		case "Password.VRF":
+			setSecret(&s.Password.VRF, v)
+		case "Pyroscope.AuthToken":
+			setSecret(&s.Pyroscope.AuthToken, v)
+		case "Prometheus.AuthToken":
+			setSecret(&s.Prometheus.AuthToken, v)
+		case "Threshold.ThresholdKeyShare":
+			setSecret(&s.Threshold.ThresholdKeyShare, v)
+		default:
+			if name, field, ok := cutCredentials(k, "Mercury.Credentials."); ok {
+				if mercuryCreds == nil {
+					mercuryCreds = maps.Clone(s.Mercury.Credentials)
+					if mercuryCreds == nil {
+						mercuryCreds = map[string]toml.MercuryCredentials{}
+					}
+				}
+				c := mercuryCreds[name]
+				switch field {
+				case "URL":
+					setURL(k, &c.URL, v)
+				case "LegacyURL":
+					setURL(k, &c.LegacyURL, v)
+				case "Username":
+					setSecret(&c.Username, v)
+				case "Password":
+					setSecret(&c.Password, v)
+				default:
+					err = multierr.Append(err, fmt.Errorf("unknown secret key %q", k))
+					continue
+				}
+				mercuryCreds[name] = c
+			} else if name, field, ok := cutCredentials(k, "Bridges.Credentials."); ok {
+				if bridgeCreds == nil {
+					bridgeCreds = maps.Clone(s.Bridges.Credentials)
+					if bridgeCreds == nil {
+						bridgeCreds = map[string]toml.BridgeCredentials{}
+					}
+				}
+				c := bridgeCreds[name]
+				switch field {
+				case "Username":
+					setSecret(&c.Username, v)
+				case "Password":
+					setSecret(&c.Password, v)
+				default:
+					err = multierr.Append(err, fmt.Errorf("unknown secret key %q", k))
+					continue
+				}
+				bridgeCreds[name] = c
+			} else {
+				err = multierr.Append(err, fmt.Errorf("unknown secret key %q", k))
+			}
+		}
+	}
+	if mercuryCreds != nil {
+		s.Mercury.Credentials = mercuryCreds
+	}
+	if bridgeCreds != nil {
+		s.Bridges.Credentials = bridgeCreds
+	}
+	return err
+}
@@ -72,6 +72,7 @@ type databaseConfig struct {
 	c      toml.Database
 	s      toml.DatabaseSecrets
 	logSQL func() bool
+	url    func() url.URL // may be rotated by the secrets provider
 }
 
 func (d *databaseConfig) Backup() config.Backup {
@@ -106,7 +107,7 @@ func (d *databaseConfig) DefaultQueryTimeout() time.Duration {
 }
 
 func (d *databaseConfig) URL() url.URL {
-	return *d.s.URL.URL()
+	return d.url()
 }
 
 func (d *databaseConfig) Dialect() dialects.DialectName {
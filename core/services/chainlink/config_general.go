@@ -1,6 +1,7 @@
 package chainlink
 
 import (
+	"context"
 	_ "embed"
 	"fmt"
 	"os"
@@ -23,6 +24,7 @@ import (
 	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/env"
 	"github.com/smartcontractkit/chainlink/v2/core/config/parse"
+	"github.com/smartcontractkit/chainlink/v2/core/config/secrets"
 	v2 "github.com/smartcontractkit/chainlink/v2/core/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -47,8 +49,14 @@ type generalConfig struct {
 	logMu sync.RWMutex // for the mutable fields Log.Level & Log.SQL
 
 	passwordMu sync.RWMutex // passwords are set after initialization
+
+	secretsProvider secrets.Provider
+	secretsMu       sync.RWMutex // secrets may be rotated by the secrets provider
 }
 
+// secretsProviderTimeout bounds resolving secrets from the provider at boot.
+const secretsProviderTimeout = 30 * time.Second
+
 // GeneralConfigOpts holds configuration options for creating a coreconfig.GeneralConfig via New().
 //
 // See ParseTOML to initilialize Config and Secrets from TOML.
@@ -59,6 +67,9 @@ type GeneralConfigOpts struct {
 	Config
 	Secrets
 
+	// SecretsProvider is an optional external backend for secrets, which take precedence over TOML and env.
+	SecretsProvider secrets.Provider
+
 	// OverrideFn is a *test-only* hook to override effective values.
 	OverrideFn func(*Config, *Secrets)
 
@@ -81,16 +92,22 @@ func (o *GeneralConfigOpts) Setup(configFiles []string, secretsFiles []string) e
 
 	o.ConfigStrings = configs
 
-	secrets := []string{}
+	secretsStrings := []string{}
 	for _, fileName := range secretsFiles {
 		b, err := os.ReadFile(fileName)
 		if err != nil {
 			return errors.Wrapf(err, "failed to read secrets file: %s", fileName)
 		}
-		secrets = append(secrets, string(b))
+		secretsStrings = append(secretsStrings, string(b))
 	}
 
-	o.SecretsStrings = secrets
+	o.SecretsStrings = secretsStrings
+
+	provider, err := secrets.NewProviderFromEnv()
+	if err != nil {
+		return err
+	}
+	o.SecretsProvider = provider
 	return nil
 }
 
@@ -145,6 +162,18 @@ func (o GeneralConfigOpts) New() (GeneralConfig, error) {
 		}
 	}
 
+	if o.SecretsProvider != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), secretsProviderTimeout)
+		values, err2 := o.SecretsProvider.Fetch(ctx)
+		cancel()
+		if err2 != nil {
+			return nil, fmt.Errorf("failed to fetch secrets from %s provider: %w", o.SecretsProvider.Name(), err2)
+		}
+		if err2 = o.Secrets.setProvided(values); err2 != nil {
+			return nil, fmt.Errorf("invalid secrets from %s provider: %w", o.SecretsProvider.Name(), err2)
+		}
+	}
+
 	if fn := o.OverrideFn; fn != nil {
 		fn(&o.Config, &o.Secrets)
 	}
@@ -160,12 +189,13 @@ func (o GeneralConfigOpts) New() (GeneralConfig, error) {
 	}
 
 	cfg := &generalConfig{
-		inputTOML:     input,
-		effectiveTOML: effective,
-		secretsTOML:   secrets,
-		c:             &o.Config,
-		secrets:       &o.Secrets,
-		warning:       warning,
+		inputTOML:       input,
+		effectiveTOML:   effective,
+		secretsTOML:     secrets,
+		c:               &o.Config,
+		secrets:         &o.Secrets,
+		warning:         warning,
+		secretsProvider: o.SecretsProvider,
 	}
 	if lvl := o.Config.Log.Level; lvl != nil {
 		cfg.logLevelDefault = zapcore.Level(*lvl)
@@ -346,7 +376,9 @@ func (g *generalConfig) StarkNetEnabled() bool {
 }
 
 func (g *generalConfig) WebServer() config.WebServer {
-	return &webServerConfig{c: g.c.WebServer, s: g.secrets.WebServer, rootDir: g.RootDir}
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
+	return &webServerConfig{c: g.c.WebServer, s: g.secrets.WebServer, rootDir: g.RootDir, bridgeCredentials: g.bridgeCredentials}
 }
 
 func (g *generalConfig) AutoPprofBlockProfileRate() int {
@@ -402,7 +434,9 @@ func (g *generalConfig) Capabilities() config.Capabilities {
 }
 
 func (g *generalConfig) Database() coreconfig.Database {
-	return &databaseConfig{c: g.c.Database, s: g.secrets.Secrets.Database, logSQL: g.logSQL}
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
+	return &databaseConfig{c: g.c.Database, s: g.secrets.Secrets.Database, logSQL: g.logSQL, url: g.DatabaseURL}
 }
 
 func (g *generalConfig) ShutdownGracePeriod() time.Duration {
@@ -462,6 +496,8 @@ func (g *generalConfig) P2POutgoingMessageBufferSize() int {
 }
 
 func (g *generalConfig) Pyroscope() config.Pyroscope {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
 	return &pyroscopeConfig{c: g.c.Pyroscope, s: g.secrets.Pyroscope}
 }
 
@@ -476,7 +512,8 @@ func (g *generalConfig) RootDir() string {
 
 func (g *generalConfig) TelemetryIngress() coreconfig.TelemetryIngress {
 	return &telemetryIngressConfig{
-		c: g.c.TelemetryIngress,
+		c:       g.c.TelemetryIngress,
+		rootDir: g.RootDir,
 	}
 }
 
@@ -497,14 +534,20 @@ func (g *generalConfig) Password() coreconfig.Password {
 }
 
 func (g *generalConfig) Prometheus() coreconfig.Prometheus {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
 	return &prometheusConfig{s: g.secrets.Prometheus}
 }
 
 func (g *generalConfig) Mercury() coreconfig.Mercury {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
 	return &mercuryConfig{c: g.c.Mercury, s: g.secrets.Mercury}
 }
 
 func (g *generalConfig) Threshold() coreconfig.Threshold {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
 	return &thresholdConfig{s: g.secrets.Threshold}
 }
 
@@ -512,4 +555,8 @@ func (g *generalConfig) Tracing() coreconfig.Tracing {
 	return &tracingConfig{s: g.c.Tracing}
 }
 
+func (g *generalConfig) Workflows() coreconfig.Workflows {
+	return &workflowsConfig{c: g.c.Workflows}
+}
+
 var zeroSha256Hash = models.Sha256Hash{}
@@ -2,9 +2,14 @@ package chainlink
 
 import (
 	"net/url"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/secrets"
 )
 
 func (g *generalConfig) DatabaseURL() url.URL {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
 	if g.secrets.Database.URL == nil {
 		return url.URL{}
 	}
@@ -12,5 +17,45 @@ func (g *generalConfig) DatabaseURL() url.URL {
 }
 
 func (g *generalConfig) DatabaseBackupURL() *url.URL {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
 	return g.secrets.Database.BackupURL.URL()
 }
+
+func (g *generalConfig) bridgeCredentials(name string) *config.BridgeCredentials {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
+	bc, ok := g.secrets.Bridges.Credentials[name]
+	if !ok {
+		return nil
+	}
+	return &config.BridgeCredentials{
+		Username: string(*bc.Username),
+		Password: string(*bc.Password),
+	}
+}
+
+func (g *generalConfig) SecretsProvider() secrets.Provider {
+	return g.secretsProvider
+}
+
+// UpdateSecrets applies a snapshot of rotated secrets from the SecretsProvider. The update is all or nothing:
+// if the resulting secrets are invalid, the previous values are kept.
+// A rotated Database.URL is used to authenticate new database connections, while existing connections are kept.
+// Password secrets are only read at boot, so rotating them requires a restart.
+func (g *generalConfig) UpdateSecrets(values map[string]string) error {
+	g.secretsMu.Lock()
+	defer g.secretsMu.Unlock()
+	g.passwordMu.Lock()
+	defer g.passwordMu.Unlock()
+
+	s := *g.secrets
+	if err := s.setProvided(values); err != nil {
+		return err
+	}
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	*g.secrets = s
+	return nil
+}
@@ -22,6 +22,10 @@ func (j *jobPipelineConfig) DefaultHTTPTimeout() commonconfig.Duration {
 	return *j.c.HTTPRequest.DefaultTimeout
 }
 
+func (j *jobPipelineConfig) MaxHTTPCacheSize() int64 {
+	return int64(*j.c.HTTPRequest.MaxCacheSize)
+}
+
 func (j *jobPipelineConfig) MaxRunDuration() time.Duration {
 	return j.c.MaxRunDuration.Duration()
 }
@@ -24,6 +24,7 @@ func TestJobPipelineConfigTest(t *testing.T) {
 	d, err := commonconfig.NewDuration(1 * time.Minute)
 	require.NoError(t, err)
 	assert.Equal(t, d, jp.DefaultHTTPTimeout())
+	assert.Equal(t, int64(utils.MB), jp.MaxHTTPCacheSize())
 	assert.Equal(t, 1*time.Hour, jp.MaxRunDuration())
 	assert.Equal(t, uint64(123456), jp.MaxSuccessfulRuns())
 	assert.Equal(t, 4*time.Hour, jp.ReaperInterval())
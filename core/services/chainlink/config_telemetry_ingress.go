@@ -2,16 +2,28 @@ package chainlink
 
 import (
 	"net/url"
+	"path/filepath"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 var _ config.TelemetryIngress = (*telemetryIngressConfig)(nil)
 
 type telemetryIngressConfig struct {
-	c toml.TelemetryIngress
+	c       toml.TelemetryIngress
+	rootDir func() string
+}
+
+type telemetryFileSinkConfig struct {
+	c       toml.TelemetryFileSink
+	rootDir func() string
+}
+
+type telemetryOTLPSinkConfig struct {
+	c toml.TelemetryOTLPSink
 }
 
 type telemetryIngressEndpointConfig struct {
@@ -56,6 +68,56 @@ func (t *telemetryIngressConfig) Endpoints() []config.TelemetryIngressEndpoint {
 	return endpoints
 }
 
+func (t *telemetryIngressConfig) FileSink() config.TelemetryFileSink {
+	return &telemetryFileSinkConfig{c: t.c.FileSink, rootDir: t.rootDir}
+}
+
+func (t *telemetryIngressConfig) OTLPSink() config.TelemetryOTLPSink {
+	return &telemetryOTLPSinkConfig{c: t.c.OTLPSink}
+}
+
+func (t *telemetryFileSinkConfig) Enabled() bool {
+	return *t.c.Enabled
+}
+
+func (t *telemetryFileSinkConfig) Path() string {
+	s := *t.c.Path
+	if s == "" {
+		s = filepath.Join(t.rootDir(), "telemetry.ndjson")
+	}
+	return s
+}
+
+func (t *telemetryFileSinkConfig) MaxSize() utils.FileSize {
+	return *t.c.MaxSize
+}
+
+func (t *telemetryFileSinkConfig) MaxBackups() int64 {
+	return *t.c.MaxBackups
+}
+
+func (t *telemetryOTLPSinkConfig) Enabled() bool {
+	return *t.c.Enabled
+}
+
+func (t *telemetryOTLPSinkConfig) CollectorTarget() string {
+	if t.c.CollectorTarget == nil {
+		return ""
+	}
+	return *t.c.CollectorTarget
+}
+
+func (t *telemetryOTLPSinkConfig) Mode() string {
+	return *t.c.Mode
+}
+
+func (t *telemetryOTLPSinkConfig) TLSCertPath() string {
+	if t.c.TLSCertPath == nil {
+		return ""
+	}
+	return *t.c.TLSCertPath
+}
+
 func (t *telemetryIngressEndpointConfig) Network() string {
 	return *t.c.Network
 }
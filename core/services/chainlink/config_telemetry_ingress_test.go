@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func TestTelemetryIngressConfig(t *testing.T) {
@@ -24,6 +26,18 @@ func TestTelemetryIngressConfig(t *testing.T) {
 	assert.Equal(t, 5*time.Second, ticfg.SendTimeout())
 	assert.True(t, ticfg.UseBatchSend())
 
+	fs := ticfg.FileSink()
+	assert.True(t, fs.Enabled())
+	assert.Equal(t, "telemetry/dir/telemetry.ndjson", fs.Path())
+	assert.Equal(t, 5*utils.MB, fs.MaxSize())
+	assert.Equal(t, int64(3), fs.MaxBackups())
+
+	otlp := ticfg.OTLPSink()
+	assert.True(t, otlp.Enabled())
+	assert.Equal(t, "otel-collector:4317", otlp.CollectorTarget())
+	assert.Equal(t, "tls", otlp.Mode())
+	assert.Equal(t, "/path/to/telemetry/cert.pem", otlp.TLSCertPath())
+
 	tec := cfg.TelemetryIngress().Endpoints()
 
 	assert.Equal(t, 1, len(tec))
@@ -32,3 +46,16 @@ func TestTelemetryIngressConfig(t *testing.T) {
 	assert.Equal(t, "prom.test", tec[0].URL().String())
 	assert.Equal(t, "test-pub-key", tec[0].ServerPubKey())
 }
+
+func TestTelemetryFileSinkConfig_DefaultPath(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{`RootDir = '/root/dir'`},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	fs := cfg.TelemetryIngress().FileSink()
+	assert.False(t, fs.Enabled())
+	assert.Equal(t, "/root/dir/telemetry.ndjson", fs.Path())
+	assert.False(t, cfg.TelemetryIngress().OTLPSink().Enabled())
+}
@@ -294,6 +294,18 @@ func TestConfig_Marshal(t *testing.T) {
 		SendInterval: commoncfg.MustNewDuration(time.Minute),
 		SendTimeout:  commoncfg.MustNewDuration(5 * time.Second),
 		UseBatchSend: ptr(true),
+		FileSink: toml.TelemetryFileSink{
+			Enabled:    ptr(true),
+			Path:       ptr("telemetry/dir/telemetry.ndjson"),
+			MaxSize:    ptr[utils.FileSize](5 * utils.MB),
+			MaxBackups: ptr[int64](3),
+		},
+		OTLPSink: toml.TelemetryOTLPSink{
+			Enabled:         ptr(true),
+			CollectorTarget: ptr("otel-collector:4317"),
+			Mode:            ptr("tls"),
+			TLSCertPath:     ptr("/path/to/telemetry/cert.pem"),
+		},
 		Endpoints: []toml.TelemetryIngressEndpoint{{
 			Network:      ptr("EVM"),
 			ChainID:      ptr("1"),
@@ -374,6 +386,7 @@ func TestConfig_Marshal(t *testing.T) {
 		VerboseLogging:            ptr(false),
 		HTTPRequest: toml.JobPipelineHTTPRequest{
 			MaxSize:        ptr[utils.FileSize](100 * utils.MB),
+			MaxCacheSize:   ptr[utils.FileSize](utils.MB),
 			DefaultTimeout: commoncfg.MustNewDuration(time.Minute),
 		},
 	}
@@ -445,6 +458,10 @@ func TestConfig_Marshal(t *testing.T) {
 			},
 		},
 	}
+	full.Workflows = toml.Workflows{
+		ExecutionReaperInterval:  commoncfg.MustNewDuration(2 * time.Hour),
+		ExecutionReaperThreshold: commoncfg.MustNewDuration(30 * 24 * time.Hour),
+	}
 	full.Keeper = toml.Keeper{
 		DefaultTransactionQueueDepth: ptr[uint32](17),
 		GasPriceBufferPercent:        ptr[uint16](12),
@@ -491,7 +508,9 @@ func TestConfig_Marshal(t *testing.T) {
 			Chain: evmcfg.Chain{
 				AutoCreateKey: ptr(false),
 				BalanceMonitor: evmcfg.BalanceMonitor{
-					Enabled: ptr(true),
+					Enabled:              ptr(true),
+					MinBalance:           assets.NewWeiI(500_000_000_000_000_000),
+					PauseBelowMinBalance: ptr(true),
 				},
 				BlockBackfillDepth:   ptr[uint32](100),
 				BlockBackfillSkip:    ptr(true),
@@ -535,6 +554,10 @@ func TestConfig_Marshal(t *testing.T) {
 						EIP1559FeeCapBufferBlocks: ptr[uint16](13),
 						TransactionPercentile:     ptr[uint16](15),
 					},
+					FeeHistory: evmcfg.FeeHistoryEstimator{
+						BlockWindow:      ptr[uint16](21),
+						RewardPercentile: ptr[uint16](61),
+					},
 				},
 
 				KeySpecific: []evmcfg.KeySpecific{
@@ -570,6 +593,11 @@ func TestConfig_Marshal(t *testing.T) {
 					AutoPurge: evmcfg.AutoPurgeConfig{
 						Enabled: ptr(false),
 					},
+					FeeBumpBudget: evmcfg.FeeBumpBudgetConfig{
+						Enabled:   ptr(true),
+						Window:    commoncfg.MustNewDuration(6 * time.Hour),
+						MaxPerKey: assets.NewWeiI(250_000_000_000_000_000),
+					},
 				},
 
 				HeadTracker: evmcfg.HeadTracker{
@@ -795,6 +823,18 @@ SendInterval = '1m0s'
 SendTimeout = '5s'
 UseBatchSend = true
 
+[TelemetryIngress.FileSink]
+Enabled = true
+Path = 'telemetry/dir/telemetry.ndjson'
+MaxSize = '5.00mb'
+MaxBackups = 3
+
+[TelemetryIngress.OTLPSink]
+Enabled = true
+CollectorTarget = 'otel-collector:4317'
+Mode = 'tls'
+TLSCertPath = '/path/to/telemetry/cert.pem'
+
 [[TelemetryIngress.Endpoints]]
 Network = 'EVM'
 ChainID = '1'
@@ -880,6 +920,7 @@ VerboseLogging = false
 [JobPipeline.HTTPRequest]
 DefaultTimeout = '1m0s'
 MaxSize = '100.00mb'
+MaxCacheSize = '1.00mb'
 `},
 		{"OCR", Config{Core: toml.Core{OCR: full.OCR}}, `[OCR]
 Enabled = true
@@ -997,8 +1038,15 @@ ResendAfterThreshold = '1h0m0s'
 [EVM.Transactions.AutoPurge]
 Enabled = false
 
+[EVM.Transactions.FeeBumpBudget]
+Enabled = true
+Window = '6h0m0s'
+MaxPerKey = '250 milli'
+
 [EVM.BalanceMonitor]
 Enabled = true
+MinBalance = '500 milli'
+PauseBelowMinBalance = true
 
 [EVM.GasEstimator]
 Mode = 'SuggestedPrice'
@@ -1034,6 +1082,10 @@ CheckInclusionPercentile = 19
 EIP1559FeeCapBufferBlocks = 13
 TransactionPercentile = 15
 
+[EVM.GasEstimator.FeeHistory]
+BlockWindow = 21
+RewardPercentile = 61
+
 [EVM.HeadTracker]
 HistoryDepth = 15
 MaxBufferSize = 17
@@ -97,9 +97,10 @@ func (m *mfaConfig) RPOrigin() string {
 }
 
 type webServerConfig struct {
-	c       toml.WebServer
-	s       toml.WebServerSecrets
-	rootDir func() string
+	c                 toml.WebServer
+	s                 toml.WebServerSecrets
+	rootDir           func() string
+	bridgeCredentials func(name string) *config.BridgeCredentials // may be rotated by the secrets provider
 }
 
 func (w *webServerConfig) TLS() config.TLS {
@@ -137,6 +138,10 @@ func (w *webServerConfig) BridgeCacheTTL() time.Duration {
 	return w.c.BridgeCacheTTL.Duration()
 }
 
+func (w *webServerConfig) BridgeCredentials(name string) *config.BridgeCredentials {
+	return w.bridgeCredentials(name)
+}
+
 func (w *webServerConfig) HTTPMaxSize() int64 {
 	return int64(*w.c.HTTPMaxSize)
 }
@@ -0,0 +1,22 @@
+package chainlink
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.Workflows = (*workflowsConfig)(nil)
+
+type workflowsConfig struct {
+	c toml.Workflows
+}
+
+func (w *workflowsConfig) ExecutionReaperInterval() time.Duration {
+	return w.c.ExecutionReaperInterval.Duration()
+}
+
+func (w *workflowsConfig) ExecutionReaperThreshold() time.Duration {
+	return w.c.ExecutionReaperThreshold.Duration()
+}
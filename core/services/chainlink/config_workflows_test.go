@@ -0,0 +1,21 @@
+package chainlink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowsConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{fullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	w := cfg.Workflows()
+	assert.Equal(t, 2*time.Hour, w.ExecutionReaperInterval())
+	assert.Equal(t, 30*24*time.Hour, w.ExecutionReaperThreshold())
+}
@@ -10,6 +10,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	secrets "github.com/smartcontractkit/chainlink/v2/core/config/secrets"
+
 	solanaconfig "github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
 
 	time "time"
@@ -549,6 +551,26 @@ func (_m *GeneralConfig) RootDir() string {
 	return r0
 }
 
+// SecretsProvider provides a mock function with given fields:
+func (_m *GeneralConfig) SecretsProvider() secrets.Provider {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SecretsProvider")
+	}
+
+	var r0 secrets.Provider
+	if rf, ok := ret.Get(0).(func() secrets.Provider); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(secrets.Provider)
+		}
+	}
+
+	return r0
+}
+
 // Sentry provides a mock function with given fields:
 func (_m *GeneralConfig) Sentry() config.Sentry {
 	ret := _m.Called()
@@ -751,6 +773,24 @@ func (_m *GeneralConfig) Tracing() config.Tracing {
 	return r0
 }
 
+// UpdateSecrets provides a mock function with given fields: values
+func (_m *GeneralConfig) UpdateSecrets(values map[string]string) error {
+	ret := _m.Called(values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSecrets")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(map[string]string) error); ok {
+		r0 = rf(values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Validate provides a mock function with given fields:
 func (_m *GeneralConfig) Validate() error {
 	ret := _m.Called()
@@ -807,6 +847,26 @@ func (_m *GeneralConfig) WebServer() config.WebServer {
 	return r0
 }
 
+// Workflows provides a mock function with given fields:
+func (_m *GeneralConfig) Workflows() config.Workflows {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Workflows")
+	}
+
+	var r0 config.Workflows
+	if rf, ok := ret.Get(0).(func() config.Workflows); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Workflows)
+		}
+	}
+
+	return r0
+}
+
 // NewGeneralConfig creates a new instance of GeneralConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewGeneralConfig(t interface {
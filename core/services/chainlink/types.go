@@ -7,6 +7,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/secrets"
 )
 
 //go:generate mockery --quiet --name GeneralConfig --output ./mocks/ --case=underscore
@@ -19,4 +20,8 @@ type GeneralConfig interface {
 	StarknetConfigs() stkcfg.TOMLConfigs
 	// ConfigTOML returns both the user provided and effective configuration as TOML.
 	ConfigTOML() (user, effective string)
+	// SecretsProvider returns the external secrets backend, or nil if none is configured.
+	SecretsProvider() secrets.Provider
+	// UpdateSecrets applies secrets rotated by the SecretsProvider, keyed by their dotted TOML path.
+	UpdateSecrets(values map[string]string) error
 }
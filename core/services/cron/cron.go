@@ -3,6 +3,10 @@ package cron
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/robfig/cron/v3"
 
@@ -15,22 +19,29 @@ import (
 
 // Cron runs a cron jobSpec from a CronSpec
 type Cron struct {
+	services.StateMachine
 	cronRunner     *cron.Cron
 	logger         logger.Logger
 	jobSpec        job.Job
 	pipelineRunner pipeline.Runner
+	orm            *ORM
 	chStop         services.StopChan
+	wg             sync.WaitGroup
+
+	// running is the number of pipeline runs in progress, bounded by MaxConcurrentRuns.
+	running atomic.Int32
 }
 
 // NewCronFromJobSpec instantiates a job that executes on a predefined schedule.
 func NewCronFromJobSpec(
 	jobSpec job.Job,
 	pipelineRunner pipeline.Runner,
+	orm *ORM,
 	logger logger.Logger,
 ) (*Cron, error) {
 	cronLogger := logger.Named("Cron").With(
 		"jobID", jobSpec.ID,
-		"schedule", jobSpec.CronSpec.CronSchedule,
+		"schedule", jobSpec.CronSpec.Schedule(),
 	)
 
 	return &Cron{
@@ -38,29 +49,148 @@ func NewCronFromJobSpec(
 		logger:         cronLogger,
 		jobSpec:        jobSpec,
 		pipelineRunner: pipelineRunner,
+		orm:            orm,
 		chStop:         make(chan struct{}),
 	}, nil
 }
 
 // Start implements the job.Service interface.
-func (cr *Cron) Start(context.Context) error {
-	cr.logger.Debug("Starting")
+func (cr *Cron) Start(ctx context.Context) error {
+	return cr.StartOnce("Cron", func() error {
+		cr.logger.Debug("Starting")
 
-	_, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.CronSchedule, cr.runPipeline)
-	if err != nil {
-		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "err", err, "schedule", cr.jobSpec.CronSpec.CronSchedule, "jobID", cr.jobSpec.ID)
-		return err
-	}
-	cr.cronRunner.Start()
-	return nil
+		schedule, err := cronParser().Parse(cr.jobSpec.CronSpec.Schedule())
+		if err != nil {
+			cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "err", err, "schedule", cr.jobSpec.CronSpec.Schedule(), "jobID", cr.jobSpec.ID)
+			return err
+		}
+
+		now := time.Now()
+		missed, err := cr.missedRuns(ctx, schedule, now)
+		if err != nil {
+			return err
+		}
+		if missed > 0 {
+			cr.logger.Infow("Catching up on missed runs", "runs", missed, "catchUp", cr.jobSpec.CronSpec.CatchUp)
+			// Every run scheduled up to now is either caught up on or skipped.
+			if err = cr.orm.SetLastRun(ctx, cr.jobSpec.ID, now); err != nil {
+				return err
+			}
+			cr.wg.Add(1)
+			go func() {
+				defer cr.wg.Done()
+				for i := 0; i < missed; i++ {
+					if !cr.runGuarded(0) {
+						return
+					}
+				}
+			}()
+		}
+
+		cr.cronRunner.Schedule(schedule, cron.FuncJob(cr.runScheduled))
+		cr.cronRunner.Start()
+		return nil
+	})
 }
 
 // Close implements the job.Service interface. It stops this job from
 // running and cleans up resources.
 func (cr *Cron) Close() error {
-	cr.logger.Debug("Closing")
-	cr.cronRunner.Stop()
-	return nil
+	return cr.StopOnce("Cron", func() error {
+		cr.logger.Debug("Closing")
+		close(cr.chStop)
+		<-cr.cronRunner.Stop().Done()
+		cr.wg.Wait()
+		return nil
+	})
+}
+
+// catchUpEnabled returns whether the missed runs of the job are caught up on, which requires
+// keeping track of its last run.
+func (cr *Cron) catchUpEnabled() bool {
+	catchUp := cr.jobSpec.CronSpec.CatchUp
+	return catchUp != "" && catchUp != job.CronCatchUpSkip
+}
+
+// missedRuns returns the number of runs scheduled between the last run of the job and now which
+// should be caught up on according to the catch up policy of the spec.
+func (cr *Cron) missedRuns(ctx context.Context, schedule cron.Schedule, now time.Time) (missed int, err error) {
+	if !cr.catchUpEnabled() {
+		return 0, nil
+	}
+	spec := cr.jobSpec.CronSpec
+
+	lastRun, found, err := cr.orm.LastRun(ctx, cr.jobSpec.ID)
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		// The job never ran, so any runs since it was created were missed.
+		lastRun = cr.jobSpec.CreatedAt
+	}
+	if lastRun.IsZero() {
+		return 0, nil
+	}
+
+	limit := int(spec.MaxCatchUpRuns)
+	if spec.CatchUp == job.CronCatchUpOnce {
+		limit = 1
+	}
+
+	// Only the number of runs is needed, so stop counting at the limit rather than walking every run of a
+	// frequent schedule since the last one.
+	for next := schedule.Next(lastRun); missed < limit && !next.IsZero() && !next.After(now); next = schedule.Next(next) {
+		missed++
+	}
+	return missed, nil
+}
+
+// runScheduled is called by the cron runner for each scheduled run.
+func (cr *Cron) runScheduled() {
+	ctx, cancel := cr.chStop.NewCtx()
+	defer cancel()
+
+	// The runner fires on schedule, so the current time is the scheduled time of the run.
+	if cr.catchUpEnabled() {
+		if err := cr.orm.SetLastRun(ctx, cr.jobSpec.ID, time.Now()); err != nil {
+			cr.logger.Errorw("Failed to record last run", "err", err)
+		}
+	}
+
+	var jitter time.Duration
+	if maxJitter := cr.jobSpec.CronSpec.Jitter.Duration(); maxJitter > 0 {
+		jitter = time.Duration(rand.Int63n(int64(maxJitter)))
+	}
+	cr.runGuarded(jitter)
+}
+
+// runGuarded runs the pipeline after the jitter, unless MaxConcurrentRuns runs are already in
+// progress. It returns false if the job is stopping.
+func (cr *Cron) runGuarded(jitter time.Duration) bool {
+	if maxRuns := cr.jobSpec.CronSpec.MaxConcurrentRuns; maxRuns > 0 {
+		if cr.running.Add(1) > int32(maxRuns) {
+			cr.running.Add(-1)
+			cr.logger.Warnw("Skipping run, too many runs in progress", "maxConcurrentRuns", maxRuns)
+			return true
+		}
+		defer cr.running.Add(-1)
+	}
+
+	if jitter > 0 {
+		select {
+		case <-cr.chStop:
+			return false
+		case <-time.After(jitter):
+		}
+	}
+	select {
+	case <-cr.chStop:
+		return false
+	default:
+	}
+
+	cr.runPipeline()
+	return true
 }
 
 func (cr *Cron) runPipeline() {
@@ -89,3 +219,8 @@ func (cr *Cron) runPipeline() {
 func cronRunner() *cron.Cron {
 	return cron.New(cron.WithSeconds())
 }
+
+// cronParser parses schedules the same way as the runner returned by cronRunner.
+func cronParser() cron.Parser {
+	return cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+}
@@ -1,7 +1,9 @@
 package cron_test
 
 import (
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -38,7 +40,7 @@ func TestCronV2Pipeline(t *testing.T) {
 		PipelineSpec:  &pipeline.Spec{},
 		ExternalJobID: uuid.New(),
 	}
-	delegate := cron.NewDelegate(runner, lggr)
+	delegate := cron.NewDelegate(runner, db, lggr)
 
 	require.NoError(t, jobORM.CreateJob(testutils.Context(t), jb))
 	serviceArray, err := delegate.ServicesForSpec(testutils.Context(t), *jb)
@@ -51,27 +53,146 @@ func TestCronV2Pipeline(t *testing.T) {
 	defer func() { assert.NoError(t, service.Close()) }()
 }
 
-func TestCronV2Schedule(t *testing.T) {
-	t.Parallel()
+func createCronJob(t *testing.T, spec job.CronSpec) (job.Job, *cron.ORM) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	pipelineORM := pipeline.NewORM(db, lggr, cfg.JobPipeline().MaxSuccessfulRuns())
+	jobORM := job.NewORM(db, pipelineORM, bridges.NewORM(db), cltest.NewKeyStore(t, db), lggr)
 
-	spec := job.Job{
+	jb := job.Job{
 		Type:          job.Cron,
 		SchemaVersion: 1,
-		CronSpec:      &job.CronSpec{CronSchedule: "@every 1s"},
+		CronSpec:      &spec,
 		PipelineSpec:  &pipeline.Spec{},
+		ExternalJobID: uuid.New(),
+	}
+	require.NoError(t, jobORM.CreateJob(testutils.Context(t), &jb))
+	return jb, cron.NewORM(db)
+}
+
+func TestCronV2Schedule(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name          string
+		catchUp       job.CronCatchUpPolicy
+		recordLastRun bool
+	}{
+		{"without catch up", "", false},
+		{"with catch up", job.CronCatchUpOnce, true},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testutils.Context(t)
+			spec, orm := createCronJob(t, job.CronSpec{CronSchedule: "@every 1s", CatchUp: tc.catchUp})
+			startedAt := time.Now()
+			if tc.recordLastRun {
+				// Nothing to catch up on
+				require.NoError(t, orm.SetLastRun(ctx, spec.ID, startedAt))
+			}
+			runner := pipelinemocks.NewRunner(t)
+			awaiter := cltest.NewAwaiter()
+			runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) { awaiter.ItHappened() }).
+				Return(false, nil).
+				Once()
+
+			service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
+			require.NoError(t, err)
+			err = service.Start(ctx)
+			require.NoError(t, err)
+
+			awaiter.AwaitOrFail(t)
+			require.NoError(t, service.Close())
+			require.Error(t, service.Close(), "the job can only be closed once")
+
+			// The last run is only needed to catch up on missed runs
+			lastRun, found, err := orm.LastRun(ctx, spec.ID)
+			require.NoError(t, err)
+			require.Equal(t, tc.recordLastRun, found)
+			if tc.recordLastRun {
+				assert.True(t, lastRun.After(startedAt))
+			}
+		})
 	}
+}
+
+func TestCronV2CatchUp(t *testing.T) {
+	t.Parallel()
+
+	// Runs once a year, so no scheduled run happens during the test
+	const schedule = "CRON_TZ=UTC 0 0 0 1 1 *"
+	threeYearsAgo := time.Now().AddDate(-3, 0, 0)
+
+	for _, tc := range []struct {
+		name    string
+		catchUp job.CronCatchUpPolicy
+		maxRuns uint32
+		runs    int
+	}{
+		{"skip", job.CronCatchUpSkip, 0, 0},
+		{"once", job.CronCatchUpOnce, 0, 1},
+		{"all", job.CronCatchUpAll, 2, 2},
+		{"all up to the missed runs", job.CronCatchUpAll, 5, 3},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := testutils.Context(t)
+			spec, orm := createCronJob(t, job.CronSpec{CronSchedule: schedule, CatchUp: tc.catchUp, MaxCatchUpRuns: tc.maxRuns})
+			require.NoError(t, orm.SetLastRun(ctx, spec.ID, threeYearsAgo))
+
+			runner := pipelinemocks.NewRunner(t)
+			var runs atomic.Int32
+			if tc.runs > 0 {
+				runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, false, mock.Anything).
+					Run(func(mock.Arguments) { runs.Add(1) }).
+					Return(false, nil).
+					Times(tc.runs)
+			}
+
+			service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
+			require.NoError(t, err)
+			require.NoError(t, service.Start(ctx))
+			require.Eventually(t, func() bool { return runs.Load() == int32(tc.runs) }, testutils.WaitTimeout(t), 10*time.Millisecond)
+			require.NoError(t, service.Close())
+
+			// The missed runs are not caught up on again after a restart
+			lastRun, _, err := orm.LastRun(ctx, spec.ID)
+			require.NoError(t, err)
+			if tc.runs > 0 {
+				assert.True(t, lastRun.After(threeYearsAgo))
+			}
+		})
+	}
+}
+
+func TestCronV2MaxConcurrentRuns(t *testing.T) {
+	t.Parallel()
+
+	spec, orm := createCronJob(t, job.CronSpec{CronSchedule: "@every 1s", MaxConcurrentRuns: 1})
 	runner := pipelinemocks.NewRunner(t)
-	awaiter := cltest.NewAwaiter()
+	release := make(chan struct{})
+	var runs atomic.Int32
 	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
-		Run(func(args mock.Arguments) { awaiter.ItHappened() }).
-		Return(false, nil).
-		Once()
+		Run(func(mock.Arguments) {
+			runs.Add(1)
+			<-release
+		}).
+		Return(false, nil)
 
-	service, err := cron.NewCronFromJobSpec(spec, runner, logger.TestLogger(t))
+	service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
 	require.NoError(t, err)
-	err = service.Start(testutils.Context(t))
-	require.NoError(t, err)
-	defer func() { assert.NoError(t, service.Close()) }()
+	require.NoError(t, service.Start(testutils.Context(t)))
+
+	// The run blocks for several scheduled runs, which are skipped
+	require.Eventually(t, func() bool { return runs.Load() == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	time.Sleep(2500 * time.Millisecond)
+	assert.Equal(t, int32(1), runs.Load())
 
-	awaiter.AwaitOrFail(t)
+	close(release)
+	require.NoError(t, service.Close())
 }
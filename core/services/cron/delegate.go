@@ -5,6 +5,8 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
@@ -12,14 +14,16 @@ import (
 
 type Delegate struct {
 	pipelineRunner pipeline.Runner
+	orm            *ORM
 	lggr           logger.Logger
 }
 
 var _ job.Delegate = (*Delegate)(nil)
 
-func NewDelegate(pipelineRunner pipeline.Runner, lggr logger.Logger) *Delegate {
+func NewDelegate(pipelineRunner pipeline.Runner, ds sqlutil.DataSource, lggr logger.Logger) *Delegate {
 	return &Delegate{
 		pipelineRunner: pipelineRunner,
+		orm:            NewORM(ds),
 		lggr:           lggr,
 	}
 }
@@ -39,7 +43,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) (services
 		return nil, errors.Errorf("services.Delegate expects a *jobSpec.CronSpec to be present, got %v", spec)
 	}
 
-	cron, err := NewCronFromJobSpec(spec, d.pipelineRunner, d.lggr)
+	cron, err := NewCronFromJobSpec(spec, d.pipelineRunner, d.orm, d.lggr)
 	if err != nil {
 		return nil, err
 	}
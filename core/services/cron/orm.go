@@ -0,0 +1,40 @@
+package cron
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// ORM keeps track of the last scheduled run of cron jobs, so that the runs missed while the node
+// was down can be caught up on.
+type ORM struct {
+	ds sqlutil.DataSource
+}
+
+func NewORM(ds sqlutil.DataSource) *ORM {
+	return &ORM{ds: ds}
+}
+
+// LastRun returns the time the last run of the job was scheduled at, and false if it never ran.
+func (o *ORM) LastRun(ctx context.Context, jobID int32) (lastRun time.Time, found bool, err error) {
+	err = o.ds.GetContext(ctx, &lastRun, `SELECT last_run_at FROM cron_job_last_runs WHERE job_id = $1`, jobID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, false, nil
+	}
+	if err != nil {
+		return time.Time{}, false, errors.Wrapf(err, "failed to get last run for job %d", jobID)
+	}
+	return lastRun, true, nil
+}
+
+// SetLastRun records the time the last run of the job was scheduled at.
+func (o *ORM) SetLastRun(ctx context.Context, jobID int32, lastRun time.Time) error {
+	_, err := o.ds.ExecContext(ctx, `INSERT INTO cron_job_last_runs (job_id, last_run_at, updated_at) VALUES ($1, $2, NOW())
+ON CONFLICT (job_id) DO UPDATE SET last_run_at = EXCLUDED.last_run_at, updated_at = EXCLUDED.updated_at`, jobID, lastRun)
+	return errors.Wrapf(err, "failed to set last run for job %d", jobID)
+}
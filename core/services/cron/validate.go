@@ -1,6 +1,9 @@
 package cron
 
 import (
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
@@ -34,9 +37,41 @@ func ValidatedCronSpec(tomlString string) (job.Job, error) {
 	if jb.Type != job.Cron {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
-	if err := utils.ValidateCronSchedule(spec.CronSchedule); err != nil {
-		return jb, errors.Wrapf(err, "while validating cron schedule '%v'", spec.CronSchedule)
+	if spec.Timezone != "" {
+		if strings.HasPrefix(spec.CronSchedule, "CRON_TZ=") {
+			return jb, errors.New("timezone cannot be set when the cron schedule specifies CRON_TZ")
+		}
+		if _, err := time.LoadLocation(spec.Timezone); err != nil {
+			return jb, errors.Wrapf(err, "invalid timezone '%v'", spec.Timezone)
+		}
+	}
+	if err := utils.ValidateCronSchedule(spec.Schedule()); err != nil {
+		return jb, errors.Wrapf(err, "while validating cron schedule '%v'", spec.Schedule())
+	}
+	if err := validateSchedulingOptions(&spec); err != nil {
+		return jb, err
 	}
 
 	return jb, nil
 }
+
+func validateSchedulingOptions(spec *job.CronSpec) error {
+	if spec.Jitter.Duration() < 0 {
+		return errors.New("jitter cannot be negative")
+	}
+	switch spec.CatchUp {
+	case "":
+		spec.CatchUp = job.CronCatchUpSkip
+	case job.CronCatchUpSkip, job.CronCatchUpOnce:
+	case job.CronCatchUpAll:
+		if spec.MaxCatchUpRuns == 0 {
+			return errors.New("maxCatchUpRuns must be set when catchUp is 'all'")
+		}
+	default:
+		return errors.Errorf("invalid catchUp '%v', must be one of 'skip', 'once' or 'all'", spec.CatchUp)
+	}
+	if spec.MaxCatchUpRuns > 0 && spec.CatchUp != job.CronCatchUpAll {
+		return errors.New("maxCatchUpRuns can only be set when catchUp is 'all'")
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package cron_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
@@ -77,6 +78,106 @@ ds -> ds_parse -> ds_multiply;
 				assert.True(t, strings.Contains(err.Error(), "invalid cron schedule"))
 			},
 		},
+		{
+			name: "scheduling options",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "0 0 1 * * *"
+timezone          = "America/New_York"
+jitter            = "30s"
+catchUp           = "all"
+maxCatchUpRuns    = 3
+maxConcurrentRuns = 1
+observationSource = """
+ds [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.CronSpec)
+				assert.Equal(t, "CRON_TZ=America/New_York 0 0 1 * * *", s.CronSpec.Schedule())
+				assert.Equal(t, 30*time.Second, s.CronSpec.Jitter.Duration())
+				assert.Equal(t, job.CronCatchUpAll, s.CronSpec.CatchUp)
+				assert.Equal(t, uint32(3), s.CronSpec.MaxCatchUpRuns)
+				assert.Equal(t, uint32(1), s.CronSpec.MaxConcurrentRuns)
+			},
+		},
+		{
+			name: "catch up defaults to skip",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "@every 1h"
+observationSource = """
+ds [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, job.CronCatchUpSkip, s.CronSpec.CatchUp)
+			},
+		},
+		{
+			name: "invalid timezone",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "0 0 1 * * *"
+timezone          = "Mars/Olympus_Mons"
+observationSource = """
+ds [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.ErrorContains(t, err, "invalid timezone")
+			},
+		},
+		{
+			name: "timezone and CRON_TZ",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "CRON_TZ=UTC 0 0 1 * * *"
+timezone          = "UTC"
+observationSource = """
+ds [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.ErrorContains(t, err, "timezone cannot be set when the cron schedule specifies CRON_TZ")
+			},
+		},
+		{
+			name: "invalid catch up",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "@every 1h"
+catchUp           = "sometimes"
+observationSource = """
+ds [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.ErrorContains(t, err, "invalid catchUp 'sometimes'")
+			},
+		},
+		{
+			name: "catch up all without max runs",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "@every 1h"
+catchUp           = "all"
+observationSource = """
+ds [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.ErrorContains(t, err, "maxCatchUpRuns must be set when catchUp is 'all'")
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
@@ -0,0 +1,310 @@
+package evmlog
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+type Delegate struct {
+	lggr           logger.Logger
+	pipelineRunner pipeline.Runner
+	legacyChains   legacyevm.LegacyChainContainer
+	orm            *ORM
+}
+
+var _ job.Delegate = (*Delegate)(nil)
+
+func NewDelegate(
+	lggr logger.Logger,
+	pipelineRunner pipeline.Runner,
+	legacyChains legacyevm.LegacyChainContainer,
+	ds sqlutil.DataSource,
+) *Delegate {
+	return &Delegate{
+		lggr:           lggr.Named("EVMLog"),
+		pipelineRunner: pipelineRunner,
+		legacyChains:   legacyChains,
+		orm:            NewORM(ds),
+	}
+}
+
+func (d *Delegate) JobType() job.Type {
+	return job.EVMLog
+}
+
+func (d *Delegate) BeforeJobCreated(spec job.Job) {}
+func (d *Delegate) AfterJobCreated(spec job.Job)  {}
+func (d *Delegate) BeforeJobDeleted(spec job.Job) {}
+
+// OnDeleteJob unregisters the log poller filter of the job. The consumed logs bookkeeping is
+// deleted along with the job.
+func (d *Delegate) OnDeleteJob(ctx context.Context, jb job.Job) error {
+	if jb.EVMLogSpec == nil {
+		d.lggr.Errorf("evmlog.Delegate.OnDeleteJob called with wrong job type, ignoring non-evmlog spec %v", jb)
+		return nil
+	}
+	chain, err := d.legacyChains.Get(jb.EVMLogSpec.EVMChainID.String())
+	if err != nil {
+		d.lggr.Errorw("OnDeleteJob: failed to get chain", "evmChainID", jb.EVMLogSpec.EVMChainID, "err", err)
+		return nil
+	}
+	return chain.LogPoller().UnregisterFilter(ctx, filterName(jb.ID))
+}
+
+// ServicesForSpec returns the log listener service for an evmlog job
+func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.ServiceCtx, error) {
+	if jb.EVMLogSpec == nil {
+		return nil, errors.Errorf("EVMLog: evmlog.Delegate expects a *job.EVMLogSpec to be present, got %v", jb)
+	}
+	chain, err := d.legacyChains.Get(jb.EVMLogSpec.EVMChainID.String())
+	if err != nil {
+		return nil, err
+	}
+	event, err := pipeline.ParseETHABIEvent(jb.EVMLogSpec.EventABI)
+	if err != nil {
+		return nil, errors.Wrap(err, "EVMLog: invalid eventABI")
+	}
+
+	lggr := d.lggr.Named(jb.ExternalJobID.String()).With(
+		"contract", jb.EVMLogSpec.ContractAddress.String(),
+		"event", event.Name,
+		"jobName", jb.PipelineSpec.JobName,
+		"jobID", jb.PipelineSpec.JobID,
+		"externalJobID", jb.ExternalJobID,
+	)
+
+	return []job.ServiceCtx{newListener(lggr, jb, event, chain.LogPoller(), d.pipelineRunner, d.orm, chain.Config().EVM().LogPollInterval())}, nil
+}
+
+func filterName(jobID int32) string {
+	return logpoller.FilterName("EVMLogJob", jobID)
+}
+
+var _ job.ServiceCtx = (*listener)(nil)
+
+// listener runs the pipeline of an evmlog job once for every log matching its spec, in the order
+// they were emitted, once the logs are finalized or have enough confirmations.
+type listener struct {
+	services.StateMachine
+	lggr           logger.Logger
+	job            job.Job
+	spec           job.EVMLogSpec
+	event          abi.Event
+	logPoller      logpoller.LogPoller
+	pipelineRunner pipeline.Runner
+	orm            *ORM
+	pollPeriod     time.Duration
+
+	// cursor is the last block all the logs of were processed, or -1 until it's been loaded.
+	cursor int64
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+func newListener(lggr logger.Logger, jb job.Job, event abi.Event, lp logpoller.LogPoller, pipelineRunner pipeline.Runner, orm *ORM, pollPeriod time.Duration) *listener {
+	return &listener{
+		lggr:           lggr.Named("Listener"),
+		job:            jb,
+		spec:           *jb.EVMLogSpec,
+		event:          event,
+		logPoller:      lp,
+		pipelineRunner: pipelineRunner,
+		orm:            orm,
+		pollPeriod:     pollPeriod,
+		cursor:         -1,
+		stopCh:         make(services.StopChan),
+	}
+}
+
+func (l *listener) HealthReport() map[string]error {
+	return map[string]error{l.Name(): l.Healthy()}
+}
+
+func (l *listener) Name() string { return l.lggr.Name() }
+
+// Start complies with job.Service
+func (l *listener) Start(ctx context.Context) error {
+	return l.StartOnce("EVMLogListener", func() error {
+		err := l.logPoller.RegisterFilter(ctx, logpoller.Filter{
+			Name:      filterName(l.job.ID),
+			Addresses: evmtypes.AddressArray{l.spec.ContractAddress.Address()},
+			EventSigs: evmtypes.HashArray{l.event.ID},
+			Topic2:    evmtypes.HashArray(l.spec.Topic2),
+			Topic3:    evmtypes.HashArray(l.spec.Topic3),
+			Topic4:    evmtypes.HashArray(l.spec.Topic4),
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to register log poller filter")
+		}
+
+		l.wg.Add(1)
+		go l.run()
+		return nil
+	})
+}
+
+// Close complies with job.Service
+func (l *listener) Close() error {
+	return l.StopOnce("EVMLogListener", func() error {
+		close(l.stopCh)
+		l.wg.Wait()
+		return nil
+	})
+}
+
+func (l *listener) run() {
+	defer l.wg.Done()
+	ctx, cancel := l.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(l.pollPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := l.processLogs(ctx); err != nil && ctx.Err() == nil {
+				l.lggr.Errorw("Failed to process logs", "err", err)
+			}
+		}
+	}
+}
+
+// processLogs runs the pipeline for the logs of the blocks after the cursor which are confirmed,
+// then moves the cursor past them. If it fails part way through, the logs which were already
+// processed are skipped on the next attempt.
+func (l *listener) processLogs(ctx context.Context) error {
+	latest, err := l.logPoller.LatestBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get latest block")
+	}
+	confirmed := l.confirmedBlock(latest)
+
+	if l.cursor < 0 {
+		cursor, found, err2 := l.orm.Cursor(ctx, l.job.ID)
+		if err2 != nil {
+			return err2
+		}
+		if !found {
+			// A new job only processes the logs emitted after it was created.
+			if err2 = l.orm.SetCursor(ctx, l.job.ID, confirmed); err2 != nil {
+				return err2
+			}
+			cursor = confirmed
+		}
+		l.cursor = cursor
+	}
+	if confirmed <= l.cursor {
+		return nil
+	}
+
+	logs, err := l.logPoller.LogsWithSigs(ctx, l.cursor+1, confirmed, []common.Hash{l.event.ID}, l.spec.ContractAddress.Address())
+	if err != nil {
+		return errors.Wrap(err, "failed to get logs")
+	}
+	for _, lg := range logs {
+		if !l.matchesTopics(lg) {
+			continue
+		}
+		if err = l.runLog(ctx, lg); err != nil {
+			return err
+		}
+	}
+
+	if err = l.orm.SetCursor(ctx, l.job.ID, confirmed); err != nil {
+		return err
+	}
+	l.cursor = confirmed
+	return nil
+}
+
+// confirmedBlock returns the latest block which logs can be processed from.
+func (l *listener) confirmedBlock(latest logpoller.LogPollerBlock) int64 {
+	if !l.spec.MinConfirmations.Valid {
+		return latest.FinalizedBlockNumber
+	}
+	confs := int64(l.spec.MinConfirmations.Uint32)
+	if confs == 0 {
+		confs = 1
+	}
+	return latest.BlockNumber - confs + 1
+}
+
+func (l *listener) matchesTopics(lg logpoller.Log) bool {
+	topics := lg.GetTopics()
+	for i, filter := range []models.HashCollection{l.spec.Topic2, l.spec.Topic3, l.spec.Topic4} {
+		if len(filter) == 0 {
+			continue
+		}
+		if len(topics) <= i+1 || !slices.Contains(filter, topics[i+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (l *listener) runLog(ctx context.Context, lg logpoller.Log) error {
+	lggr := l.lggr.With("blockNumber", lg.BlockNumber, "blockHash", lg.BlockHash, "txHash", lg.TxHash, "logIndex", lg.LogIndex)
+
+	topics := lg.GetTopics()
+	logData, err := pipeline.DecodeETHABILog(l.event, lg.Data, topics)
+	if err != nil {
+		// The log can never be decoded, e.g. because another event has the same signature but
+		// different indexed arguments, so it's skipped rather than blocking the job.
+		lggr.Errorw("Failed to decode log, skipping", "err", err)
+		return nil
+	}
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"jobSpec": map[string]interface{}{
+			"databaseID":    l.job.ID,
+			"externalJobID": l.job.ExternalJobID,
+			"name":          l.job.Name.ValueOrZero(),
+			"pipelineSpec": &pipeline.Spec{
+				ForwardingAllowed: l.job.ForwardingAllowed,
+			},
+			"evmChainID": l.spec.EVMChainID.String(),
+		},
+		"jobRun": map[string]interface{}{
+			"logBlockHash":   lg.BlockHash,
+			"logBlockNumber": lg.BlockNumber,
+			"logTxHash":      lg.TxHash,
+			"logAddress":     lg.Address,
+			"logIndex":       lg.LogIndex,
+			"logTopics":      topics,
+			"logRawData":     lg.Data,
+			"logData":        logData,
+		},
+	})
+	run := pipeline.NewRun(*l.job.PipelineSpec, vars)
+	_, err = l.pipelineRunner.Run(ctx, run, lggr, true, func(tx sqlutil.DataSource) error {
+		return l.orm.MarkLogConsumed(ctx, tx, l.job.ID, lg.BlockHash, lg.BlockNumber, lg.LogIndex)
+	})
+	if errors.Is(err, ErrLogAlreadyConsumed) {
+		lggr.Debugw("Log already consumed, skipping")
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "failed to run pipeline")
+	}
+	lggr.Debugw("Ran pipeline for log", "runID", run.ID)
+	return nil
+}
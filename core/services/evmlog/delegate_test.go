@@ -0,0 +1,207 @@
+package evmlog_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/evmlog"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	pipelinemocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+const (
+	transferABI = "Transfer(address indexed from, address indexed to, uint256 value)"
+	specTOML    = `
+type              = "evmlog"
+schemaVersion     = 1
+name              = "transfers"
+evmChainID        = 0
+contractAddress   = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI          = "` + transferABI + `"
+topic2            = ["0x0000000000000000000000001111111111111111111111111111111111111111"]
+observationSource = """
+    ds1 [type=memo value="$(jobRun.logData.value)"];
+"""
+`
+)
+
+var (
+	sender    = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	other     = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	recipient = common.HexToAddress("0x3333333333333333333333333333333333333333")
+)
+
+func setupJob(t *testing.T) (job.Job, *evmlog.ORM, sqlutil.DataSource) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	pipelineORM := pipeline.NewORM(db, lggr, cfg.JobPipeline().MaxSuccessfulRuns())
+	jobORM := job.NewORM(db, pipelineORM, bridges.NewORM(db), cltest.NewKeyStore(t, db), lggr)
+
+	jb, err := evmlog.ValidatedEVMLogSpec(specTOML)
+	require.NoError(t, err)
+	require.NoError(t, jobORM.CreateJob(ctx, &jb))
+
+	jb, err = jobORM.FindJob(ctx, jb.ID)
+	require.NoError(t, err)
+	require.NotNil(t, jb.EVMLogSpec)
+	assert.Equal(t, transferABI, jb.EVMLogSpec.EventABI)
+	assert.Equal(t, models.HashCollection{common.BytesToHash(sender.Bytes())}, jb.EVMLogSpec.Topic2)
+	assert.Empty(t, jb.EVMLogSpec.Topic3)
+	assert.False(t, jb.EVMLogSpec.MinConfirmations.Valid)
+
+	return jb, evmlog.NewORM(db), db
+}
+
+func TestListener_Start(t *testing.T) {
+	jb, orm, _ := setupJob(t)
+	event, err := pipeline.ParseETHABIEvent(transferABI)
+	require.NoError(t, err)
+
+	lp := lpmocks.NewLogPoller(t)
+	lp.On("RegisterFilter", mock.Anything, mock.MatchedBy(func(f logpoller.Filter) bool {
+		return f.Name == evmlog.FilterName(jb.ID) &&
+			assert.ObjectsAreEqual([]common.Address{jb.EVMLogSpec.ContractAddress.Address()}, []common.Address(f.Addresses)) &&
+			assert.ObjectsAreEqual([]common.Hash{event.ID}, []common.Hash(f.EventSigs)) &&
+			assert.ObjectsAreEqual([]common.Hash(jb.EVMLogSpec.Topic2), []common.Hash(f.Topic2))
+	})).Return(nil).Once()
+
+	l, err := evmlog.NewTestListener(logger.TestLogger(t), jb, lp, pipelinemocks.NewRunner(t), orm)
+	require.NoError(t, err)
+	require.NoError(t, l.Start(testutils.Context(t)))
+	require.NoError(t, l.Close())
+}
+
+func TestListener_ProcessLogs(t *testing.T) {
+	ctx := testutils.Context(t)
+	jb, orm, ds := setupJob(t)
+	contract := jb.EVMLogSpec.ContractAddress.Address()
+	event, err := pipeline.ParseETHABIEvent(transferABI)
+	require.NoError(t, err)
+
+	newLog := func(blockNumber int64, logIndex int64, from common.Address, value int64) logpoller.Log {
+		data, err2 := event.Inputs.NonIndexed().Pack(big.NewInt(value))
+		require.NoError(t, err2)
+		return logpoller.Log{
+			LogIndex:    logIndex,
+			BlockHash:   common.BigToHash(big.NewInt(blockNumber)),
+			BlockNumber: blockNumber,
+			Topics:      pq.ByteaArray{event.ID.Bytes(), common.BytesToHash(from.Bytes()).Bytes(), common.BytesToHash(recipient.Bytes()).Bytes()},
+			EventSig:    event.ID,
+			Address:     contract,
+			TxHash:      common.BigToHash(big.NewInt(blockNumber*100 + logIndex)),
+			Data:        data,
+		}
+	}
+	logs := []logpoller.Log{
+		newLog(11, 0, sender, 1),
+		newLog(12, 3, other, 2),
+		newLog(14, 1, sender, 3),
+	}
+
+	lp := lpmocks.NewLogPoller(t)
+	runner := pipelinemocks.NewRunner(t)
+
+	var (
+		calls int
+		runs  []*pipeline.Run
+	)
+	runner.On("Run", mock.Anything, mock.Anything, mock.Anything, true, mock.Anything).
+		Return(func(ctx context.Context, run *pipeline.Run, _ logger.Logger, _ bool, fn func(sqlutil.DataSource) error) (bool, error) {
+			calls++
+			if calls == 2 {
+				// The transaction inserting the run fails, so the log isn't marked consumed either
+				return false, errors.New("connection refused")
+			}
+			if err2 := fn(ds); err2 != nil {
+				return false, err2
+			}
+			runs = append(runs, run)
+			return false, nil
+		}, nil)
+
+	l, err := evmlog.NewTestListener(logger.TestLogger(t), jb, lp, runner, orm)
+	require.NoError(t, err)
+
+	// A new job starts from the latest finalized block
+	lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 20, FinalizedBlockNumber: 10}, nil).Once()
+	require.NoError(t, l.ProcessLogs(ctx))
+	cursor, found, err := orm.Cursor(ctx, jb.ID)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, int64(10), cursor)
+
+	lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 30, FinalizedBlockNumber: 15}, nil)
+	lp.On("LogsWithSigs", mock.Anything, int64(11), int64(15), []common.Hash{event.ID}, contract).Return(logs, nil).Twice()
+
+	// The second matching log fails, so the cursor isn't moved
+	require.ErrorContains(t, l.ProcessLogs(ctx), "connection refused")
+	cursor, _, err = orm.Cursor(ctx, jb.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), cursor)
+	require.Len(t, runs, 1)
+
+	// The first log is not run again when retrying
+	require.NoError(t, l.ProcessLogs(ctx))
+	cursor, _, err = orm.Cursor(ctx, jb.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(15), cursor)
+	assert.Equal(t, 4, calls)
+	require.Len(t, runs, 2)
+
+	for i, lg := range []logpoller.Log{logs[0], logs[2]} {
+		jobRun, ok := runs[i].Inputs.Val.(map[string]interface{})["jobRun"].(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, lg.TxHash, jobRun["logTxHash"])
+		assert.Equal(t, lg.BlockNumber, jobRun["logBlockNumber"])
+		assert.Equal(t, lg.LogIndex, jobRun["logIndex"])
+		assert.Equal(t, lg.Data, jobRun["logRawData"])
+		value := new(big.Int).SetBytes(lg.Data)
+		assert.Equal(t, map[string]interface{}{"from": sender, "to": recipient, "value": value}, jobRun["logData"])
+	}
+
+	// After a restart, the logs before the cursor are not processed again
+	l, err = evmlog.NewTestListener(logger.TestLogger(t), jb, lp, runner, orm)
+	require.NoError(t, err)
+	require.NoError(t, l.ProcessLogs(ctx))
+	assert.Equal(t, 4, calls)
+}
+
+func TestListener_MinConfirmations(t *testing.T) {
+	ctx := testutils.Context(t)
+	jb, orm, _ := setupJob(t)
+	jb.EVMLogSpec.MinConfirmations.Valid = true
+	jb.EVMLogSpec.MinConfirmations.Uint32 = 5
+
+	lp := lpmocks.NewLogPoller(t)
+	l, err := evmlog.NewTestListener(logger.TestLogger(t), jb, lp, pipelinemocks.NewRunner(t), orm)
+	require.NoError(t, err)
+
+	// Block 16 has 5 confirmations when block 20 is the latest
+	lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 20, FinalizedBlockNumber: 10}, nil).Once()
+	require.NoError(t, l.ProcessLogs(ctx))
+	cursor, _, err := orm.Cursor(ctx, jb.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(16), cursor)
+}
@@ -0,0 +1,23 @@
+package evmlog
+
+import (
+	"context"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func NewTestListener(lggr logger.Logger, jb job.Job, lp logpoller.LogPoller, pipelineRunner pipeline.Runner, orm *ORM) (*listener, error) {
+	event, err := pipeline.ParseETHABIEvent(jb.EVMLogSpec.EventABI)
+	if err != nil {
+		return nil, err
+	}
+	return newListener(lggr, jb, event, lp, pipelineRunner, orm, time.Hour), nil
+}
+
+func (l *listener) ProcessLogs(ctx context.Context) error { return l.processLogs(ctx) }
+
+func FilterName(jobID int32) string { return filterName(jobID) }
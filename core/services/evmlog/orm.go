@@ -0,0 +1,75 @@
+package evmlog
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// ErrLogAlreadyConsumed is returned by MarkLogConsumed if the job already ran its pipeline for the log.
+var ErrLogAlreadyConsumed = errors.New("log already consumed")
+
+// ORM keeps track of the logs evmlog jobs have run their pipeline for, so that each log is
+// processed exactly once across restarts.
+type ORM struct {
+	ds sqlutil.DataSource
+}
+
+func NewORM(ds sqlutil.DataSource) *ORM {
+	return &ORM{ds: ds}
+}
+
+// Cursor returns the last block the job has processed all the logs of, and false if it has
+// not processed any block yet.
+func (o *ORM) Cursor(ctx context.Context, jobID int32) (blockNumber int64, found bool, err error) {
+	err = o.ds.GetContext(ctx, &blockNumber, `SELECT block_number FROM evm_log_job_cursors WHERE job_id = $1`, jobID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "failed to get cursor for job %d", jobID)
+	}
+	return blockNumber, true, nil
+}
+
+// SetCursor records that the job processed all the logs up to and including blockNumber. The
+// consumed logs of those blocks are no longer needed and are deleted.
+func (o *ORM) SetCursor(ctx context.Context, jobID int32, blockNumber int64) error {
+	return sqlutil.Transact(ctx, o.new, o.ds, nil, func(tx *ORM) error {
+		_, err := tx.ds.ExecContext(ctx, `INSERT INTO evm_log_job_cursors (job_id, block_number, updated_at) VALUES ($1, $2, NOW())
+ON CONFLICT (job_id) DO UPDATE SET block_number = EXCLUDED.block_number, updated_at = EXCLUDED.updated_at`, jobID, blockNumber)
+		if err != nil {
+			return errors.Wrapf(err, "failed to set cursor for job %d", jobID)
+		}
+		_, err = tx.ds.ExecContext(ctx, `DELETE FROM evm_log_job_consumed_logs WHERE job_id = $1 AND block_number <= $2`, jobID, blockNumber)
+		return errors.Wrapf(err, "failed to delete consumed logs for job %d", jobID)
+	})
+}
+
+// MarkLogConsumed records that the job ran its pipeline for a log. ds is an optional override,
+// so that the log can be marked consumed in the same transaction as the pipeline run is created.
+// It returns ErrLogAlreadyConsumed if the log was already marked consumed.
+func (o *ORM) MarkLogConsumed(ctx context.Context, ds sqlutil.DataSource, jobID int32, blockHash common.Hash, blockNumber int64, logIndex int64) error {
+	if ds == nil {
+		ds = o.ds
+	}
+	res, err := ds.ExecContext(ctx, `INSERT INTO evm_log_job_consumed_logs (job_id, block_hash, block_number, log_index, created_at) VALUES ($1, $2, $3, $4, NOW())
+ON CONFLICT DO NOTHING`, jobID, blockHash, blockNumber, logIndex)
+	if err != nil {
+		return errors.Wrap(err, "failed to mark log consumed")
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to mark log consumed")
+	}
+	if rows == 0 {
+		return ErrLogAlreadyConsumed
+	}
+	return nil
+}
+
+func (o *ORM) new(ds sqlutil.DataSource) *ORM { return NewORM(ds) }
@@ -0,0 +1,55 @@
+package evmlog
+
+import (
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func ValidatedEVMLogSpec(tomlString string) (job.Job, error) {
+	var jb = job.Job{}
+	tree, err := toml.Load(tomlString)
+	if err != nil {
+		return jb, err
+	}
+	err = tree.Unmarshal(&jb)
+	if err != nil {
+		return jb, err
+	}
+	var spec job.EVMLogSpec
+	err = tree.Unmarshal(&spec)
+	if err != nil {
+		return jb, err
+	}
+	jb.EVMLogSpec = &spec
+
+	if jb.Type != job.EVMLog {
+		return jb, errors.Errorf("unsupported type %s", jb.Type)
+	}
+	if spec.EVMChainID == nil {
+		return jb, errors.New("evmChainID must be defined")
+	}
+	if spec.ContractAddress == "" {
+		return jb, errors.New("contractAddress must be defined")
+	}
+	event, err := pipeline.ParseETHABIEvent(spec.EventABI)
+	if err != nil {
+		return jb, errors.Wrap(err, "invalid eventABI")
+	}
+
+	var indexed int
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed++
+		}
+	}
+	for i, topic := range []models.HashCollection{spec.Topic2, spec.Topic3, spec.Topic4} {
+		if len(topic) > 0 && i >= indexed {
+			return jb, errors.Errorf("topic%d filter requires event %s to have at least %d indexed arguments", i+2, event.Name, i+1)
+		}
+	}
+	return jb, nil
+}
@@ -0,0 +1,87 @@
+package evmlog
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func TestValidatedEVMLogSpec(t *testing.T) {
+	t.Parallel()
+
+	const header = `
+type              = "evmlog"
+schemaVersion     = 1
+name              = "transfers"
+evmChainID        = 1
+contractAddress   = "0x613a38AC1659769640aaE063C651F48E0250454C"
+observationSource = """
+    ds1 [type=memo value="$(jobRun.logData.value)"];
+"""
+`
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := ValidatedEVMLogSpec(header + `
+eventABI         = "Transfer(address indexed from, address indexed to, uint256 value)"
+topic3           = ["0x0000000000000000000000001111111111111111111111111111111111111111"]
+minConfirmations = 12
+`)
+		require.NoError(t, err)
+
+		spec := s.EVMLogSpec
+		require.NotNil(t, spec)
+		assert.Equal(t, "0x613a38AC1659769640aaE063C651F48E0250454C", spec.ContractAddress.Hex())
+		assert.Equal(t, "1", spec.EVMChainID.String())
+		assert.Empty(t, spec.Topic2)
+		assert.Equal(t, models.HashCollection{common.HexToHash("0x1111111111111111111111111111111111111111")}, spec.Topic3)
+		assert.Empty(t, spec.Topic4)
+		assert.True(t, spec.MinConfirmations.Valid)
+		assert.Equal(t, uint32(12), spec.MinConfirmations.Uint32)
+	})
+
+	t.Run("finalized by default", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := ValidatedEVMLogSpec(header + `eventABI = "Ping(uint256 value)"`)
+		require.NoError(t, err)
+		assert.False(t, s.EVMLogSpec.MinConfirmations.Valid)
+	})
+
+	for _, tt := range []struct {
+		name string
+		toml string
+		err  string
+	}{
+		{"missing event", header, "invalid eventABI"},
+		{"invalid event", header + `eventABI = "Transfer(address from"`, "invalid eventABI"},
+		{"too many topic filters", header + `
+eventABI = "Transfer(address indexed from, address to, uint256 value)"
+topic3   = ["0x0000000000000000000000001111111111111111111111111111111111111111"]
+`, "topic3 filter requires event Transfer to have at least 2 indexed arguments"},
+		{"missing chain", `
+type            = "evmlog"
+schemaVersion   = 1
+contractAddress = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI        = "Ping(uint256 value)"
+`, "evmChainID must be defined"},
+		{"wrong type", `
+type       = "directrequest"
+evmChainID = 1
+`, "unsupported type directrequest"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ValidatedEVMLogSpec(tt.toml)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
@@ -1191,6 +1191,7 @@ func (s *service) generateJob(ctx context.Context, spec string) (*job.Job, error
 	if err != nil {
 		return nil, err
 	}
+	js.SpecTOML = spec
 
 	return &js, nil
 }
@@ -179,6 +179,14 @@ func (h *functionsConnectorHandler) handleSecretsList(ctx context.Context, gatew
 				Expiration: row.Expiration,
 			}
 		}
+		usage := s4.UsageOf(snapshot, time.Now())
+		constraints := h.storage.Constraints()
+		response.Usage = &functions.SecretsUsage{
+			SlotsUsed: usage.SlotsUsed,
+			MaxSlots:  constraints.MaxSlotsPerUser,
+			BytesUsed: usage.BytesUsed,
+			MaxBytes:  constraints.MaxBytesPerUser,
+		}
 	} else {
 		response.ErrorMessage = fmt.Sprintf("Failed to list secrets: %v", err)
 	}
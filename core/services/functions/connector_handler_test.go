@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math/big"
 	"testing"
 	"time"
@@ -120,16 +121,19 @@ func TestFunctionsConnectorHandler(t *testing.T) {
 			require.NoError(t, msg.Sign(privateKey))
 
 			ctx := testutils.Context(t)
+			expiration := time.Now().Add(time.Hour).UnixMilli()
 			snapshot := []*s4.SnapshotRow{
-				{SlotId: 1, Version: 1, Expiration: 1},
-				{SlotId: 2, Version: 2, Expiration: 2},
+				{SlotId: 1, Version: 1, Expiration: 1, PayloadSize: 10},
+				{SlotId: 2, Version: 2, Expiration: expiration, PayloadSize: 20},
 			}
 			storage.On("List", ctx, addr).Return(snapshot, nil).Once()
+			storage.On("Constraints").Return(s4.Constraints{MaxSlotsPerUser: 5, MaxBytesPerUser: 100}).Once()
 			allowlist.On("Allow", addr).Return(true).Once()
 			connector.On("SendToGateway", ctx, "gw1", mock.Anything).Run(func(args mock.Arguments) {
 				msg, ok := args[2].(*api.Message)
 				require.True(t, ok)
-				require.Equal(t, `{"success":true,"rows":[{"slot_id":1,"version":1,"expiration":1},{"slot_id":2,"version":2,"expiration":2}]}`, string(msg.Body.Payload))
+				expected := fmt.Sprintf(`{"success":true,"rows":[{"slot_id":1,"version":1,"expiration":1},{"slot_id":2,"version":2,"expiration":%d}],"usage":{"slots_used":1,"max_slots":5,"bytes_used":20,"max_bytes":100}}`, expiration)
+				require.Equal(t, expected, string(msg.Body.Payload))
 			}).Return(nil).Once()
 
 			handler.HandleGatewayMessage(ctx, "gw1", &msg)
@@ -10,11 +10,13 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/functions"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/webapi"
 )
 
 const (
 	FunctionsHandlerType HandlerType = "functions"
 	DummyHandlerType     HandlerType = "dummy"
+	WebAPIHandlerType    HandlerType = "webapi"
 )
 
 type handlerFactory struct {
@@ -35,6 +37,8 @@ func (hf *handlerFactory) NewHandler(handlerType HandlerType, handlerConfig json
 		return functions.NewFunctionsHandlerFromConfig(handlerConfig, donConfig, don, hf.legacyChains, hf.ds, hf.lggr)
 	case DummyHandlerType:
 		return handlers.NewDummyHandler(donConfig, don, hf.lggr)
+	case WebAPIHandlerType:
+		return webapi.NewWebAPIHandlerFromConfig(handlerConfig, donConfig, don, hf.lggr)
 	default:
 		return nil, fmt.Errorf("unsupported handler type %s", handlerType)
 	}
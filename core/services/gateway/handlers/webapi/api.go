@@ -0,0 +1,16 @@
+package webapi
+
+import "github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+
+// Nodes are expected to respond to each forwarded request with a payload starting with ResponseBase.
+// Any additional fields are passed back to the user as-is, inside CombinedResponse.
+type ResponseBase struct {
+	Success      bool   `json:"success"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// Gateway -> User response, which combines responses from the nodes that made up the quorum
+type CombinedResponse struct {
+	ResponseBase
+	NodeResponses []*api.Message `json:"node_responses"`
+}
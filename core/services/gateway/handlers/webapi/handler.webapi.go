@@ -0,0 +1,284 @@
+package webapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	hc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+)
+
+var (
+	ErrNotAllowlisted    = errors.New("sender not allowlisted")
+	ErrRateLimited       = errors.New("rate-limited")
+	ErrUnsupportedMethod = errors.New("unsupported method")
+
+	promHandlerError = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_webapi_handler_error",
+		Help: "Metric to track webapi handler errors",
+	}, []string{"don_id", "error"})
+
+	promRequestSuccess = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_webapi_request_success",
+		Help: "Metric to track webapi requests which reached a successful quorum",
+	}, []string{"don_id"})
+
+	promRequestFailure = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_webapi_request_failure",
+		Help: "Metric to track webapi requests which could not reach a successful quorum",
+	}, []string{"don_id"})
+)
+
+// QuorumRule decides how many successful node responses are needed before returning a response to the user.
+type QuorumRule string
+
+const (
+	// QuorumFPlusOne requires F+1 successful responses, i.e. at least one from an honest node.
+	QuorumFPlusOne QuorumRule = "F+1"
+	// Quorum2FPlusOne requires 2F+1 successful responses, i.e. a majority of honest nodes.
+	Quorum2FPlusOne QuorumRule = "2F+1"
+	// QuorumAll requires a successful response from every DON member.
+	QuorumAll QuorumRule = "ALL"
+)
+
+// threshold returns the number of successful responses required from a DON of n nodes tolerating f faulty ones.
+func (q QuorumRule) threshold(n, f int) (int, error) {
+	var threshold int
+	switch q {
+	case QuorumFPlusOne, "":
+		threshold = f + 1
+	case Quorum2FPlusOne:
+		threshold = 2*f + 1
+	case QuorumAll:
+		threshold = n
+	default:
+		return 0, fmt.Errorf("unsupported quorum rule %q", q)
+	}
+	if threshold > n {
+		return 0, fmt.Errorf("quorum rule %q requires %d responses but the DON only has %d members", q, threshold, n)
+	}
+	return threshold, nil
+}
+
+type WebAPIHandlerConfig struct {
+	// Not specifying AllowedSenders disables allowlist checks
+	AllowedSenders []string `json:"allowedSenders"`
+	// Not specifying AllowedMethods allows forwarding any method
+	AllowedMethods []string `json:"allowedMethods"`
+	// Not specifying RateLimiter config disables rate limiting
+	UserRateLimiter      *hc.RateLimiterConfig `json:"userRateLimiter"`
+	NodeRateLimiter      *hc.RateLimiterConfig `json:"nodeRateLimiter"`
+	MaxPendingRequests   uint32                `json:"maxPendingRequests"`
+	RequestTimeoutMillis int64                 `json:"requestTimeoutMillis"`
+	// Defaults to QuorumFPlusOne
+	Quorum QuorumRule `json:"quorum"`
+}
+
+type webAPIHandler struct {
+	services.StateMachine
+
+	donConfig       *config.DONConfig
+	don             handlers.DON
+	pendingRequests hc.RequestCache[PendingRequest]
+	allowedSenders  map[string]struct{}
+	allowedMethods  map[string]struct{}
+	userRateLimiter *hc.RateLimiter
+	nodeRateLimiter *hc.RateLimiter
+	quorum          int
+	lggr            logger.Logger
+}
+
+type PendingRequest struct {
+	request    *api.Message
+	responses  map[string]*api.Message
+	successful []*api.Message
+	errors     []*api.Message
+}
+
+var _ handlers.Handler = (*webAPIHandler)(nil)
+
+func NewWebAPIHandlerFromConfig(handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON, lggr logger.Logger) (handlers.Handler, error) {
+	var cfg WebAPIHandlerConfig
+	err := json.Unmarshal(handlerConfig, &cfg)
+	if err != nil {
+		return nil, err
+	}
+	var userRateLimiter, nodeRateLimiter *hc.RateLimiter
+	if cfg.UserRateLimiter != nil {
+		userRateLimiter, err = hc.NewRateLimiter(*cfg.UserRateLimiter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cfg.NodeRateLimiter != nil {
+		nodeRateLimiter, err = hc.NewRateLimiter(*cfg.NodeRateLimiter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pendingRequestsCache := hc.NewRequestCache[PendingRequest](time.Millisecond*time.Duration(cfg.RequestTimeoutMillis), cfg.MaxPendingRequests)
+	return NewWebAPIHandler(cfg, donConfig, don, pendingRequestsCache, userRateLimiter, nodeRateLimiter, lggr)
+}
+
+func NewWebAPIHandler(
+	cfg WebAPIHandlerConfig,
+	donConfig *config.DONConfig,
+	don handlers.DON,
+	pendingRequestsCache hc.RequestCache[PendingRequest],
+	userRateLimiter *hc.RateLimiter,
+	nodeRateLimiter *hc.RateLimiter,
+	lggr logger.Logger) (handlers.Handler, error) {
+	quorum, err := cfg.Quorum.threshold(len(donConfig.Members), donConfig.F)
+	if err != nil {
+		return nil, err
+	}
+	var allowedSenders map[string]struct{}
+	if len(cfg.AllowedSenders) > 0 {
+		allowedSenders = make(map[string]struct{})
+		for _, sender := range cfg.AllowedSenders {
+			allowedSenders[strings.ToLower(sender)] = struct{}{}
+		}
+	}
+	var allowedMethods map[string]struct{}
+	if len(cfg.AllowedMethods) > 0 {
+		allowedMethods = make(map[string]struct{})
+		for _, method := range cfg.AllowedMethods {
+			allowedMethods[method] = struct{}{}
+		}
+	}
+	return &webAPIHandler{
+		donConfig:       donConfig,
+		don:             don,
+		pendingRequests: pendingRequestsCache,
+		allowedSenders:  allowedSenders,
+		allowedMethods:  allowedMethods,
+		userRateLimiter: userRateLimiter,
+		nodeRateLimiter: nodeRateLimiter,
+		quorum:          quorum,
+		lggr:            lggr.Named("WebAPIHandler:" + donConfig.DonId),
+	}, nil
+}
+
+// HandleUserMessage forwards a user request to all DON members. The message signature has already been
+// validated by the gateway, so msg.Body.Sender is the authenticated sender address.
+func (h *webAPIHandler) HandleUserMessage(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload) error {
+	if h.allowedSenders != nil {
+		if _, ok := h.allowedSenders[strings.ToLower(msg.Body.Sender)]; !ok {
+			h.lggr.Debugw("received a message from a non-allowlisted address", "sender", msg.Body.Sender)
+			promHandlerError.WithLabelValues(h.donConfig.DonId, ErrNotAllowlisted.Error()).Inc()
+			return ErrNotAllowlisted
+		}
+	}
+	if h.allowedMethods != nil {
+		if _, ok := h.allowedMethods[msg.Body.Method]; !ok {
+			h.lggr.Debugw("unsupported method", "method", msg.Body.Method)
+			promHandlerError.WithLabelValues(h.donConfig.DonId, ErrUnsupportedMethod.Error()).Inc()
+			return ErrUnsupportedMethod
+		}
+	}
+	if h.userRateLimiter != nil && !h.userRateLimiter.Allow(msg.Body.Sender) {
+		h.lggr.Debugw("rate-limited", "sender", msg.Body.Sender)
+		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrRateLimited.Error()).Inc()
+		return ErrRateLimited
+	}
+
+	h.lggr.Debugw("HandleUserMessage: processing message", "sender", msg.Body.Sender, "messageId", msg.Body.MessageId, "method", msg.Body.Method)
+	err := h.pendingRequests.NewRequest(msg, callbackCh, &PendingRequest{request: msg, responses: make(map[string]*api.Message)})
+	if err != nil {
+		h.lggr.Warnw("HandleUserMessage: error adding new request", "sender", msg.Body.Sender, "err", err)
+		promHandlerError.WithLabelValues(h.donConfig.DonId, err.Error()).Inc()
+		return err
+	}
+	// Send to all nodes.
+	for _, member := range h.donConfig.Members {
+		err := h.don.SendToNode(ctx, member.Address, msg)
+		if err != nil {
+			h.lggr.Debugw("HandleUserMessage: failed to send to a node", "node", member.Address, "err", err)
+		}
+	}
+	return nil
+}
+
+func (h *webAPIHandler) HandleNodeMessage(ctx context.Context, msg *api.Message, nodeAddr string) error {
+	h.lggr.Debugw("HandleNodeMessage: processing message", "nodeAddr", nodeAddr, "receiver", msg.Body.Receiver, "id", msg.Body.MessageId)
+	if h.nodeRateLimiter != nil && !h.nodeRateLimiter.Allow(nodeAddr) {
+		h.lggr.Debugw("rate-limited", "sender", nodeAddr)
+		return ErrRateLimited
+	}
+	return h.pendingRequests.ProcessResponse(msg, h.processResponse)
+}
+
+// Conforms to ResponseProcessor[*PendingRequest]
+func (h *webAPIHandler) processResponse(response *api.Message, responseData *PendingRequest) (*handlers.UserCallbackPayload, *PendingRequest, error) {
+	if _, exists := responseData.responses[response.Body.Sender]; exists {
+		return nil, nil, errors.New("duplicate response")
+	}
+	if response.Body.Method != responseData.request.Body.Method {
+		return nil, responseData, errors.New("invalid method")
+	}
+	responseData.responses[response.Body.Sender] = response
+	var responsePayload ResponseBase
+	err := json.Unmarshal(response.Body.Payload, &responsePayload)
+	if err != nil || !responsePayload.Success {
+		responseData.errors = append(responseData.errors, response)
+		// user response is a failure once the quorum can't be reached anymore
+		if len(responseData.errors) > len(h.donConfig.Members)-h.quorum {
+			callbackPayload, err2 := h.newUserResponse(responseData.request, false, responseData.errors)
+			return callbackPayload, responseData, err2
+		}
+		return nil, responseData, err
+	}
+	responseData.successful = append(responseData.successful, response)
+	if len(responseData.successful) >= h.quorum {
+		callbackPayload, err := h.newUserResponse(responseData.request, true, responseData.successful)
+		return callbackPayload, responseData, err
+	}
+	// not ready to be processed yet
+	return nil, responseData, nil
+}
+
+func (h *webAPIHandler) newUserResponse(request *api.Message, success bool, responses []*api.Message) (*handlers.UserCallbackPayload, error) {
+	payload := CombinedResponse{ResponseBase: ResponseBase{Success: success}, NodeResponses: responses}
+	if !success {
+		payload.ErrorMessage = fmt.Sprintf("quorum of %d successful node responses not reached", h.quorum)
+	}
+	payloadJson, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if success {
+		promRequestSuccess.WithLabelValues(h.donConfig.DonId).Inc()
+	} else {
+		promRequestFailure.WithLabelValues(h.donConfig.DonId).Inc()
+	}
+
+	userResponse := *request
+	userResponse.Body.Receiver = request.Body.Sender
+	userResponse.Body.Payload = payloadJson
+	return &handlers.UserCallbackPayload{Msg: &userResponse, ErrCode: api.NoError, ErrMsg: ""}, nil
+}
+
+func (h *webAPIHandler) Start(context.Context) error {
+	return h.StartOnce("WebAPIHandler", func() error {
+		h.lggr.Info("starting WebAPIHandler")
+		return nil
+	})
+}
+
+func (h *webAPIHandler) Close() error {
+	return h.StopOnce("WebAPIHandler", func() error {
+		return nil
+	})
+}
@@ -0,0 +1,184 @@
+package webapi_test
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	gc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	hc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+	handlers_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/webapi"
+)
+
+func newDONConfig(nodes []gc.TestNode) *config.DONConfig {
+	donConfig := &config.DONConfig{
+		DonId:   "don_id",
+		Members: []config.NodeConfig{},
+		F:       1,
+	}
+	for id, n := range nodes {
+		donConfig.Members = append(donConfig.Members, config.NodeConfig{
+			Name:    fmt.Sprintf("node_%d", id),
+			Address: n.Address,
+		})
+	}
+	return donConfig
+}
+
+func newWebAPIHandlerForATestDON(t *testing.T, nodes []gc.TestNode, cfg webapi.WebAPIHandlerConfig) (handlers.Handler, *handlers_mocks.DON) {
+	don := handlers_mocks.NewDON(t)
+	userRateLimiter, err := hc.NewRateLimiter(hc.RateLimiterConfig{GlobalRPS: 100.0, GlobalBurst: 100, PerSenderRPS: 100.0, PerSenderBurst: 100})
+	require.NoError(t, err)
+	pendingRequestsCache := hc.NewRequestCache[webapi.PendingRequest](time.Hour, 1000)
+	handler, err := webapi.NewWebAPIHandler(cfg, newDONConfig(nodes), don, pendingRequestsCache, userRateLimiter, nil, logger.TestLogger(t))
+	require.NoError(t, err)
+	return handler, don
+}
+
+func newSignedMessage(t *testing.T, id string, method string, privateKey *ecdsa.PrivateKey) api.Message {
+	msg := api.Message{
+		Body: api.MessageBody{
+			MessageId: id,
+			Method:    method,
+			DonId:     "don_id",
+			Payload:   []byte(`{"url":"https://example.com"}`),
+		},
+	}
+	require.NoError(t, msg.Sign(privateKey))
+	return msg
+}
+
+func sendNodeReponses(t *testing.T, handler handlers.Handler, userRequestMsg api.Message, nodes []gc.TestNode, responses []bool) {
+	for id, resp := range responses {
+		nodeResponseMsg := userRequestMsg
+		nodeResponseMsg.Body.Receiver = userRequestMsg.Body.Sender
+		if resp {
+			nodeResponseMsg.Body.Payload = []byte(`{"success":true,"body":"ok"}`)
+		} else {
+			nodeResponseMsg.Body.Payload = []byte(`{"success":false,"error_message":"boom"}`)
+		}
+		require.NoError(t, nodeResponseMsg.Sign(nodes[id].PrivateKey))
+		_ = handler.HandleNodeMessage(testutils.Context(t), &nodeResponseMsg, nodes[id].Address)
+	}
+}
+
+func TestWebAPIHandler_CleanStartAndClose(t *testing.T) {
+	t.Parallel()
+
+	nodes := gc.NewTestNodes(t, 4)
+	donConfig := newDONConfig(nodes)
+	handler, err := webapi.NewWebAPIHandlerFromConfig(json.RawMessage(`{"quorum":"2F+1"}`), donConfig, nil, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	servicetest.Run(t, handler)
+}
+
+func TestWebAPIHandler_InvalidQuorum(t *testing.T) {
+	t.Parallel()
+
+	donConfig := newDONConfig(gc.NewTestNodes(t, 2))
+	_, err := webapi.NewWebAPIHandlerFromConfig(json.RawMessage(`{"quorum":"2F+1"}`), donConfig, nil, logger.TestLogger(t))
+	require.ErrorContains(t, err, "requires 3 responses")
+
+	_, err = webapi.NewWebAPIHandlerFromConfig(json.RawMessage(`{"quorum":"most"}`), donConfig, nil, logger.TestLogger(t))
+	require.ErrorContains(t, err, "unsupported quorum rule")
+}
+
+func TestWebAPIHandler_HandleUserMessage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                     string
+		quorum                   webapi.QuorumRule
+		nodeResults              []bool
+		expectedGatewayResult    bool
+		expectedNodeMessageCount int
+	}{
+		{"F+1: two successful", webapi.QuorumFPlusOne, []bool{false, true, false, true}, true, 2},
+		{"F+1: one successful", webapi.QuorumFPlusOne, []bool{false, true, false, false}, false, 3},
+		{"2F+1: three successful", webapi.Quorum2FPlusOne, []bool{true, false, true, true}, true, 3},
+		{"2F+1: two successful", webapi.Quorum2FPlusOne, []bool{true, false, false, true}, false, 2},
+		{"ALL: four successful", webapi.QuorumAll, []bool{true, true, true, true}, true, 4},
+		{"ALL: one failed", webapi.QuorumAll, []bool{true, false, true, true}, false, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+			handler, don := newWebAPIHandlerForATestDON(t, nodes, webapi.WebAPIHandlerConfig{Quorum: test.quorum})
+			userRequestMsg := newSignedMessage(t, "1234", "fetch", user.PrivateKey)
+
+			callbackCh := make(chan handlers.UserCallbackPayload)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				// wait on a response from Gateway to the user
+				response := <-callbackCh
+				require.Equal(t, api.NoError, response.ErrCode)
+				require.Equal(t, userRequestMsg.Body.MessageId, response.Msg.Body.MessageId)
+				require.Equal(t, userRequestMsg.Body.Sender, response.Msg.Body.Receiver)
+				var payload webapi.CombinedResponse
+				require.NoError(t, json.Unmarshal(response.Msg.Body.Payload, &payload))
+				require.Equal(t, test.expectedGatewayResult, payload.Success)
+				require.Equal(t, test.expectedNodeMessageCount, len(payload.NodeResponses))
+			}()
+
+			don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(4)
+			require.NoError(t, handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, callbackCh))
+			sendNodeReponses(t, handler, userRequestMsg, nodes, test.nodeResults)
+			<-done
+		})
+	}
+}
+
+func TestWebAPIHandler_HandleUserMessage_NotAllowlisted(t *testing.T) {
+	t.Parallel()
+
+	nodes, users := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 2)
+	handler, _ := newWebAPIHandlerForATestDON(t, nodes, webapi.WebAPIHandlerConfig{AllowedSenders: []string{users[0].Address}})
+
+	userRequestMsg := newSignedMessage(t, "1234", "fetch", users[1].PrivateKey)
+	err := handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, make(chan handlers.UserCallbackPayload))
+	require.ErrorIs(t, err, webapi.ErrNotAllowlisted)
+}
+
+func TestWebAPIHandler_HandleUserMessage_UnsupportedMethod(t *testing.T) {
+	t.Parallel()
+
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	handler, _ := newWebAPIHandlerForATestDON(t, nodes, webapi.WebAPIHandlerConfig{AllowedMethods: []string{"fetch"}})
+
+	userRequestMsg := newSignedMessage(t, "1234", "delete_everything", user.PrivateKey)
+	err := handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, make(chan handlers.UserCallbackPayload))
+	require.ErrorIs(t, err, webapi.ErrUnsupportedMethod)
+}
+
+func TestWebAPIHandler_HandleUserMessage_RateLimited(t *testing.T) {
+	t.Parallel()
+
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	don := handlers_mocks.NewDON(t)
+	userRateLimiter, err := hc.NewRateLimiter(hc.RateLimiterConfig{GlobalRPS: 100.0, GlobalBurst: 100, PerSenderRPS: 0.01, PerSenderBurst: 1})
+	require.NoError(t, err)
+	pendingRequestsCache := hc.NewRequestCache[webapi.PendingRequest](time.Hour, 1000)
+	handler, err := webapi.NewWebAPIHandler(webapi.WebAPIHandlerConfig{}, newDONConfig(nodes), don, pendingRequestsCache, userRateLimiter, nil, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(4)
+	msg1 := newSignedMessage(t, "1", "fetch", user.PrivateKey)
+	require.NoError(t, handler.HandleUserMessage(testutils.Context(t), &msg1, make(chan handlers.UserCallbackPayload, 1)))
+	msg2 := newSignedMessage(t, "2", "fetch", user.PrivateKey)
+	require.ErrorIs(t, handler.HandleUserMessage(testutils.Context(t), &msg2, make(chan handlers.UserCallbackPayload, 1)), webapi.ErrRateLimited)
+}
@@ -1753,6 +1753,44 @@ func Test_FindSpecErrorsByJobIDs(t *testing.T) {
 	assert.Equal(t, len(specErrs), 2)
 }
 
+func Test_SpecVersions(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	config := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config.JobPipeline().MaxSuccessfulRuns())
+	bridgesORM := bridges.NewORM(db)
+	orm := NewTestORM(t, db, pipelineORM, bridgesORM, keyStore)
+
+	jb, err := directrequest.ValidatedDirectRequestSpec(testspecs.GetDirectRequestSpec())
+	require.NoError(t, err)
+	jb.SpecTOML = `name = "v1"`
+	require.NoError(t, orm.CreateJob(ctx, &jb))
+
+	v2, err := orm.InsertSpecVersion(ctx, jb.ID, `name = "v2"`)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), v2.Version)
+
+	_, err = orm.InsertSpecVersion(ctx, jb.ID+1, `name = "other"`)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	versions, err := orm.FindSpecVersions(ctx, jb.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, int32(2), versions[0].Version)
+	assert.Equal(t, int32(1), versions[1].Version)
+
+	found, err := orm.FindSpecVersion(ctx, jb.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, `name = "v1"`, found.TOML)
+
+	_, err = orm.FindSpecVersion(ctx, jb.ID, 3)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
 func Test_CountPipelineRunsByJobID(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -603,6 +603,64 @@ func (_m *ORM) FindSpecErrorsByJobIDs(ctx context.Context, ids []int32) ([]job.S
 	return r0, r1
 }
 
+// FindSpecVersion provides a mock function with given fields: ctx, jobID, version
+func (_m *ORM) FindSpecVersion(ctx context.Context, jobID int32, version int32) (job.SpecVersion, error) {
+	ret := _m.Called(ctx, jobID, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSpecVersion")
+	}
+
+	var r0 job.SpecVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32) (job.SpecVersion, error)); ok {
+		return rf(ctx, jobID, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32) job.SpecVersion); ok {
+		r0 = rf(ctx, jobID, version)
+	} else {
+		r0 = ret.Get(0).(job.SpecVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, int32) error); ok {
+		r1 = rf(ctx, jobID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindSpecVersions provides a mock function with given fields: ctx, jobID
+func (_m *ORM) FindSpecVersions(ctx context.Context, jobID int32) ([]job.SpecVersion, error) {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSpecVersions")
+	}
+
+	var r0 []job.SpecVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]job.SpecVersion, error)); ok {
+		return rf(ctx, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []job.SpecVersion); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.SpecVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindTaskResultByRunIDAndTaskName provides a mock function with given fields: ctx, runID, taskName
 func (_m *ORM) FindTaskResultByRunIDAndTaskName(ctx context.Context, runID int64, taskName string) ([]byte, error) {
 	ret := _m.Called(ctx, runID, taskName)
@@ -651,6 +709,34 @@ func (_m *ORM) InsertJob(ctx context.Context, _a1 *job.Job) error {
 	return r0
 }
 
+// InsertSpecVersion provides a mock function with given fields: ctx, jobID, toml
+func (_m *ORM) InsertSpecVersion(ctx context.Context, jobID int32, toml string) (job.SpecVersion, error) {
+	ret := _m.Called(ctx, jobID, toml)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertSpecVersion")
+	}
+
+	var r0 job.SpecVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, string) (job.SpecVersion, error)); ok {
+		return rf(ctx, jobID, toml)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, string) job.SpecVersion); ok {
+		r0 = rf(ctx, jobID, toml)
+	} else {
+		r0 = ret.Get(0).(job.SpecVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, string) error); ok {
+		r1 = rf(ctx, jobID, toml)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InsertWebhookSpec provides a mock function with given fields: ctx, webhookSpec
 func (_m *ORM) InsertWebhookSpec(ctx context.Context, webhookSpec *job.WebhookSpec) error {
 	ret := _m.Called(ctx, webhookSpec)
@@ -38,6 +38,7 @@ const (
 	Bootstrap               Type = (Type)(pipeline.BootstrapJobType)
 	Cron                    Type = (Type)(pipeline.CronJobType)
 	DirectRequest           Type = (Type)(pipeline.DirectRequestJobType)
+	EVMLog                  Type = (Type)(pipeline.EVMLogJobType)
 	FluxMonitor             Type = (Type)(pipeline.FluxMonitorJobType)
 	Gateway                 Type = (Type)(pipeline.GatewayJobType)
 	Keeper                  Type = (Type)(pipeline.KeeperJobType)
@@ -78,6 +79,7 @@ var (
 		Bootstrap:               false,
 		Cron:                    true,
 		DirectRequest:           true,
+		EVMLog:                  true,
 		FluxMonitor:             true,
 		Gateway:                 false,
 		Keeper:                  false, // observationSource is injected in the upkeep executor
@@ -97,6 +99,7 @@ var (
 		Bootstrap:               false,
 		Cron:                    true,
 		DirectRequest:           true,
+		EVMLog:                  true,
 		FluxMonitor:             false,
 		Gateway:                 false,
 		Keeper:                  true,
@@ -116,6 +119,7 @@ var (
 		Bootstrap:               1,
 		Cron:                    1,
 		DirectRequest:           1,
+		EVMLog:                  1,
 		FluxMonitor:             1,
 		Gateway:                 1,
 		Keeper:                  1,
@@ -143,6 +147,8 @@ type Job struct {
 	CronSpec                      *CronSpec
 	DirectRequestSpecID           *int32
 	DirectRequestSpec             *DirectRequestSpec
+	EVMLogSpecID                  *int32
+	EVMLogSpec                    *EVMLogSpec
 	FluxMonitorSpecID             *int32
 	FluxMonitorSpec               *FluxMonitorSpec
 	KeeperSpecID                  *int32
@@ -182,6 +188,8 @@ type Job struct {
 	MaxTaskDuration               models.Interval
 	Pipeline                      pipeline.Pipeline `toml:"observationSource"`
 	CreatedAt                     time.Time
+	// SpecTOML is the TOML the job was validated from. When set, CreateJob records it as the job's next spec version.
+	SpecTOML string `toml:"-" db:"-" json:"-"`
 }
 
 func ExternalJobIDEncodeStringToTopic(id uuid.UUID) common.Hash {
@@ -231,6 +239,16 @@ type SpecError struct {
 	UpdatedAt   time.Time
 }
 
+// SpecVersion is an immutable snapshot of the TOML a job was created or updated with.
+// Versions are numbered from 1 for each job ID.
+type SpecVersion struct {
+	ID        int64
+	JobID     int32
+	Version   int32
+	TOML      string `db:"toml"`
+	CreatedAt time.Time
+}
+
 // SetID takes the id as a string and attempts to convert it to an int32. If
 // it succeeds, it will set it as the id on the job
 func (j *SpecError) SetID(value string) error {
@@ -456,8 +474,15 @@ type ExternalInitiatorWebhookSpec struct {
 type WebhookSpec struct {
 	ID                            int32 `toml:"-"`
 	ExternalInitiatorWebhookSpecs []ExternalInitiatorWebhookSpec
-	CreatedAt                     time.Time `json:"createdAt" toml:"-"`
-	UpdatedAt                     time.Time `json:"updatedAt" toml:"-"`
+	// SignatureSecret, if set, requires every request to be signed with HMAC-SHA256 using it as the key.
+	SignatureSecret string `json:"-" toml:"signatureSecret"`
+	// SignatureTolerance is how far the timestamp of a signed request may be from the time it's received.
+	SignatureTolerance models.Interval `toml:"signatureTolerance"`
+	// RateLimitRPS is the number of runs per second the job accepts, or unlimited if zero.
+	RateLimitRPS   float64   `toml:"rateLimitRPS"`
+	RateLimitBurst uint32    `toml:"rateLimitBurst"`
+	CreatedAt      time.Time `json:"createdAt" toml:"-"`
+	UpdatedAt      time.Time `json:"updatedAt" toml:"-"`
 }
 
 func (w WebhookSpec) GetID() string {
@@ -484,11 +509,54 @@ type DirectRequestSpec struct {
 	UpdatedAt                time.Time                `toml:"-"`
 }
 
+// EVMLogSpec defines a job which runs its pipeline once for every log emitted by a contract event.
+// Topic filters follow the log poller convention: Topic2 to Topic4 are the first to third indexed
+// arguments of the event, Topic1 being the event signature.
+type EVMLogSpec struct {
+	ID               int32                 `toml:"-"`
+	ContractAddress  evmtypes.EIP55Address `toml:"contractAddress"`
+	EventABI         string                `toml:"eventABI"`
+	Topic2           models.HashCollection `toml:"topic2"`
+	Topic3           models.HashCollection `toml:"topic3"`
+	Topic4           models.HashCollection `toml:"topic4"`
+	MinConfirmations clnull.Uint32         `toml:"minConfirmations"` // when not set, only finalized logs are processed
+	EVMChainID       *big.Big              `toml:"evmChainID"`
+	CreatedAt        time.Time             `toml:"-"`
+	UpdatedAt        time.Time             `toml:"-"`
+}
+
+// CronCatchUpPolicy controls what a cron job does about the runs it missed while the node was down.
+type CronCatchUpPolicy string
+
+const (
+	// CronCatchUpSkip skips the missed runs.
+	CronCatchUpSkip CronCatchUpPolicy = "skip"
+	// CronCatchUpOnce runs the pipeline once if any runs were missed.
+	CronCatchUpOnce CronCatchUpPolicy = "once"
+	// CronCatchUpAll runs the pipeline for each of the most recent missed runs, up to MaxCatchUpRuns.
+	CronCatchUpAll CronCatchUpPolicy = "all"
+)
+
 type CronSpec struct {
-	ID           int32     `toml:"-"`
-	CronSchedule string    `toml:"schedule"`
-	CreatedAt    time.Time `toml:"-"`
-	UpdatedAt    time.Time `toml:"-"`
+	ID           int32  `toml:"-"`
+	CronSchedule string `toml:"schedule"`
+	// Timezone is the IANA time zone the schedule is evaluated in, as an alternative to a CRON_TZ prefix.
+	Timezone string `toml:"timezone"`
+	// Jitter is the maximum random delay added to each scheduled run.
+	Jitter            models.Interval   `toml:"jitter"`
+	CatchUp           CronCatchUpPolicy `toml:"catchUp"`
+	MaxCatchUpRuns    uint32            `toml:"maxCatchUpRuns"`
+	MaxConcurrentRuns uint32            `toml:"maxConcurrentRuns"`
+	CreatedAt         time.Time         `toml:"-"`
+	UpdatedAt         time.Time         `toml:"-"`
+}
+
+// Schedule returns the cron schedule with the time zone of the spec applied, if any.
+func (s CronSpec) Schedule() string {
+	if s.Timezone == "" {
+		return s.CronSchedule
+	}
+	return "CRON_TZ=" + s.Timezone + " " + s.CronSchedule
 }
 
 func (s CronSpec) GetID() string {
@@ -80,6 +80,12 @@ type ORM interface {
 	WithDataSource(source sqlutil.DataSource) ORM
 
 	FindJobIDByWorkflow(ctx context.Context, spec WorkflowSpec) (int32, error)
+
+	// InsertSpecVersion records the TOML of a job as its next spec version.
+	InsertSpecVersion(ctx context.Context, jobID int32, toml string) (SpecVersion, error)
+	// FindSpecVersions returns all the spec versions of a job, most recent first.
+	FindSpecVersions(ctx context.Context, jobID int32) ([]SpecVersion, error)
+	FindSpecVersion(ctx context.Context, jobID int32, version int32) (SpecVersion, error)
 }
 
 type ORMConfig interface {
@@ -190,6 +196,15 @@ func (o *orm) CreateJob(ctx context.Context, jb *Job) error {
 				return fmt.Errorf("failed to create DirectRequestSpec for jobSpec: %w", err)
 			}
 			jb.DirectRequestSpecID = &specID
+		case EVMLog:
+			if jb.EVMLogSpec.EVMChainID == nil {
+				return errors.New("evm chain id must be defined")
+			}
+			specID, err := tx.insertEVMLogSpec(ctx, jb.EVMLogSpec)
+			if err != nil {
+				return fmt.Errorf("failed to create EVMLogSpec for jobSpec: %w", err)
+			}
+			jb.EVMLogSpecID = &specID
 		case FluxMonitor:
 			if jb.FluxMonitorSpec.EVMChainID == nil {
 				return errors.New("evm chain id must be defined")
@@ -440,7 +455,16 @@ func (o *orm) CreateJob(ctx context.Context, jb *Job) error {
 
 		err = tx.InsertJob(ctx, jb)
 		jobID = jb.ID
-		return errors.Wrap(err, "failed to insert job")
+		if err != nil {
+			return errors.Wrap(err, "failed to insert job")
+		}
+
+		if jb.SpecTOML != "" {
+			if _, err = tx.InsertSpecVersion(ctx, jb.ID, jb.SpecTOML); err != nil {
+				return errors.Wrap(err, "failed to record job spec version")
+			}
+		}
+		return nil
 	})
 	if err != nil {
 		return errors.Wrap(err, "CreateJobFailed")
@@ -466,6 +490,12 @@ func (o *orm) insertDirectRequestSpec(ctx context.Context, spec *DirectRequestSp
 			RETURNING id;`, spec)
 }
 
+func (o *orm) insertEVMLogSpec(ctx context.Context, spec *EVMLogSpec) (specID int32, err error) {
+	return o.prepareQuerySpecID(ctx, `INSERT INTO evm_log_specs (contract_address, event_abi, topic2, topic3, topic4, min_confirmations, evm_chain_id, created_at, updated_at)
+			VALUES (:contract_address, :event_abi, :topic2, :topic3, :topic4, :min_confirmations, :evm_chain_id, NOW(), NOW())
+			RETURNING id;`, spec)
+}
+
 func (o *orm) insertFluxMonitorSpec(ctx context.Context, spec *FluxMonitorSpec) (specID int32, err error) {
 	return o.prepareQuerySpecID(ctx, `INSERT INTO flux_monitor_specs (contract_address, threshold, absolute_threshold, poll_timer_period, poll_timer_disabled, idle_timer_period, idle_timer_disabled,
 					drumbeat_schedule, drumbeat_random_delay, drumbeat_enabled, min_payment, evm_chain_id, created_at, updated_at)
@@ -501,8 +531,8 @@ func (o *orm) insertKeeperSpec(ctx context.Context, spec *KeeperSpec) (specID in
 }
 
 func (o *orm) insertCronSpec(ctx context.Context, spec *CronSpec) (specID int32, err error) {
-	return o.prepareQuerySpecID(ctx, `INSERT INTO cron_specs (cron_schedule, created_at, updated_at)
-			VALUES (:cron_schedule, NOW(), NOW())
+	return o.prepareQuerySpecID(ctx, `INSERT INTO cron_specs (cron_schedule, timezone, jitter, catch_up, max_catch_up_runs, max_concurrent_runs, created_at, updated_at)
+			VALUES (:cron_schedule, :timezone, :jitter, :catch_up, :max_catch_up_runs, :max_concurrent_runs, NOW(), NOW())
 			RETURNING id;`, spec)
 }
 
@@ -623,8 +653,8 @@ func areSendingKeysDefined(ctx context.Context, jb *Job, keystore keystore.Maste
 }
 
 func (o *orm) InsertWebhookSpec(ctx context.Context, webhookSpec *WebhookSpec) error {
-	query, args, err := o.ds.BindNamed(`INSERT INTO webhook_specs (created_at, updated_at)
-			VALUES (NOW(), NOW())
+	query, args, err := o.ds.BindNamed(`INSERT INTO webhook_specs (signature_secret, signature_tolerance, rate_limit_rps, rate_limit_burst, created_at, updated_at)
+			VALUES (:signature_secret, :signature_tolerance, :rate_limit_rps, :rate_limit_burst, NOW(), NOW())
 			RETURNING *;`, webhookSpec)
 	if err != nil {
 		return fmt.Errorf("error binding arg: %w", err)
@@ -640,18 +670,18 @@ func (o *orm) InsertJob(ctx context.Context, job *Job) error {
 		if job.ID == 0 {
 			query = `INSERT INTO jobs (name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id, 
-                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, evm_log_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
 		VALUES (:name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id, 
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :evm_log_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
 		RETURNING *;`
 		} else {
 			query = `INSERT INTO jobs (id, name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 			keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id, 
-                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, evm_log_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
 		VALUES (:id, :name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id, 
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :evm_log_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
 		RETURNING *;`
 		}
 		query, args, err := tx.ds.BindNamed(query, job)
@@ -695,7 +725,8 @@ func (o *orm) DeleteJob(ctx context.Context, id int32) error {
 				block_header_feeder_spec_id,
 				gateway_spec_id,
 				workflow_spec_id,
-				standard_capabilities_spec_id
+				standard_capabilities_spec_id,
+				evm_log_spec_id
 		),
 		deleted_oracle_specs AS (
 			DELETE FROM ocr_oracle_specs WHERE id IN (SELECT ocr_oracle_spec_id FROM deleted_jobs)
@@ -738,6 +769,9 @@ func (o *orm) DeleteJob(ctx context.Context, id int32) error {
 		),
 		deleted_standardcapabilities_specs AS (
 			DELETE FROM standardcapabilities_specs WHERE id in (SELECT standard_capabilities_spec_id FROM deleted_jobs)
+		),
+		deleted_evm_log_specs AS (
+			DELETE FROM evm_log_specs WHERE id IN (SELECT evm_log_spec_id FROM deleted_jobs)
 		),	                               
 		deleted_job_pipeline_specs AS (
 			DELETE FROM job_pipeline_specs WHERE job_id IN (SELECT id FROM deleted_jobs) RETURNING pipeline_spec_id
@@ -804,6 +838,43 @@ func (o *orm) FindSpecError(ctx context.Context, id int64) (SpecError, error) {
 	return *specErr, errors.Wrap(err, "FindSpecError failed")
 }
 
+// InsertSpecVersion locks the job row so that concurrent inserts for the same job can't compute the same next version.
+// Secrets are redacted from toml before it's stored.
+func (o *orm) InsertSpecVersion(ctx context.Context, jobID int32, toml string) (specVersion SpecVersion, err error) {
+	toml, err = RedactSpecTOML(toml)
+	if err != nil {
+		return specVersion, errors.Wrap(err, "InsertSpecVersion failed")
+	}
+	err = o.transact(ctx, false, func(tx *orm) error {
+		var id int32
+		if err := tx.ds.GetContext(ctx, &id, `SELECT id FROM jobs WHERE id = $1 FOR UPDATE;`, jobID); err != nil {
+			return errors.Wrap(err, "failed to lock job")
+		}
+
+		stmt := `INSERT INTO job_spec_versions (job_id, version, toml, created_at)
+		SELECT $1, COALESCE(MAX(version), 0) + 1, $2, NOW() FROM job_spec_versions WHERE job_id = $1
+		RETURNING *;`
+		return tx.ds.GetContext(ctx, &specVersion, stmt, jobID, toml)
+	})
+	return specVersion, errors.Wrap(err, "InsertSpecVersion failed")
+}
+
+func (o *orm) FindSpecVersions(ctx context.Context, jobID int32) ([]SpecVersion, error) {
+	stmt := `SELECT * FROM job_spec_versions WHERE job_id = $1 ORDER BY version DESC;`
+
+	specVersions := []SpecVersion{}
+	err := o.ds.SelectContext(ctx, &specVersions, stmt, jobID)
+	return specVersions, errors.Wrap(err, "FindSpecVersions failed")
+}
+
+func (o *orm) FindSpecVersion(ctx context.Context, jobID int32, version int32) (SpecVersion, error) {
+	stmt := `SELECT * FROM job_spec_versions WHERE job_id = $1 AND version = $2;`
+
+	var specVersion SpecVersion
+	err := o.ds.GetContext(ctx, &specVersion, stmt, jobID, version)
+	return specVersion, errors.Wrap(err, "FindSpecVersion failed")
+}
+
 func (o *orm) FindJobs(ctx context.Context, offset, limit int) (jobs []Job, count int, err error) {
 	err = o.transact(ctx, false, func(tx *orm) error {
 		sql := `SELECT count(*) FROM jobs;`
@@ -1373,6 +1444,7 @@ func (o *orm) loadAllJobTypes(ctx context.Context, job *Job) error {
 		o.loadJobPipelineSpec(ctx, job, &job.PipelineSpecID),
 		o.loadJobType(ctx, job, "FluxMonitorSpec", "flux_monitor_specs", job.FluxMonitorSpecID),
 		o.loadJobType(ctx, job, "DirectRequestSpec", "direct_request_specs", job.DirectRequestSpecID),
+		o.loadJobType(ctx, job, "EVMLogSpec", "evm_log_specs", job.EVMLogSpecID),
 		o.loadJobType(ctx, job, "OCROracleSpec", "ocr_oracle_specs", job.OCROracleSpecID),
 		o.loadJobType(ctx, job, "OCR2OracleSpec", "ocr2_oracle_specs", job.OCR2OracleSpecID),
 		o.loadJobType(ctx, job, "KeeperSpec", "keeper_specs", job.KeeperSpecID),
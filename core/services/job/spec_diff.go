@@ -0,0 +1,80 @@
+package job
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+)
+
+type SpecChangeType string
+
+const (
+	SpecChangeAdded    SpecChangeType = "added"
+	SpecChangeRemoved  SpecChangeType = "removed"
+	SpecChangeModified SpecChangeType = "modified"
+)
+
+// SpecChange is a single difference between two job spec versions, identified by the dotted path of the TOML key.
+type SpecChange struct {
+	Key  string         `json:"key"`
+	Type SpecChangeType `json:"type"`
+	From interface{}    `json:"from,omitempty"`
+	To   interface{}    `json:"to,omitempty"`
+}
+
+// DiffSpecVersions compares the TOML of two spec versions key by key, and returns the changes needed to go from
+// one to the other, sorted by key. Tables are compared recursively, arrays and strings (e.g. observationSource) as
+// a whole.
+func DiffSpecVersions(from, to SpecVersion) ([]SpecChange, error) {
+	fromValues, err := flattenSpecTOML(from.TOML)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse version %d", from.Version)
+	}
+	toValues, err := flattenSpecTOML(to.TOML)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse version %d", to.Version)
+	}
+
+	changes := []SpecChange{}
+	for key, fromValue := range fromValues {
+		toValue, ok := toValues[key]
+		if !ok {
+			changes = append(changes, SpecChange{Key: key, Type: SpecChangeRemoved, From: fromValue})
+		} else if !reflect.DeepEqual(fromValue, toValue) {
+			changes = append(changes, SpecChange{Key: key, Type: SpecChangeModified, From: fromValue, To: toValue})
+		}
+	}
+	for key, toValue := range toValues {
+		if _, ok := fromValues[key]; !ok {
+			changes = append(changes, SpecChange{Key: key, Type: SpecChangeAdded, To: toValue})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes, nil
+}
+
+func flattenSpecTOML(spec string) (map[string]interface{}, error) {
+	tree, err := toml.Load(spec)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]interface{})
+	flattenSpecValues("", tree.ToMap(), values)
+	return values, nil
+}
+
+func flattenSpecValues(prefix string, m map[string]interface{}, values map[string]interface{}) {
+	for k, v := range m {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]interface{}); ok {
+			flattenSpecValues(key, nested, values)
+			continue
+		}
+		values[key] = v
+	}
+}
@@ -0,0 +1,84 @@
+package job_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+func TestDiffSpecVersions(t *testing.T) {
+	t.Parallel()
+
+	from := job.SpecVersion{Version: 1, TOML: `
+type = "cron"
+schemaVersion = 1
+name = "old"
+schedule = "CRON_TZ=UTC 0 0 1 1 *"
+observationSource = "ds [type=http method=GET url=\"https://example.com\"]"
+[labels]
+team = "a"
+`}
+	to := job.SpecVersion{Version: 2, TOML: `
+type = "cron"
+schemaVersion = 1
+name = "new"
+observationSource = "ds [type=http method=GET url=\"https://example.com\"]"
+forwardingAllowed = true
+[labels]
+team = "b"
+`}
+
+	changes, err := job.DiffSpecVersions(from, to)
+	require.NoError(t, err)
+	assert.Equal(t, []job.SpecChange{
+		{Key: "forwardingAllowed", Type: job.SpecChangeAdded, To: true},
+		{Key: "labels.team", Type: job.SpecChangeModified, From: "a", To: "b"},
+		{Key: "name", Type: job.SpecChangeModified, From: "old", To: "new"},
+		{Key: "schedule", Type: job.SpecChangeRemoved, From: "CRON_TZ=UTC 0 0 1 1 *"},
+	}, changes)
+
+	changes, err = job.DiffSpecVersions(to, to)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	_, err = job.DiffSpecVersions(from, job.SpecVersion{Version: 3, TOML: "not toml ["})
+	require.ErrorContains(t, err, "failed to parse version 3")
+}
+
+func TestRedactSpecTOML(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name   string
+		spec   string
+		exp    string
+		expErr bool
+	}{
+		{name: "no secret", spec: "type = \"webhook\"\nschemaVersion = 1\n", exp: "type = \"webhook\"\nschemaVersion = 1\n"},
+		{
+			name: "secret",
+			spec: "type = \"webhook\"\nsignatureSecret = \"partner-secret\"\nschemaVersion = 1\n",
+			exp:  "type = \"webhook\"\nsignatureSecret = \"<redacted>\"\nschemaVersion = 1\n",
+		},
+		{
+			name: "literal secret with comment",
+			spec: "  signatureSecret='partner-secret' # shared with partner\n",
+			exp:  "  signatureSecret= \"<redacted>\"\n",
+		},
+		{name: "multi-line secret", spec: "signatureSecret = \"\"\"partner\nsecret\"\"\"\n", expErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			redacted, err := job.RedactSpecTOML(tt.spec)
+			if tt.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.exp, redacted)
+			assert.NotContains(t, redacted, "partner-secret")
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package job
+
+import (
+	"regexp"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+)
+
+// RedactedSignatureSecret replaces the signatureSecret of webhook specs in the spec versions of a job, so that the
+// secret is never exposed through the version history.
+const RedactedSignatureSecret = "<redacted>"
+
+var signatureSecretLine = regexp.MustCompile(`(?m)^(\s*signatureSecret\s*=).*$`)
+
+// RedactSpecTOML returns spec with the value of signatureSecret replaced by RedactedSignatureSecret. The rest of
+// the spec is left as is.
+func RedactSpecTOML(spec string) (string, error) {
+	if !signatureSecretLine.MatchString(spec) {
+		return spec, nil
+	}
+	redacted := signatureSecretLine.ReplaceAllString(spec, `${1} "`+RedactedSignatureSecret+`"`)
+
+	// Make sure the secret didn't span more than the line that was replaced.
+	tree, err := toml.Load(redacted)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to redact signatureSecret")
+	}
+	if secret, _ := tree.Get("signatureSecret").(string); secret != RedactedSignatureSecret {
+		return "", errors.New("failed to redact signatureSecret")
+	}
+	return redacted, nil
+}
@@ -17,6 +17,7 @@ var (
 		Bootstrap:               {},
 		Cron:                    {},
 		DirectRequest:           {},
+		EVMLog:                  {},
 		FluxMonitor:             {},
 		Gateway:                 {},
 		Keeper:                  {},
@@ -13,6 +13,7 @@ import (
 	"github.com/smartcontractkit/chainlink-data-streams/llo"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 )
 
@@ -29,6 +30,12 @@ var (
 	},
 		[]string{"streamID"},
 	)
+	promInvalidObservationCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "llo_stream_invalid_observation_count",
+		Help: "Number of times we observed a stream, but its value failed to parse or validate",
+	},
+		[]string{"streamID"},
+	)
 )
 
 type ErrMissingStream struct {
@@ -78,10 +85,11 @@ func (d *dataSource) Observe(ctx context.Context, streamIDs map[llotypes.StreamI
 					d.lggr.Debugw("Observation failed for stream", "err", err, "streamID", streamID, "runID", runID)
 					promObservationErrorCount.WithLabelValues(fmt.Sprintf("%d", streamID)).Inc()
 				} else {
-					// TODO: support types other than *big.Int
-					// https://smartcontract-it.atlassian.net/browse/MERC-3525
-					val, err := streams.ExtractBigInt(trrs)
-					if err == nil {
+					val, err := extractStreamValue(trrs)
+					if err != nil {
+						d.lggr.Debugw("Invalid observation for stream", "err", err, "streamID", streamID)
+						promInvalidObservationCount.WithLabelValues(fmt.Sprintf("%d", streamID)).Inc()
+					} else {
 						res.Val = val
 						res.Valid = true
 					}
@@ -101,3 +109,15 @@ func (d *dataSource) Observe(ctx context.Context, streamIDs map[llotypes.StreamI
 
 	return sv, nil
 }
+
+// extractStreamValue extracts and validates the typed value of the stream.
+// llo.StreamValues carries integers, so the value is packed into one with
+// streams.EncodeBigInt, for the report codecs to decode. Decimals are
+// truncated to integers, as they always were.
+func extractStreamValue(trrs pipeline.TaskRunResults) (*big.Int, error) {
+	val, err := streams.ExtractStreamValue(trrs)
+	if err != nil {
+		return nil, err
+	}
+	return streams.EncodeBigInt(val)
+}
@@ -6,11 +6,16 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chainselectors "github.com/smartcontractkit/chain-selectors"
 
 	"github.com/smartcontractkit/chainlink-data-streams/llo"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 )
@@ -41,6 +46,12 @@ func makeStreamWithSingleResult[T any](res T, err error) *mockStream {
 	}
 }
 
+func mustEncodeBigInt(t *testing.T, val streams.StreamValue) *big.Int {
+	i, err := streams.EncodeBigInt(val)
+	require.NoError(t, err)
+	return i
+}
+
 func Test_DataSource(t *testing.T) {
 	lggr := logger.TestLogger(t)
 	reg := &mockRegistry{make(map[streams.StreamID]*mockStream)}
@@ -91,5 +102,80 @@ func Test_DataSource(t *testing.T) {
 				3: llo.ObsResult[*big.Int]{Val: nil, Valid: false},
 			}, vals)
 		})
+		t.Run("observes typed stream values and rejects invalid ones", func(t *testing.T) {
+			reg.streams[1] = makeStreamWithSingleResult[map[string]interface{}](map[string]interface{}{"bid": "99.5", "benchmark": "100.2", "ask": "101"}, nil)
+			reg.streams[2] = makeStreamWithSingleResult[bool](true, nil)
+			reg.streams[3] = makeStreamWithSingleResult[map[string]interface{}](map[string]interface{}{"bid": "102", "benchmark": "100", "ask": "101"}, nil)
+
+			vals, err := ds.Observe(ctx, streamIDs)
+			assert.NoError(t, err)
+
+			assert.Equal(t, llo.StreamValues{
+				1: llo.ObsResult[*big.Int]{Val: mustEncodeBigInt(t, streams.Quote{Bid: decimal.New(99, 0), Benchmark: decimal.New(100, 0), Ask: decimal.New(101, 0)}), Valid: true},
+				2: llo.ObsResult[*big.Int]{Val: mustEncodeBigInt(t, streams.Bool(true)), Valid: true},
+				3: llo.ObsResult[*big.Int]{Val: nil, Valid: false},
+			}, vals)
+		})
+	})
+}
+
+func Test_DataSource_ValuesSurviveIntoEVMReports(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	reg := &mockRegistry{map[streams.StreamID]*mockStream{
+		1: makeStreamWithSingleResult[string]("2181.25", nil),
+		2: makeStreamWithSingleResult[map[string]interface{}](map[string]interface{}{"bid": "99.5", "benchmark": "100.2", "ask": "101"}, nil),
+	}}
+	ds := newDataSource(lggr, reg)
+	ctx := testutils.Context(t)
+
+	vals, err := ds.Observe(ctx, map[streams.StreamID]struct{}{1: {}, 2: {}})
+	require.NoError(t, err)
+	require.True(t, vals[1].Valid)
+	require.True(t, vals[2].Valid)
+
+	t.Run("decimals are truncated to int192s in EVM reports", func(t *testing.T) {
+		report := llo.Report{
+			ChainSelector: chainselectors.ETHEREUM_MAINNET.Selector,
+			SeqNr:         1,
+			ChannelID:     1,
+			Values:        []*big.Int{vals[1].Val},
+		}
+		encoded, err := evm.ReportCodec{}.Encode(report)
+		require.NoError(t, err)
+
+		reportElems := make(map[string]interface{})
+		require.NoError(t, evm.Schema.UnpackIntoMap(reportElems, encoded))
+		assert.Equal(t, []*big.Int{big.NewInt(2181)}, reportElems["values"])
+	})
+	t.Run("quotes are only carried by typed EVM reports", func(t *testing.T) {
+		report := llo.Report{
+			ChainSelector: chainselectors.ETHEREUM_MAINNET.Selector,
+			SeqNr:         1,
+			ChannelID:     1,
+			Values:        []*big.Int{vals[1].Val, vals[2].Val},
+		}
+		_, err := evm.ReportCodec{}.Encode(report)
+		assert.EqualError(t, err, "value 1 is a quote, which can only be encoded in the typed EVM report format (3)")
+
+		encoded, err := evm.TypedReportCodec{}.Encode(report)
+		require.NoError(t, err)
+
+		reportElems := make(map[string]interface{})
+		require.NoError(t, evm.TypedSchema.UnpackIntoMap(reportElems, encoded))
+		valueTypes := reportElems["valueTypes"].([]uint8)
+		values := reportElems["values"].([][]byte)
+		require.Len(t, values, 2)
+
+		price, err := evm.DecodeStreamValue(streams.StreamValueType(valueTypes[0]), values[0])
+		require.NoError(t, err)
+		assert.Equal(t, streams.Decimal{Decimal: decimal.New(2181, 0)}, price)
+
+		quote, err := evm.DecodeStreamValue(streams.StreamValueType(valueTypes[1]), values[1])
+		require.NoError(t, err)
+		assert.Equal(t, streams.Quote{Bid: decimal.New(99, 0), Benchmark: decimal.New(100, 0), Ask: decimal.New(101, 0)}, quote)
+
+		decoded, err := evm.TypedReportCodec{}.Decode(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, report.Values, decoded.Values)
 	})
 }
@@ -79,8 +79,9 @@ func NewDelegate(cfg DelegateConfig) (job.ServiceCtx, error) {
 	codecs := make(map[llotypes.ReportFormat]llo.ReportCodec)
 
 	// NOTE: All codecs must be specified here
-	codecs[llotypes.ReportFormatJSON] = llo.JSONReportCodec{}
+	codecs[llotypes.ReportFormatJSON] = JSONReportCodec{}
 	codecs[llotypes.ReportFormatEVM] = evm.ReportCodec{}
+	codecs[evm.ReportFormatEVMTyped] = evm.TypedReportCodec{}
 
 	// TODO: Do these services need starting?
 	// https://smartcontract-it.atlassian.net/browse/MERC-3386
@@ -12,6 +12,8 @@ import (
 	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
 
 	"github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 )
 
 var (
@@ -56,6 +58,9 @@ func (ReportCodec) Encode(report llo.Report) ([]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to get chain ID for selector %d; %w", report.ChainSelector, err)
 	}
+	if err = checkDecimals(report.Values); err != nil {
+		return nil, err
+	}
 
 	b, err := Schema.Pack(report.ConfigDigest, chainID, report.SeqNr, report.ChannelID, report.ValidAfterSeconds, report.ValidUntilSeconds, report.Values, report.Specimen)
 	if err != nil {
@@ -95,3 +100,18 @@ func (ReportCodec) Decode(encoded []byte) (llo.Report, error) {
 		Specimen:          decoded.Specimen,
 	}, err
 }
+
+// checkDecimals returns an error if any of the values is not a decimal, since
+// quotes and bools can only be carried by typed reports.
+func checkDecimals(reportValues []*big.Int) error {
+	for i, v := range reportValues {
+		val, err := streams.DecodeBigInt(v)
+		if err != nil {
+			return fmt.Errorf("failed to decode value %d: %w", i, err)
+		}
+		if val.Type() != streams.StreamValueTypeDecimal {
+			return fmt.Errorf("value %d is a %s, which can only be encoded in the typed EVM report format (%d)", i, val.Type(), ReportFormatEVMTyped)
+		}
+	}
+	return nil
+}
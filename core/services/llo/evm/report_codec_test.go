@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -12,6 +13,8 @@ import (
 	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
 
 	"github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 )
 
 const ethMainnetChainSelector uint64 = 5009297550715157269
@@ -29,6 +32,14 @@ func newValidReport() llo.Report {
 	}
 }
 
+func mustEncodeBigInt(val streams.StreamValue) *big.Int {
+	i, err := streams.EncodeBigInt(val)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
 func Test_ReportCodec(t *testing.T) {
 	rc := ReportCodec{}
 
@@ -76,6 +87,14 @@ func Test_ReportCodec(t *testing.T) {
 		})
 	})
 
+	t.Run("Encode errors on values other than decimals", func(t *testing.T) {
+		report := newValidReport()
+		report.Values = []*big.Int{big.NewInt(35), mustEncodeBigInt(streams.Quote{Bid: decimal.New(1, 0), Benchmark: decimal.New(2, 0), Ask: decimal.New(3, 0)})}
+
+		_, err := rc.Encode(report)
+		assert.EqualError(t, err, "value 1 is a quote, which can only be encoded in the typed EVM report format (3)")
+	})
+
 	t.Run("Decode errors on invalid report", func(t *testing.T) {
 		_, err := rc.Decode([]byte{1, 2, 3})
 		assert.EqualError(t, err, "failed to decode report: abi: cannot marshal in to go type: length insufficient 3 require 32")
@@ -0,0 +1,105 @@
+package evm
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
+)
+
+var (
+	maxInt192 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 191), big.NewInt(1))
+	minInt192 = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 191))
+
+	streamValueSchemas = map[streams.StreamValueType]abi.Arguments{
+		streams.StreamValueTypeDecimal: mustNewArguments("int192"),
+		streams.StreamValueTypeQuote:   mustNewArguments("int192", "int192", "int192"),
+		streams.StreamValueTypeBool:    mustNewArguments("bool"),
+	}
+)
+
+func mustNewArguments(types ...string) abi.Arguments {
+	args := make(abi.Arguments, len(types))
+	for i, t := range types {
+		typ, err := abi.NewType(t, "", []abi.ArgumentMarshaling{})
+		if err != nil {
+			panic(fmt.Sprintf("Unexpected error during abi.NewType: %s", err))
+		}
+		args[i] = abi.Argument{Type: typ}
+	}
+	return args
+}
+
+// EncodeStreamValue ABI-encodes the value. Decimals, including the prices of
+// quotes, are truncated to int192s, as they are in legacy EVM reports.
+func EncodeStreamValue(val streams.StreamValue) ([]byte, error) {
+	schema, ok := streamValueSchemas[val.Type()]
+	if !ok {
+		return nil, fmt.Errorf("unsupported stream value type: %s", val.Type())
+	}
+
+	var args []interface{}
+	switch v := val.(type) {
+	case streams.Decimal:
+		i, err := toInt192(v.Decimal)
+		if err != nil {
+			return nil, err
+		}
+		args = []interface{}{i}
+	case streams.Quote:
+		for _, d := range []decimal.Decimal{v.Bid, v.Benchmark, v.Ask} {
+			i, err := toInt192(d)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, i)
+		}
+	case streams.Bool:
+		args = []interface{}{bool(v)}
+	default:
+		return nil, fmt.Errorf("unsupported stream value: %T", val)
+	}
+
+	b, err := schema.Pack(args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s stream value: %w", val.Type(), err)
+	}
+	return b, nil
+}
+
+// DecodeStreamValue decodes a value of the given type encoded by
+// EncodeStreamValue.
+func DecodeStreamValue(typ streams.StreamValueType, encoded []byte) (streams.StreamValue, error) {
+	schema, ok := streamValueSchemas[typ]
+	if !ok {
+		return nil, fmt.Errorf("unsupported stream value type: %s", typ)
+	}
+	vals, err := schema.Unpack(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s stream value: %w", typ, err)
+	}
+
+	switch typ {
+	case streams.StreamValueTypeDecimal:
+		return streams.Decimal{Decimal: decimal.NewFromBigInt(vals[0].(*big.Int), 0)}, nil
+	case streams.StreamValueTypeQuote:
+		return streams.Quote{
+			Bid:       decimal.NewFromBigInt(vals[0].(*big.Int), 0),
+			Benchmark: decimal.NewFromBigInt(vals[1].(*big.Int), 0),
+			Ask:       decimal.NewFromBigInt(vals[2].(*big.Int), 0),
+		}, nil
+	default:
+		return streams.Bool(vals[0].(bool)), nil
+	}
+}
+
+func toInt192(d decimal.Decimal) (*big.Int, error) {
+	i := d.BigInt()
+	if i.Cmp(maxInt192) > 0 || i.Cmp(minInt192) < 0 {
+		return nil, fmt.Errorf("decimal %s overflows int192", d)
+	}
+	return i, nil
+}
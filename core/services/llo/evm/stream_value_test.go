@@ -0,0 +1,63 @@
+package evm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
+)
+
+func Test_EncodeStreamValue(t *testing.T) {
+	t.Run("decimal", func(t *testing.T) {
+		encoded, err := EncodeStreamValue(streams.Decimal{Decimal: decimal.RequireFromString("12345.67")})
+		require.NoError(t, err)
+
+		vals, err := streamValueSchemas[streams.StreamValueTypeDecimal].Unpack(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{big.NewInt(12345)}, vals)
+	})
+	t.Run("quote", func(t *testing.T) {
+		q := streams.Quote{Bid: decimal.RequireFromString("150.5"), Benchmark: decimal.New(200, 0), Ask: decimal.RequireFromString("225.25")}
+		encoded, err := EncodeStreamValue(q)
+		require.NoError(t, err)
+
+		vals, err := streamValueSchemas[streams.StreamValueTypeQuote].Unpack(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{big.NewInt(150), big.NewInt(200), big.NewInt(225)}, vals)
+	})
+	t.Run("bool", func(t *testing.T) {
+		encoded, err := EncodeStreamValue(streams.Bool(true))
+		require.NoError(t, err)
+
+		vals, err := streamValueSchemas[streams.StreamValueTypeBool].Unpack(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{true}, vals)
+	})
+	t.Run("decodes what it encoded", func(t *testing.T) {
+		for _, val := range []streams.StreamValue{
+			streams.Decimal{Decimal: decimal.New(12345, 0)},
+			streams.Quote{Bid: decimal.New(150, 0), Benchmark: decimal.New(200, 0), Ask: decimal.New(225, 0)},
+			streams.Bool(true),
+		} {
+			encoded, err := EncodeStreamValue(val)
+			require.NoError(t, err)
+			decoded, err := DecodeStreamValue(val.Type(), encoded)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(mustMarshalJSON(t, val)), string(mustMarshalJSON(t, decoded)))
+		}
+	})
+	t.Run("errors on overflow", func(t *testing.T) {
+		_, err := EncodeStreamValue(streams.Decimal{Decimal: decimal.New(1, 60)})
+		assert.EqualError(t, err, "decimal 1000000000000000000000000000000000000000000000000000000000000 overflows int192")
+	})
+}
+
+func mustMarshalJSON(t *testing.T, val streams.StreamValue) []byte {
+	b, err := val.MarshalJSON()
+	require.NoError(t, err)
+	return b
+}
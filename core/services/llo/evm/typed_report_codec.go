@@ -0,0 +1,147 @@
+package evm
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	chainselectors "github.com/smartcontractkit/chain-selectors"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
+)
+
+// ReportFormatEVMTyped is the report format of channels whose streams may
+// report quotes and bools. Its reports are signed with EVM keys, like
+// llotypes.ReportFormatEVM reports, but carry the type of every value.
+const ReportFormatEVMTyped llotypes.ReportFormat = 3
+
+var (
+	_           llo.ReportCodec = TypedReportCodec{}
+	TypedSchema                 = getTypedSchema()
+)
+
+func getTypedSchema() abi.Arguments {
+	mustNewType := func(t string) abi.Type {
+		result, err := abi.NewType(t, "", []abi.ArgumentMarshaling{})
+		if err != nil {
+			panic(fmt.Sprintf("Unexpected error during abi.NewType: %s", err))
+		}
+		return result
+	}
+	return abi.Arguments([]abi.Argument{
+		{Name: "configDigest", Type: mustNewType("bytes32")},
+		{Name: "chainId", Type: mustNewType("uint64")},
+		{Name: "seqNr", Type: mustNewType("uint64")},
+		{Name: "channelId", Type: mustNewType("uint32")},
+		{Name: "validAfterSeconds", Type: mustNewType("uint32")},
+		{Name: "validUntilSeconds", Type: mustNewType("uint32")},
+		// values are ABI-encoded with EncodeStreamValue, according to their type
+		{Name: "valueTypes", Type: mustNewType("uint8[]")},
+		{Name: "values", Type: mustNewType("bytes[]")},
+		{Name: "specimen", Type: mustNewType("bool")},
+	})
+}
+
+// TypedReportCodec encodes reports of the ReportFormatEVMTyped format.
+type TypedReportCodec struct{}
+
+func NewTypedReportCodec() TypedReportCodec {
+	return TypedReportCodec{}
+}
+
+func (TypedReportCodec) Encode(report llo.Report) ([]byte, error) {
+	chainID, err := chainselectors.ChainIdFromSelector(report.ChainSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID for selector %d; %w", report.ChainSelector, err)
+	}
+
+	valueTypes, values, err := encodeValues(report.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := TypedSchema.Pack(report.ConfigDigest, chainID, report.SeqNr, report.ChannelID, report.ValidAfterSeconds, report.ValidUntilSeconds, valueTypes, values, report.Specimen)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode report: %w", err)
+	}
+	return b, nil
+}
+
+func (TypedReportCodec) Decode(encoded []byte) (llo.Report, error) {
+	type decode struct {
+		ConfigDigest      types.ConfigDigest
+		ChainId           uint64
+		SeqNr             uint64
+		ChannelId         llotypes.ChannelID
+		ValidAfterSeconds uint32
+		ValidUntilSeconds uint32
+		ValueTypes        []uint8
+		Values            [][]byte
+		Specimen          bool
+	}
+	values, err := TypedSchema.Unpack(encoded)
+	if err != nil {
+		return llo.Report{}, fmt.Errorf("failed to decode report: %w", err)
+	}
+	decoded := new(decode)
+	if err = TypedSchema.Copy(decoded, values); err != nil {
+		return llo.Report{}, fmt.Errorf("failed to copy report values to struct: %w", err)
+	}
+	reportValues, err := decodeValues(decoded.ValueTypes, decoded.Values)
+	if err != nil {
+		return llo.Report{}, err
+	}
+	chainSelector, err := chainselectors.SelectorFromChainId(decoded.ChainId)
+	return llo.Report{
+		ConfigDigest:      decoded.ConfigDigest,
+		ChainSelector:     chainSelector,
+		SeqNr:             decoded.SeqNr,
+		ChannelID:         decoded.ChannelId,
+		ValidAfterSeconds: decoded.ValidAfterSeconds,
+		ValidUntilSeconds: decoded.ValidUntilSeconds,
+		Values:            reportValues,
+		Specimen:          decoded.Specimen,
+	}, err
+}
+
+// encodeValues ABI-encodes the typed values packed into the values of the
+// report by streams.EncodeBigInt.
+func encodeValues(reportValues []*big.Int) (valueTypes []uint8, values [][]byte, err error) {
+	valueTypes = make([]uint8, len(reportValues))
+	values = make([][]byte, len(reportValues))
+	for i, v := range reportValues {
+		val, err := streams.DecodeBigInt(v)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode value %d: %w", i, err)
+		}
+		valueTypes[i] = uint8(val.Type())
+		if values[i], err = EncodeStreamValue(val); err != nil {
+			return nil, nil, fmt.Errorf("failed to encode value %d: %w", i, err)
+		}
+	}
+	return valueTypes, values, nil
+}
+
+// decodeValues is the inverse of encodeValues.
+func decodeValues(valueTypes []uint8, values [][]byte) ([]*big.Int, error) {
+	if len(valueTypes) != len(values) {
+		return nil, fmt.Errorf("failed to decode report: got %d value types for %d values", len(valueTypes), len(values))
+	}
+	reportValues := make([]*big.Int, len(values))
+	for i, b := range values {
+		val, err := DecodeStreamValue(streams.StreamValueType(valueTypes[i]), b)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode value %d: %w", i, err)
+		}
+		if reportValues[i], err = streams.EncodeBigInt(val); err != nil {
+			return nil, fmt.Errorf("failed to decode value %d: %w", i, err)
+		}
+	}
+	return reportValues, nil
+}
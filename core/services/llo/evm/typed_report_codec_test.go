@@ -0,0 +1,93 @@
+package evm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
+)
+
+func Test_TypedReportCodec(t *testing.T) {
+	rc := TypedReportCodec{}
+
+	t.Run("Encode errors on zero fields", func(t *testing.T) {
+		_, err := rc.Encode(llo.Report{})
+		require.Error(t, err)
+
+		assert.Contains(t, err.Error(), "failed to get chain ID for selector 0; chain not found for chain selector 0")
+	})
+
+	t.Run("Encode constructs a report with typed values", func(t *testing.T) {
+		report := newValidReport()
+		quote := streams.Quote{Bid: decimal.New(99, 0), Benchmark: decimal.New(100, 0), Ask: decimal.New(101, 0)}
+		report.Values = []*big.Int{big.NewInt(35), mustEncodeBigInt(quote), mustEncodeBigInt(streams.Bool(true))}
+
+		encoded, err := rc.Encode(report)
+		require.NoError(t, err)
+
+		reportElems := make(map[string]interface{})
+		err = TypedSchema.UnpackIntoMap(reportElems, encoded)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(1), reportElems["chainId"])
+		assert.Equal(t, uint64(32), reportElems["seqNr"])
+		assert.Equal(t, uint32(31), reportElems["channelId"])
+		assert.Equal(t, []uint8{uint8(streams.StreamValueTypeDecimal), uint8(streams.StreamValueTypeQuote), uint8(streams.StreamValueTypeBool)}, reportElems["valueTypes"])
+		values := reportElems["values"].([][]byte)
+		require.Len(t, values, 3)
+		for i, expected := range [][]interface{}{
+			{big.NewInt(35)},
+			{big.NewInt(99), big.NewInt(100), big.NewInt(101)},
+			{true},
+		} {
+			typ := streams.StreamValueType(reportElems["valueTypes"].([]uint8)[i])
+			vals, err := streamValueSchemas[typ].Unpack(values[i])
+			require.NoError(t, err)
+			assert.Equal(t, expected, vals)
+		}
+		assert.Equal(t, true, reportElems["specimen"])
+
+		t.Run("Decode decodes the report", func(t *testing.T) {
+			decoded, err := rc.Decode(encoded)
+			require.NoError(t, err)
+
+			assert.Equal(t, report.ConfigDigest, decoded.ConfigDigest)
+			assert.Equal(t, report.ChainSelector, decoded.ChainSelector)
+			assert.Equal(t, report.SeqNr, decoded.SeqNr)
+			assert.Equal(t, report.ChannelID, decoded.ChannelID)
+			assert.Equal(t, report.ValidAfterSeconds, decoded.ValidAfterSeconds)
+			assert.Equal(t, report.ValidUntilSeconds, decoded.ValidUntilSeconds)
+			assert.Equal(t, report.Values, decoded.Values)
+			assert.Equal(t, report.Specimen, decoded.Specimen)
+		})
+	})
+
+	t.Run("Encode errors on values which cannot be ABI-encoded", func(t *testing.T) {
+		report := newValidReport()
+		report.Values = []*big.Int{big.NewInt(0).Lsh(big.NewInt(1), 200)}
+
+		_, err := rc.Encode(report)
+		assert.EqualError(t, err, "failed to encode value 0: decimal 1606938044258990275541962092341162602522202993782792835301376 overflows int192")
+	})
+
+	t.Run("Decode errors on mismatched value types", func(t *testing.T) {
+		report := newValidReport()
+		chainID := uint64(1)
+		encoded, err := TypedSchema.Pack(report.ConfigDigest, chainID, report.SeqNr, report.ChannelID, report.ValidAfterSeconds, report.ValidUntilSeconds, []uint8{0}, [][]byte{}, report.Specimen)
+		require.NoError(t, err)
+
+		_, err = rc.Decode(encoded)
+		assert.EqualError(t, err, "failed to decode report: got 1 value types for 0 values")
+	})
+
+	t.Run("Decode errors on invalid report", func(t *testing.T) {
+		_, err := rc.Decode([]byte{1, 2, 3})
+		assert.EqualError(t, err, "failed to decode report: abi: cannot marshal in to go type: length insufficient 3 require 32")
+	})
+}
@@ -0,0 +1,124 @@
+package llo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+)
+
+// JSONPayload is the payload transmitted for channels using the JSON report
+// format. It carries everything needed to verify the report without speaking
+// ABI: the report itself, the config digest and seqNr it was signed over, and
+// the signatures of the oracles.
+type JSONPayload struct {
+	ConfigDigest ocr2types.ConfigDigest
+	SeqNr        uint64
+	// Report is the JSON-encoded report, as produced by the JSON report codec
+	Report ocr2types.Report
+	Sigs   []ocr2types.AttributedOnchainSignature
+}
+
+type jsonSignature struct {
+	Signer    commontypes.OracleID `json:"signer"`
+	Signature hexutil.Bytes        `json:"signature"`
+}
+
+type jsonPayload struct {
+	ConfigDigest hexutil.Bytes   `json:"configDigest"`
+	SeqNr        uint64          `json:"seqNr"`
+	Report       json.RawMessage `json:"report"`
+	Sigs         []jsonSignature `json:"sigs"`
+}
+
+func (p JSONPayload) MarshalJSON() ([]byte, error) {
+	if !json.Valid(p.Report) {
+		return nil, errors.New("report is not valid JSON")
+	}
+	sigs := make([]jsonSignature, len(p.Sigs))
+	for i, sig := range p.Sigs {
+		sigs[i] = jsonSignature{Signer: sig.Signer, Signature: sig.Signature}
+	}
+	return json.Marshal(jsonPayload{
+		ConfigDigest: p.ConfigDigest[:],
+		SeqNr:        p.SeqNr,
+		Report:       json.RawMessage(p.Report),
+		Sigs:         sigs,
+	})
+}
+
+func (p *JSONPayload) UnmarshalJSON(b []byte) error {
+	var raw jsonPayload
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	digest, err := ocr2types.BytesToConfigDigest(raw.ConfigDigest)
+	if err != nil {
+		return fmt.Errorf("invalid configDigest: %w", err)
+	}
+	if len(raw.Report) == 0 {
+		return errors.New("missing report")
+	}
+	sigs := make([]ocr2types.AttributedOnchainSignature, len(raw.Sigs))
+	for i, sig := range raw.Sigs {
+		sigs[i] = ocr2types.AttributedOnchainSignature{Signer: sig.Signer, Signature: sig.Signature}
+	}
+	*p = JSONPayload{
+		ConfigDigest: digest,
+		SeqNr:        raw.SeqNr,
+		Report:       ocr2types.Report(raw.Report),
+		Sigs:         sigs,
+	}
+	return nil
+}
+
+func encodeJSON(digest ocr2types.ConfigDigest, seqNr uint64, report ocr2types.Report, sigs []ocr2types.AttributedOnchainSignature) ([]byte, error) {
+	return json.Marshal(JSONPayload{
+		ConfigDigest: digest,
+		SeqNr:        seqNr,
+		Report:       report,
+		Sigs:         sigs,
+	})
+}
+
+// DecodeJSONPayload decodes a payload transmitted in the JSON report format.
+func DecodeJSONPayload(b []byte) (p JSONPayload, err error) {
+	if err = json.Unmarshal(b, &p); err != nil {
+		return p, fmt.Errorf("failed to decode JSON payload: %w", err)
+	}
+	return p, nil
+}
+
+// ReportVerifier verifies the signature of an oracle over a report, e.g. an
+// ocr2key.KeyBundle of the chain type the reports are signed with.
+type ReportVerifier interface {
+	Verify3(publicKey ocr2types.OnchainPublicKey, cd ocr2types.ConfigDigest, seqNr uint64, r ocr2types.Report, signature []byte) bool
+}
+
+// VerifyJSONPayload checks that the payload carries valid signatures from at
+// least f+1 distinct oracles, given the onchain public keys of the oracles of
+// the DON.
+func VerifyJSONPayload(v ReportVerifier, p JSONPayload, signers map[commontypes.OracleID]ocr2types.OnchainPublicKey, f int) error {
+	verified := make(map[commontypes.OracleID]struct{}, len(p.Sigs))
+	for _, sig := range p.Sigs {
+		pk, ok := signers[sig.Signer]
+		if !ok {
+			return fmt.Errorf("unknown signer: %d", sig.Signer)
+		}
+		if _, dup := verified[sig.Signer]; dup {
+			return fmt.Errorf("duplicate signature from signer: %d", sig.Signer)
+		}
+		if !v.Verify3(pk, p.ConfigDigest, p.SeqNr, p.Report, sig.Signature) {
+			return fmt.Errorf("invalid signature from signer: %d", sig.Signer)
+		}
+		verified[sig.Signer] = struct{}{}
+	}
+	if len(verified) <= f {
+		return fmt.Errorf("not enough signatures: got %d, need at least %d", len(verified), f+1)
+	}
+	return nil
+}
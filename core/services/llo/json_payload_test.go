@@ -0,0 +1,91 @@
+package llo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/keystest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+)
+
+func Test_JSONPayload(t *testing.T) {
+	digest := ocr2types.ConfigDigest{1, 2, 3}
+	seqNr := uint64(42)
+	report := ocr2types.Report(`{"ChannelID":1,"Values":["1.5"],"Specimen":false}`)
+
+	kb1 := ocr2key.MustNewInsecure(keystest.NewRandReaderFromSeed(1), chaintype.EVM)
+	kb2 := ocr2key.MustNewInsecure(keystest.NewRandReaderFromSeed(2), chaintype.EVM)
+	signers := map[commontypes.OracleID]ocr2types.OnchainPublicKey{
+		1: kb1.PublicKey(),
+		2: kb2.PublicKey(),
+	}
+	sig1, err := kb1.Sign3(digest, seqNr, report)
+	require.NoError(t, err)
+	sig2, err := kb2.Sign3(digest, seqNr, report)
+	require.NoError(t, err)
+	sigs := []ocr2types.AttributedOnchainSignature{{Signer: 1, Signature: sig1}, {Signer: 2, Signature: sig2}}
+
+	t.Run("encodes and decodes the payload", func(t *testing.T) {
+		encoded, err := encodeJSON(digest, seqNr, report, sigs)
+		require.NoError(t, err)
+
+		assert.Contains(t, string(encoded), `"configDigest":"0x0102030000000000000000000000000000000000000000000000000000000000"`)
+		assert.Contains(t, string(encoded), `"seqNr":42`)
+		assert.Contains(t, string(encoded), `"report":{"ChannelID":1,"Values":["1.5"],"Specimen":false}`)
+
+		decoded, err := DecodeJSONPayload(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, digest, decoded.ConfigDigest)
+		assert.Equal(t, seqNr, decoded.SeqNr)
+		assert.JSONEq(t, string(report), string(decoded.Report))
+		assert.Equal(t, sigs, decoded.Sigs)
+	})
+
+	t.Run("encode errors if the report is not JSON", func(t *testing.T) {
+		_, err := encodeJSON(digest, seqNr, ocr2types.Report{0x01, 0x02}, sigs)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "report is not valid JSON")
+	})
+
+	t.Run("decode errors on invalid payload", func(t *testing.T) {
+		_, err := DecodeJSONPayload([]byte(`{"configDigest":"0x01","seqNr":1,"report":{}}`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid configDigest")
+
+		_, err = DecodeJSONPayload([]byte(`not json`))
+		require.Error(t, err)
+	})
+
+	t.Run("VerifyJSONPayload", func(t *testing.T) {
+		p := JSONPayload{ConfigDigest: digest, SeqNr: seqNr, Report: report, Sigs: sigs}
+
+		t.Run("succeeds with f+1 valid signatures", func(t *testing.T) {
+			require.NoError(t, VerifyJSONPayload(kb1, p, signers, 1))
+		})
+		t.Run("fails with too few signatures", func(t *testing.T) {
+			err := VerifyJSONPayload(kb1, p, signers, 2)
+			assert.EqualError(t, err, "not enough signatures: got 2, need at least 3")
+		})
+		t.Run("fails if the report was tampered with", func(t *testing.T) {
+			tampered := p
+			tampered.Report = ocr2types.Report(`{"ChannelID":1,"Values":["2.5"],"Specimen":false}`)
+			err := VerifyJSONPayload(kb1, tampered, signers, 1)
+			assert.EqualError(t, err, "invalid signature from signer: 1")
+		})
+		t.Run("fails on unknown or duplicate signers", func(t *testing.T) {
+			unknown := p
+			unknown.Sigs = []ocr2types.AttributedOnchainSignature{{Signer: 3, Signature: sig1}}
+			assert.EqualError(t, VerifyJSONPayload(kb1, unknown, signers, 0), "unknown signer: 3")
+
+			dup := p
+			dup.Sigs = []ocr2types.AttributedOnchainSignature{sigs[0], sigs[0]}
+			assert.EqualError(t, VerifyJSONPayload(kb1, dup, signers, 1), "duplicate signature from signer: 1")
+		})
+	})
+}
@@ -0,0 +1,92 @@
+package llo
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
+)
+
+var _ llo.ReportCodec = JSONReportCodec{}
+
+// JSONReportCodec encodes reports of the JSON report format. Values are
+// written as the stream values packed into them by streams.EncodeBigInt, e.g.
+// a decimal as "2181" and a quote as {"bid":"99","benchmark":"100","ask":"101"}.
+type JSONReportCodec struct{}
+
+type jsonReport struct {
+	ConfigDigest      hexutil.Bytes      `json:"configDigest"`
+	ChainSelector     uint64             `json:"chainSelector"`
+	SeqNr             uint64             `json:"seqNr"`
+	ChannelID         llotypes.ChannelID `json:"channelID"`
+	ValidAfterSeconds uint32             `json:"validAfterSeconds"`
+	ValidUntilSeconds uint32             `json:"validUntilSeconds"`
+	Values            []json.RawMessage  `json:"values"`
+	Specimen          bool               `json:"specimen"`
+}
+
+func (JSONReportCodec) Encode(r llo.Report) ([]byte, error) {
+	values := make([]json.RawMessage, len(r.Values))
+	for i, v := range r.Values {
+		val, err := streams.DecodeBigInt(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode value %d: %w", i, err)
+		}
+		if values[i], err = val.MarshalJSON(); err != nil {
+			return nil, fmt.Errorf("failed to encode value %d: %w", i, err)
+		}
+	}
+	return json.Marshal(jsonReport{
+		ConfigDigest:      r.ConfigDigest[:],
+		ChainSelector:     r.ChainSelector,
+		SeqNr:             r.SeqNr,
+		ChannelID:         r.ChannelID,
+		ValidAfterSeconds: r.ValidAfterSeconds,
+		ValidUntilSeconds: r.ValidUntilSeconds,
+		Values:            values,
+		Specimen:          r.Specimen,
+	})
+}
+
+func (JSONReportCodec) Decode(b []byte) (r llo.Report, err error) {
+	var raw jsonReport
+	if err = json.Unmarshal(b, &raw); err != nil {
+		return r, fmt.Errorf("failed to decode report: %w", err)
+	}
+	digest, err := ocr2types.BytesToConfigDigest(raw.ConfigDigest)
+	if err != nil {
+		return r, fmt.Errorf("invalid configDigest: %w", err)
+	}
+	values := make([]*big.Int, len(raw.Values))
+	for i, rawVal := range raw.Values {
+		var v interface{}
+		if err = json.Unmarshal(rawVal, &v); err != nil {
+			return r, fmt.Errorf("failed to decode value %d: %w", i, err)
+		}
+		val, err := streams.ParseStreamValue(v)
+		if err != nil {
+			return r, fmt.Errorf("failed to decode value %d: %w", i, err)
+		}
+		if values[i], err = streams.EncodeBigInt(val); err != nil {
+			return r, fmt.Errorf("failed to decode value %d: %w", i, err)
+		}
+	}
+	return llo.Report{
+		ConfigDigest:      digest,
+		ChainSelector:     raw.ChainSelector,
+		SeqNr:             raw.SeqNr,
+		ChannelID:         raw.ChannelID,
+		ValidAfterSeconds: raw.ValidAfterSeconds,
+		ValidUntilSeconds: raw.ValidUntilSeconds,
+		Values:            values,
+		Specimen:          raw.Specimen,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package llo
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
+)
+
+func Test_JSONReportCodec(t *testing.T) {
+	rc := JSONReportCodec{}
+	report := llo.Report{
+		ConfigDigest:      ocr2types.ConfigDigest{1, 2, 3},
+		ChainSelector:     5009297550715157269,
+		SeqNr:             32,
+		ChannelID:         31,
+		ValidAfterSeconds: 33,
+		ValidUntilSeconds: 34,
+		Values: []*big.Int{
+			big.NewInt(2181),
+			mustEncodeBigInt(t, streams.Quote{Bid: decimal.New(99, 0), Benchmark: decimal.New(100, 0), Ask: decimal.New(101, 0)}),
+			mustEncodeBigInt(t, streams.Bool(true)),
+		},
+		Specimen: true,
+	}
+
+	t.Run("Encode writes the typed values", func(t *testing.T) {
+		encoded, err := rc.Encode(report)
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{
+			"configDigest": "0x0102030000000000000000000000000000000000000000000000000000000000",
+			"chainSelector": 5009297550715157269,
+			"seqNr": 32,
+			"channelID": 31,
+			"validAfterSeconds": 33,
+			"validUntilSeconds": 34,
+			"values": ["2181", {"bid": "99", "benchmark": "100", "ask": "101"}, true],
+			"specimen": true
+		}`, string(encoded))
+
+		t.Run("Decode decodes the report", func(t *testing.T) {
+			decoded, err := rc.Decode(encoded)
+			require.NoError(t, err)
+			assert.Equal(t, report, decoded)
+		})
+	})
+
+	t.Run("Encode errors on values which cannot be decoded", func(t *testing.T) {
+		r := report
+		r.Values = []*big.Int{nil}
+		_, err := rc.Encode(r)
+		assert.EqualError(t, err, "failed to decode value 0: cannot decode nil integer")
+	})
+
+	t.Run("Decode errors on invalid reports", func(t *testing.T) {
+		_, err := rc.Decode([]byte("foo"))
+		assert.ErrorContains(t, err, "failed to decode report")
+
+		_, err = rc.Decode([]byte(`{"configDigest":"0x01"}`))
+		assert.ErrorContains(t, err, "invalid configDigest")
+
+		_, err = rc.Decode([]byte(`{"configDigest":"0x0102030000000000000000000000000000000000000000000000000000000000","values":[[1]]}`))
+		assert.ErrorContains(t, err, "failed to decode value 0")
+	})
+}
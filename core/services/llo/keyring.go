@@ -10,6 +10,7 @@ import (
 	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/evm"
 )
 
 type LLOOnchainKeyring ocr3types.OnchainKeyring[llotypes.ReportInfo]
@@ -51,13 +52,23 @@ func (okr *onchainKeyring) MaxSignatureLength() (n int) {
 	return
 }
 
-func (okr *onchainKeyring) Sign(digest types.ConfigDigest, seqNr uint64, r ocr3types.ReportWithInfo[llotypes.ReportInfo]) (signature []byte, err error) {
-	rf := r.Info.ReportFormat
+// keyFormat returns the report format of the key which signs reports of the
+// given format.
+func keyFormat(rf llotypes.ReportFormat) llotypes.ReportFormat {
+	switch rf {
+	case evm.ReportFormatEVMTyped:
+		return llotypes.ReportFormatEVM
 	// HACK: sign/verify JSON payloads with EVM keys for now, this makes
 	// debugging and testing easier
-	if rf == llotypes.ReportFormatJSON {
-		rf = llotypes.ReportFormatEVM
+	case llotypes.ReportFormatJSON:
+		return llotypes.ReportFormatEVM
+	default:
+		return rf
 	}
+}
+
+func (okr *onchainKeyring) Sign(digest types.ConfigDigest, seqNr uint64, r ocr3types.ReportWithInfo[llotypes.ReportInfo]) (signature []byte, err error) {
+	rf := keyFormat(r.Info.ReportFormat)
 	if key, exists := okr.keys[rf]; exists {
 		return key.Sign3(digest, seqNr, r.Report)
 	}
@@ -65,12 +76,7 @@ func (okr *onchainKeyring) Sign(digest types.ConfigDigest, seqNr uint64, r ocr3t
 }
 
 func (okr *onchainKeyring) Verify(key types.OnchainPublicKey, digest types.ConfigDigest, seqNr uint64, r ocr3types.ReportWithInfo[llotypes.ReportInfo], signature []byte) bool {
-	rf := r.Info.ReportFormat
-	// HACK: sign/verify JSON payloads with EVM keys for now, this makes
-	// debugging and testing easier
-	if rf == llotypes.ReportFormatJSON {
-		rf = llotypes.ReportFormatEVM
-	}
+	rf := keyFormat(r.Info.ReportFormat)
 	if verifier, exists := okr.keys[rf]; exists {
 		return verifier.Verify3(key, digest, seqNr, r.Report, signature)
 	}
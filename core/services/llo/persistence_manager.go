@@ -0,0 +1,141 @@
+package llo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+var (
+	flushDeletesFrequency = time.Second
+	pruneFrequency        = time.Hour
+)
+
+// persistenceManager persists the transmit queue of a server. Deletes are
+// batched, and the table is periodically pruned down to the queue size.
+type persistenceManager struct {
+	lggr      logger.Logger
+	orm       TransmitterORM
+	serverURL string
+
+	once   services.StateMachine
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+
+	deleteMu    sync.Mutex
+	deleteQueue []*Transmission
+
+	maxTransmitQueueSize  int
+	flushDeletesFrequency time.Duration
+	pruneFrequency        time.Duration
+}
+
+func newPersistenceManager(lggr logger.Logger, serverURL string, orm TransmitterORM, maxTransmitQueueSize int, flushDeletesFrequency, pruneFrequency time.Duration) *persistenceManager {
+	return &persistenceManager{
+		lggr:                  lggr.Named("LLOPersistenceManager").With("serverURL", serverURL),
+		orm:                   orm,
+		serverURL:             serverURL,
+		stopCh:                make(services.StopChan),
+		maxTransmitQueueSize:  maxTransmitQueueSize,
+		flushDeletesFrequency: flushDeletesFrequency,
+		pruneFrequency:        pruneFrequency,
+	}
+}
+
+func (pm *persistenceManager) Start(ctx context.Context) error {
+	return pm.once.StartOnce("LLOPersistenceManager", func() error {
+		pm.wg.Add(2)
+		go pm.runFlushDeletesLoop()
+		go pm.runPruneLoop()
+		return nil
+	})
+}
+
+func (pm *persistenceManager) Close() error {
+	return pm.once.StopOnce("LLOPersistenceManager", func() error {
+		close(pm.stopCh)
+		pm.wg.Wait()
+		return nil
+	})
+}
+
+func (pm *persistenceManager) Load(ctx context.Context) ([]*Transmission, error) {
+	return pm.orm.Get(ctx, pm.serverURL)
+}
+
+func (pm *persistenceManager) AsyncDelete(t *Transmission) {
+	pm.addToDeleteQueue(t)
+}
+
+func (pm *persistenceManager) runFlushDeletesLoop() {
+	defer pm.wg.Done()
+
+	ctx, cancel := pm.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(utils.WithJitter(pm.flushDeletesFrequency))
+	for {
+		select {
+		case <-ctx.Done():
+			ticker.Stop()
+			return
+		case <-ticker.C:
+			queued := pm.resetDeleteQueue()
+			if len(queued) == 0 {
+				continue
+			}
+			if err := pm.orm.Delete(ctx, queued); err != nil {
+				pm.lggr.Errorw("Failed to delete queued transmissions", "err", err)
+				pm.addToDeleteQueue(queued...)
+			} else {
+				pm.lggr.Debugw("Deleted queued transmissions", "count", len(queued))
+			}
+		}
+	}
+}
+
+func (pm *persistenceManager) runPruneLoop() {
+	defer pm.wg.Done()
+
+	ctx, cancel := pm.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(utils.WithJitter(pm.pruneFrequency))
+	for {
+		select {
+		case <-ctx.Done():
+			ticker.Stop()
+			return
+		case <-ticker.C:
+			func(ctx context.Context) {
+				ctx, cancelPrune := context.WithTimeout(sqlutil.WithoutDefaultTimeout(ctx), time.Minute)
+				defer cancelPrune()
+				if err := pm.orm.Prune(ctx, pm.serverURL, pm.maxTransmitQueueSize); err != nil {
+					pm.lggr.Errorw("Failed to prune transmit requests table", "err", err)
+				} else {
+					pm.lggr.Debugw("Pruned transmit requests table")
+				}
+			}(ctx)
+		}
+	}
+}
+
+func (pm *persistenceManager) addToDeleteQueue(ts ...*Transmission) {
+	pm.deleteMu.Lock()
+	defer pm.deleteMu.Unlock()
+	pm.deleteQueue = append(pm.deleteQueue, ts...)
+}
+
+func (pm *persistenceManager) resetDeleteQueue() []*Transmission {
+	pm.deleteMu.Lock()
+	defer pm.deleteMu.Unlock()
+	queue := pm.deleteQueue
+	pm.deleteQueue = nil
+	return queue
+}
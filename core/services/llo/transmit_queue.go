@@ -0,0 +1,285 @@
+package llo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	heap "github.com/esote/minmaxheap"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+var transmitQueueLoad = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "llo_transmit_queue_load",
+	Help: "Current count of items in the transmit queue",
+},
+	[]string{"jobID", "serverURL", "capacity"},
+)
+
+// Prometheus' default interval is 15s, set this to under 7.5s to avoid
+// aliasing (see: https://en.wikipedia.org/wiki/Nyquist_frequency)
+const promInterval = 6500 * time.Millisecond
+
+type asyncDeleter interface {
+	AsyncDelete(t *Transmission)
+}
+
+// Transmission is a report pending transmission to a mercury server
+type Transmission struct {
+	ServerURL    string
+	ConfigDigest ocr2types.ConfigDigest
+	SeqNr        uint64
+	Req          *pb.TransmitRequest // the payload to transmit
+}
+
+// transmissionKey identifies a report; reports are deduplicated by it
+type transmissionKey struct {
+	configDigest ocr2types.ConfigDigest
+	seqNr        uint64
+}
+
+func (t *Transmission) key() transmissionKey {
+	return transmissionKey{t.ConfigDigest, t.SeqNr}
+}
+
+// TransmitQueue stores the transmissions pending for a server, yielding the
+// latest (highest seqNr) first.
+type TransmitQueue interface {
+	services.Service
+
+	BlockingPop() (t *Transmission)
+	Push(t *Transmission) (ok bool)
+	Init(transmissions []*Transmission)
+	IsEmpty() bool
+}
+
+var _ TransmitQueue = (*transmitQueue)(nil)
+
+type transmitQueue struct {
+	services.StateMachine
+
+	cond         sync.Cond
+	lggr         logger.Logger
+	asyncDeleter asyncDeleter
+	mu           *sync.RWMutex
+
+	pq     *priorityQueue
+	queued map[transmissionKey]struct{}
+	maxlen int
+	closed bool
+
+	// monitor loop
+	stopMonitor       func()
+	transmitQueueLoad prometheus.Gauge
+}
+
+// NewTransmitQueue creates a queue holding at most maxlen transmissions; when
+// full, the oldest transmission is dropped to make room.
+// 0 means unlimited - be careful, this can cause memory leaks
+func NewTransmitQueue(lggr logger.Logger, serverURL string, jobID int32, maxlen int, asyncDeleter asyncDeleter) TransmitQueue {
+	mu := new(sync.RWMutex)
+	return &transmitQueue{
+		cond:              sync.Cond{L: mu},
+		lggr:              lggr.Named("TransmitQueue"),
+		asyncDeleter:      asyncDeleter,
+		mu:                mu,
+		queued:            make(map[transmissionKey]struct{}),
+		maxlen:            maxlen,
+		transmitQueueLoad: transmitQueueLoad.WithLabelValues(fmt.Sprintf("%d", jobID), serverURL, fmt.Sprintf("%d", maxlen)),
+	}
+}
+
+// Init must be called with the persisted transmissions before use
+func (tq *transmitQueue) Init(transmissions []*Transmission) {
+	tq.cond.L.Lock()
+	defer tq.cond.L.Unlock()
+
+	pq := make(priorityQueue, 0, len(transmissions))
+	for _, t := range transmissions {
+		if _, exists := tq.queued[t.key()]; exists {
+			continue
+		}
+		tq.queued[t.key()] = struct{}{}
+		pq = append(pq, t)
+	}
+	heap.Init(&pq) // ensure the heap is ordered
+	tq.pq = &pq
+}
+
+// Push adds the transmission to the queue. Pushing a transmission which is
+// already queued (same config digest and seqNr) is a no-op.
+func (tq *transmitQueue) Push(t *Transmission) (ok bool) {
+	tq.cond.L.Lock()
+	defer tq.cond.L.Unlock()
+
+	if tq.closed {
+		return false
+	}
+	if _, exists := tq.queued[t.key()]; exists {
+		tq.lggr.Debugw("Transmission already queued; ignoring duplicate", "seqNr", t.SeqNr, "configDigest", t.ConfigDigest)
+		return true
+	}
+
+	if tq.maxlen != 0 && tq.pq.Len() == tq.maxlen {
+		// evict oldest entry to make room
+		tq.lggr.Criticalf("Transmit queue is full; dropping oldest transmission (reached max length of %d)", tq.maxlen)
+		if removed, ok := heap.PopMax(tq.pq).(*Transmission); ok {
+			delete(tq.queued, removed.key())
+			tq.asyncDeleter.AsyncDelete(removed)
+		}
+	}
+
+	heap.Push(tq.pq, t)
+	tq.queued[t.key()] = struct{}{}
+	tq.cond.Signal()
+
+	return true
+}
+
+// BlockingPop will block until at least one item is in the heap, and then return it
+// If the queue is closed, it will immediately return nil
+func (tq *transmitQueue) BlockingPop() (t *Transmission) {
+	tq.cond.L.Lock()
+	defer tq.cond.L.Unlock()
+	if tq.closed {
+		return nil
+	}
+	for t = tq.pop(); t == nil; t = tq.pop() {
+		tq.cond.Wait()
+		if tq.closed {
+			return nil
+		}
+	}
+	return t
+}
+
+func (tq *transmitQueue) IsEmpty() bool {
+	tq.mu.RLock()
+	defer tq.mu.RUnlock()
+	return tq.pq.Len() == 0
+}
+
+func (tq *transmitQueue) Start(context.Context) error {
+	return tq.StartOnce("TransmitQueue", func() error {
+		t := time.NewTicker(utils.WithJitter(promInterval))
+		wg := new(sync.WaitGroup)
+		chStop := make(chan struct{})
+		tq.stopMonitor = func() {
+			t.Stop()
+			close(chStop)
+			wg.Wait()
+		}
+		wg.Add(1)
+		go tq.monitorLoop(t.C, chStop, wg)
+		return nil
+	})
+}
+
+func (tq *transmitQueue) Close() error {
+	return tq.StopOnce("TransmitQueue", func() error {
+		tq.cond.L.Lock()
+		tq.closed = true
+		tq.cond.L.Unlock()
+		tq.cond.Broadcast()
+		tq.stopMonitor()
+		return nil
+	})
+}
+
+func (tq *transmitQueue) monitorLoop(c <-chan time.Time, chStop <-chan struct{}, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	for {
+		select {
+		case <-c:
+			tq.report()
+		case <-chStop:
+			return
+		}
+	}
+}
+
+func (tq *transmitQueue) report() {
+	tq.mu.RLock()
+	length := tq.pq.Len()
+	tq.mu.RUnlock()
+	tq.transmitQueueLoad.Set(float64(length))
+}
+
+func (tq *transmitQueue) Name() string { return tq.lggr.Name() }
+func (tq *transmitQueue) HealthReport() map[string]error {
+	return map[string]error{tq.Name(): tq.status()}
+}
+
+func (tq *transmitQueue) status() (merr error) {
+	tq.mu.RLock()
+	length := tq.pq.Len()
+	closed := tq.closed
+	tq.mu.RUnlock()
+	if tq.maxlen != 0 && length > (tq.maxlen/2) {
+		merr = errors.Join(merr, fmt.Errorf("transmit priority queue is greater than 50%% full (%d/%d)", length, tq.maxlen))
+	}
+	if closed {
+		merr = errors.New("transmit queue is closed")
+	}
+	return merr
+}
+
+// pop latest Transmission from the heap
+// Not thread-safe
+func (tq *transmitQueue) pop() *Transmission {
+	if tq.pq.Len() == 0 {
+		return nil
+	}
+	t := heap.Pop(tq.pq).(*Transmission)
+	delete(tq.queued, t.key())
+	return t
+}
+
+// HEAP
+// Adapted from https://pkg.go.dev/container/heap#example-package-PriorityQueue
+
+// WARNING: None of these methods are thread-safe, caller must synchronize
+
+var _ heap.Interface = &priorityQueue{}
+
+type priorityQueue []*Transmission
+
+func (pq priorityQueue) Len() int { return len(pq) }
+
+func (pq priorityQueue) Less(i, j int) bool {
+	// We want Pop to give us the latest seqNr, so we use greater than here
+	// i.e. a later seqNr is "less" than an earlier one
+	return pq[i].SeqNr > pq[j].SeqNr
+}
+
+func (pq priorityQueue) Swap(i, j int) {
+	pq[i], pq[j] = pq[j], pq[i]
+}
+
+func (pq *priorityQueue) Pop() any {
+	n := len(*pq)
+	if n == 0 {
+		return nil
+	}
+	old := *pq
+	item := old[n-1]
+	old[n-1] = nil // avoid memory leak
+	*pq = old[0 : n-1]
+	return item
+}
+
+func (pq *priorityQueue) Push(x any) {
+	*pq = append(*pq, x.(*Transmission))
+}
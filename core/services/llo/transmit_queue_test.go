@@ -0,0 +1,87 @@
+package llo
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+)
+
+type fakeAsyncDeleter struct {
+	mu      sync.Mutex
+	deleted []*Transmission
+}
+
+func (f *fakeAsyncDeleter) AsyncDelete(t *Transmission) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleted = append(f.deleted, t)
+}
+
+func newTestTransmission(seqNr uint64) *Transmission {
+	return &Transmission{ServerURL: sURL, ConfigDigest: sampleDigest, SeqNr: seqNr, Req: &pb.TransmitRequest{Payload: []byte("test")}}
+}
+
+func Test_TransmitQueue(t *testing.T) {
+	lggr := logger.TestLogger(t)
+
+	t.Run("pops the latest transmission first", func(t *testing.T) {
+		tq := NewTransmitQueue(lggr, sURL, 0, 7, &fakeAsyncDeleter{})
+		tq.Init([]*Transmission{newTestTransmission(2)})
+
+		for _, seqNr := range []uint64{1, 3} {
+			require.True(t, tq.Push(newTestTransmission(seqNr)))
+		}
+
+		assert.Equal(t, uint64(3), tq.BlockingPop().SeqNr)
+		assert.Equal(t, uint64(2), tq.BlockingPop().SeqNr)
+		assert.Equal(t, uint64(1), tq.BlockingPop().SeqNr)
+		assert.True(t, tq.IsEmpty())
+	})
+
+	t.Run("ignores duplicate transmissions", func(t *testing.T) {
+		tq := NewTransmitQueue(lggr, sURL, 0, 7, &fakeAsyncDeleter{})
+		tq.Init([]*Transmission{newTestTransmission(1), newTestTransmission(1)})
+
+		require.True(t, tq.Push(newTestTransmission(1)))
+		assert.Equal(t, 1, tq.(*transmitQueue).pq.Len())
+
+		// may be pushed again once popped, e.g. to retry
+		tq.BlockingPop()
+		require.True(t, tq.Push(newTestTransmission(1)))
+		assert.Equal(t, 1, tq.(*transmitQueue).pq.Len())
+	})
+
+	t.Run("evicts the oldest transmission when full", func(t *testing.T) {
+		deleter := &fakeAsyncDeleter{}
+		tq := NewTransmitQueue(lggr, sURL, 0, 2, deleter)
+		tq.Init([]*Transmission{})
+
+		for _, seqNr := range []uint64{2, 1, 3} {
+			require.True(t, tq.Push(newTestTransmission(seqNr)))
+		}
+
+		require.Len(t, deleter.deleted, 1)
+		assert.Equal(t, uint64(1), deleter.deleted[0].SeqNr)
+		assert.Equal(t, uint64(3), tq.BlockingPop().SeqNr)
+		assert.Equal(t, uint64(2), tq.BlockingPop().SeqNr)
+	})
+
+	t.Run("unblocks BlockingPop and rejects pushes when closed", func(t *testing.T) {
+		tq := NewTransmitQueue(lggr, sURL, 0, 7, &fakeAsyncDeleter{})
+		tq.Init([]*Transmission{})
+		require.NoError(t, tq.Start(testutils.Context(t)))
+
+		popped := make(chan *Transmission)
+		go func() { popped <- tq.BlockingPop() }()
+
+		require.NoError(t, tq.Close())
+		assert.Nil(t, <-popped)
+		assert.False(t, tq.Push(newTestTransmission(1)))
+	})
+}
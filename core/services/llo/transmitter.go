@@ -3,37 +3,80 @@ package llo
 import (
 	"context"
 	"crypto/ed25519"
+	"errors"
 	"fmt"
+	"io"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/jpillora/backoff"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/smartcontractkit/libocr/offchainreporting2/chains/evmutil"
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/evm"
+	mercuryutils "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // LLO Transmitter implementation, based on
 // core/services/relay/evm/mercury/transmitter.go
 
-// TODO: prom metrics (common with mercury/transmitter.go?)
-// https://smartcontract-it.atlassian.net/browse/MERC-3659
-
 const (
 	// Mercury server error codes
 	DuplicateReport = 2
-	// TODO: revisit these values in light of parallel composition
-	// https://smartcontract-it.atlassian.net/browse/MERC-3659
-	// maxTransmitQueueSize = 10_000
-	// maxDeleteQueueSize   = 10_000
-	// transmitTimeout      = 5 * time.Second
+)
+
+var (
+	transmitSuccessCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "llo_transmit_success_count",
+		Help: "Number of successful transmissions (duplicates are counted as success)",
+	},
+		[]string{"jobID", "serverURL"},
+	)
+	transmitDuplicateCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "llo_transmit_duplicate_count",
+		Help: "Number of transmissions where the server told us it was a duplicate",
+	},
+		[]string{"jobID", "serverURL"},
+	)
+	transmitConnectionErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "llo_transmit_connection_error_count",
+		Help: "Number of errored transmissions that failed due to problem with the connection",
+	},
+		[]string{"jobID", "serverURL"},
+	)
+	transmitQueuePushErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "llo_transmit_queue_push_error_count",
+		Help: "Running count of errors when trying to push an item onto the queue",
+	},
+		[]string{"jobID", "serverURL"},
+	)
+	transmitServerErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "llo_transmit_server_error_count",
+		Help: "Number of errored transmissions that failed due to an error returned by the mercury server",
+	},
+		[]string{"jobID", "serverURL", "code"},
+	)
+	transmitDeliveryLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "llo_transmit_delivery_lag_seconds",
+		Help: "Time the oldest report pending for the mercury server has been queued; zero if no reports are pending",
+	},
+		[]string{"jobID", "serverURL"},
+	)
 )
 
 var PayloadTypes = getPayloadTypes()
@@ -60,38 +103,106 @@ type Transmitter interface {
 	services.Service
 }
 
+type TransmitterConfig interface {
+	TransmitQueueMaxSize() uint32
+	TransmitTimeout() commonconfig.Duration
+}
+
+var _ Transmitter = (*transmitter)(nil)
+
 type transmitter struct {
 	services.StateMachine
 	lggr        logger.Logger
-	rpcClient   wsrpc.Client
+	servers     map[string]*server
+	orm         TransmitterORM
 	fromAccount string
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
 }
 
-func NewTransmitter(lggr logger.Logger, rpcClient wsrpc.Client, fromAccount ed25519.PublicKey) Transmitter {
+// NewTransmitter returns a transmitter which queues reports for each of the
+// mercury servers, and persists the queues so they survive restarts.
+func NewTransmitter(lggr logger.Logger, cfg TransmitterConfig, clients map[string]wsrpc.Client, fromAccount ed25519.PublicKey, jobID int32, orm TransmitterORM) Transmitter {
+	lggr = lggr.Named("LLOTransmitter")
+	servers := make(map[string]*server, len(clients))
+	for serverURL, client := range clients {
+		sLggr := lggr.Named(serverURL).With("serverURL", serverURL)
+		pm := newPersistenceManager(sLggr, serverURL, orm, int(cfg.TransmitQueueMaxSize()), flushDeletesFrequency, pruneFrequency)
+		servers[serverURL] = newServer(sLggr, cfg, client, pm, serverURL, jobID)
+	}
 	return &transmitter{
-		services.StateMachine{},
-		lggr,
-		rpcClient,
-		fmt.Sprintf("%x", fromAccount),
+		lggr:        lggr,
+		servers:     servers,
+		orm:         orm,
+		fromAccount: fmt.Sprintf("%x", fromAccount),
+		stopCh:      make(services.StopChan),
 	}
 }
 
 func (t *transmitter) Start(ctx context.Context) error {
-	return nil
+	return t.StartOnce("LLOTransmitter", func() error {
+		t.lggr.Debugw("Loading transmit requests from database")
+
+		var startClosers []services.StartClose
+		for _, s := range t.servers {
+			transmissions, err := s.pm.Load(ctx)
+			if err != nil {
+				return err
+			}
+			s.initQueue(transmissions)
+			// starting pm after loading from it is fine because it simply spawns some garbage collection/prune goroutines
+			startClosers = append(startClosers, s.c, s.q, s.pm)
+		}
+		if err := (&services.MultiStart{}).Start(ctx, startClosers...); err != nil {
+			return err
+		}
+
+		// only start sending once every queue is loaded and every client started, so that a failed Start leaves no
+		// goroutines behind
+		for _, s := range t.servers {
+			t.wg.Add(1)
+			go s.runQueueLoop(t.stopCh, &t.wg)
+		}
+		return nil
+	})
 }
 
 func (t *transmitter) Close() error {
-	return nil
+	return t.StopOnce("LLOTransmitter", func() error {
+		// Drain all the queues first
+		var qs []io.Closer
+		for _, s := range t.servers {
+			qs = append(qs, s.q)
+		}
+		if err := services.CloseAll(qs...); err != nil {
+			return err
+		}
+
+		close(t.stopCh)
+		t.wg.Wait()
+
+		var closers []io.Closer
+		for _, s := range t.servers {
+			closers = append(closers, s.pm, s.c)
+		}
+		return services.CloseAll(closers...)
+	})
 }
 
 func (t *transmitter) HealthReport() map[string]error {
 	report := map[string]error{t.Name(): t.Healthy()}
-	services.CopyHealth(report, t.rpcClient.HealthReport())
+	for _, s := range t.servers {
+		services.CopyHealth(report, s.HealthReport())
+	}
 	return report
 }
 
 func (t *transmitter) Name() string { return t.lggr.Name() }
 
+// Transmit persists the report and enqueues it for transmission to every
+// server. Reports are sent asynchronously, latest seqNr first, and retried
+// until they are accepted or evicted from the queue.
 func (t *transmitter) Transmit(
 	ctx context.Context,
 	digest types.ConfigDigest,
@@ -103,8 +214,8 @@ func (t *transmitter) Transmit(
 
 	switch report.Info.ReportFormat {
 	case llotypes.ReportFormatJSON:
-		fallthrough
-	case llotypes.ReportFormatEVM:
+		payload, err = encodeJSON(digest, seqNr, report.Report, sigs)
+	case llotypes.ReportFormatEVM, evm.ReportFormatEVMTyped:
 		payload, err = encodeEVM(digest, seqNr, report.Report, sigs)
 	default:
 		return fmt.Errorf("Transmit failed; unsupported report format: %q", report.Info.ReportFormat)
@@ -118,11 +229,170 @@ func (t *transmitter) Transmit(
 		Payload:      payload,
 		ReportFormat: uint32(report.Info.ReportFormat),
 	}
+	t.lggr.Debugw("Transmit enqueue", "req.Payload", hexutil.Encode(req.Payload), "seqNr", seqNr, "configDigest", digest)
+
+	transmissions := make([]*Transmission, 0, len(t.servers))
+	for serverURL := range t.servers {
+		transmissions = append(transmissions, &Transmission{ServerURL: serverURL, ConfigDigest: digest, SeqNr: seqNr, Req: req})
+	}
+	if err := t.orm.Insert(ctx, transmissions); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, transmission := range transmissions {
+		s := t.servers[transmission.ServerURL]
+		if ok := s.push(transmission); !ok {
+			s.transmitQueuePushErrorCount.Inc()
+			errs = append(errs, fmt.Errorf("transmit queue for %s is closed", transmission.ServerURL))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type server struct {
+	lggr logger.Logger
 
-	// TODO: persistenceManager and queueing, error handling, retry etc
-	// https://smartcontract-it.atlassian.net/browse/MERC-3659
-	_, err = t.rpcClient.Transmit(ctx, req)
-	return err
+	transmitTimeout time.Duration
+
+	c  wsrpc.Client
+	pm *persistenceManager
+	q  TransmitQueue
+
+	url   string
+	jobID string
+
+	deliveryLag *mercuryutils.DeliveryLagTracker[transmissionKey]
+
+	transmitSuccessCount         prometheus.Counter
+	transmitDuplicateCount       prometheus.Counter
+	transmitConnectionErrorCount prometheus.Counter
+	transmitQueuePushErrorCount  prometheus.Counter
+	transmitDeliveryLag          prometheus.Gauge
+}
+
+func newServer(lggr logger.Logger, cfg TransmitterConfig, client wsrpc.Client, pm *persistenceManager, serverURL string, jobID int32) *server {
+	jobIDStr := fmt.Sprintf("%d", jobID)
+	s := &server{
+		lggr:                         lggr,
+		transmitTimeout:              cfg.TransmitTimeout().Duration(),
+		c:                            client,
+		pm:                           pm,
+		url:                          serverURL,
+		jobID:                        jobIDStr,
+		deliveryLag:                  mercuryutils.NewDeliveryLagTracker[transmissionKey](),
+		transmitSuccessCount:         transmitSuccessCount.WithLabelValues(jobIDStr, serverURL),
+		transmitDuplicateCount:       transmitDuplicateCount.WithLabelValues(jobIDStr, serverURL),
+		transmitConnectionErrorCount: transmitConnectionErrorCount.WithLabelValues(jobIDStr, serverURL),
+		transmitQueuePushErrorCount:  transmitQueuePushErrorCount.WithLabelValues(jobIDStr, serverURL),
+		transmitDeliveryLag:          transmitDeliveryLag.WithLabelValues(jobIDStr, serverURL),
+	}
+	// the server sits between the queue and pm so that it learns about
+	// evicted transmissions
+	s.q = NewTransmitQueue(lggr, serverURL, jobID, int(cfg.TransmitQueueMaxSize()), s)
+	return s
+}
+
+func (s *server) HealthReport() map[string]error {
+	report := map[string]error{}
+	services.CopyHealth(report, s.c.HealthReport())
+	services.CopyHealth(report, s.q.HealthReport())
+	if err := s.deliveryLag.Check(s.url); err != nil {
+		report[s.lggr.Name()] = err
+	}
+	return report
+}
+
+// initQueue loads the persisted transmissions into the queue; their delivery
+// lag is measured from when they were loaded.
+func (s *server) initQueue(transmissions []*Transmission) {
+	for _, t := range transmissions {
+		s.deliveryLag.Enqueued(t.key())
+	}
+	s.q.Init(transmissions)
+}
+
+// push queues the transmission, keeping track of how long it is pending
+func (s *server) push(t *Transmission) (ok bool) {
+	s.deliveryLag.Enqueued(t.key())
+	if ok = s.q.Push(t); !ok {
+		s.deliveryLag.Done(t.key())
+	}
+	return ok
+}
+
+// AsyncDelete is called by the queue when it evicts a transmission
+func (s *server) AsyncDelete(t *Transmission) {
+	s.deliveryLag.Done(t.key())
+	s.pm.AsyncDelete(t)
+}
+
+func (s *server) runQueueLoop(stopCh services.StopChan, wg *sync.WaitGroup) {
+	defer wg.Done()
+	// Exponential backoff with very short retry interval (since latency is a priority)
+	// 5ms, 10ms, 20ms, 40ms etc
+	b := backoff.Backoff{
+		Min:    5 * time.Millisecond,
+		Max:    1 * time.Second,
+		Factor: 2,
+		Jitter: true,
+	}
+	runloopCtx, cancel := stopCh.NewCtx()
+	defer cancel()
+	for {
+		t := s.q.BlockingPop()
+		if t == nil {
+			// queue was closed
+			return
+		}
+		ctx, cancel := context.WithTimeout(runloopCtx, utils.WithJitter(s.transmitTimeout))
+		res, err := s.c.Transmit(ctx, t.Req)
+		cancel()
+		if runloopCtx.Err() != nil {
+			// runloop context is only canceled on transmitter close so we can
+			// exit the runloop here
+			return
+		} else if err != nil {
+			s.transmitConnectionErrorCount.Inc()
+			s.lggr.Errorw("Transmit report failed", "err", err, "seqNr", t.SeqNr, "configDigest", t.ConfigDigest)
+			if ok := s.push(t); !ok {
+				s.lggr.Error("Failed to push report to transmit queue; queue is closed")
+				return
+			}
+			s.transmitDeliveryLag.Set(s.deliveryLag.Lag().Seconds())
+			// Wait a backoff duration before pulling the most recent transmission
+			// the heap
+			select {
+			case <-time.After(b.Duration()):
+				continue
+			case <-stopCh:
+				return
+			}
+		}
+
+		b.Reset()
+		s.deliveryLag.Done(t.key())
+		s.transmitDeliveryLag.Set(s.deliveryLag.Lag().Seconds())
+		if res.Error == "" {
+			s.transmitSuccessCount.Inc()
+			s.lggr.Debugw("Transmit report success", "seqNr", t.SeqNr, "configDigest", t.ConfigDigest, "response", res)
+		} else {
+			// We don't need to retry here because the mercury server
+			// has confirmed it received the report. We only need to retry
+			// on networking/unknown errors
+			switch res.Code {
+			case DuplicateReport:
+				s.transmitSuccessCount.Inc()
+				s.transmitDuplicateCount.Inc()
+				s.lggr.Debugw("Transmit report success; duplicate report", "seqNr", t.SeqNr, "configDigest", t.ConfigDigest, "response", res)
+			default:
+				transmitServerErrorCount.WithLabelValues(s.jobID, s.url, fmt.Sprintf("%d", res.Code)).Inc()
+				s.lggr.Errorw("Transmit report failed; mercury server returned error", "response", res, "seqNr", t.SeqNr, "configDigest", t.ConfigDigest, "err", res.Error, "code", res.Code)
+			}
+		}
+
+		s.pm.AsyncDelete(t)
+	}
 }
 
 func encodeEVM(digest types.ConfigDigest, seqNr uint64, report ocr2types.Report, sigs []types.AttributedOnchainSignature) ([]byte, error) {
@@ -0,0 +1,144 @@
+package llo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+)
+
+// TransmitterORM persists the transmissions queued by the transmitter of a
+// job, so that they survive restarts.
+type TransmitterORM interface {
+	Insert(ctx context.Context, transmissions []*Transmission) error
+	Delete(ctx context.Context, transmissions []*Transmission) error
+	Get(ctx context.Context, serverURL string) ([]*Transmission, error)
+	Prune(ctx context.Context, serverURL string, maxSize int) error
+}
+
+var _ TransmitterORM = &transmitterORM{}
+
+type transmitterORM struct {
+	ds    sqlutil.DataSource
+	jobID int32
+}
+
+func NewTransmitterORM(ds sqlutil.DataSource, jobID int32) TransmitterORM {
+	return &transmitterORM{ds, jobID}
+}
+
+// Insert inserts the transmissions, ignoring those which exist already.
+func (o *transmitterORM) Insert(ctx context.Context, transmissions []*Transmission) error {
+	if len(transmissions) == 0 {
+		return nil
+	}
+
+	values := make([]string, len(transmissions))
+	args := []any{o.jobID}
+	for i, t := range transmissions {
+		n := len(args)
+		values[i] = fmt.Sprintf("($1, $%d, $%d, $%d, $%d, $%d, NOW())", n+1, n+2, n+3, n+4, n+5)
+		args = append(args, t.ServerURL, t.ConfigDigest[:], strconv.FormatUint(t.SeqNr, 10), t.Req.Payload, t.Req.ReportFormat)
+	}
+
+	_, err := o.ds.ExecContext(ctx, fmt.Sprintf(`
+		INSERT INTO llo_transmit_requests (job_id, server_url, config_digest, seq_nr, payload, report_format, created_at)
+		VALUES %s
+		ON CONFLICT (job_id, server_url, config_digest, seq_nr) DO NOTHING
+	`, strings.Join(values, ",")), args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert transmissions: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes the given transmissions if they exist.
+func (o *transmitterORM) Delete(ctx context.Context, transmissions []*Transmission) error {
+	if len(transmissions) == 0 {
+		return nil
+	}
+
+	serverURLs := make(pq.StringArray, len(transmissions))
+	digests := make(pq.ByteaArray, len(transmissions))
+	seqNrs := make(pq.StringArray, len(transmissions))
+	for i, t := range transmissions {
+		serverURLs[i] = t.ServerURL
+		digests[i] = t.ConfigDigest[:]
+		seqNrs[i] = strconv.FormatUint(t.SeqNr, 10)
+	}
+
+	_, err := o.ds.ExecContext(ctx, `
+		DELETE FROM llo_transmit_requests
+		WHERE job_id = $1 AND (server_url, config_digest, seq_nr) IN (
+			SELECT * FROM UNNEST($2::TEXT[], $3::BYTEA[], $4::NUMERIC[])
+		)
+	`, o.jobID, serverURLs, digests, seqNrs)
+	if err != nil {
+		return fmt.Errorf("failed to delete transmissions: %w", err)
+	}
+	return nil
+}
+
+// Get returns all transmissions to the server, latest first.
+func (o *transmitterORM) Get(ctx context.Context, serverURL string) ([]*Transmission, error) {
+	rows, err := o.ds.QueryContext(ctx, `
+		SELECT config_digest, seq_nr, payload, report_format
+		FROM llo_transmit_requests
+		WHERE job_id = $1 AND server_url = $2
+		ORDER BY seq_nr DESC
+	`, o.jobID, serverURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transmissions: %w", err)
+	}
+	defer rows.Close()
+
+	var transmissions []*Transmission
+	for rows.Next() {
+		transmission := &Transmission{ServerURL: serverURL, Req: &pb.TransmitRequest{}}
+		var digest []byte
+		var seqNr string
+		if err := rows.Scan(&digest, &seqNr, &transmission.Req.Payload, &transmission.Req.ReportFormat); err != nil {
+			return nil, fmt.Errorf("failed to scan transmission: %w", err)
+		}
+		if transmission.ConfigDigest, err = ocr2types.BytesToConfigDigest(digest); err != nil {
+			return nil, fmt.Errorf("invalid config digest: %w", err)
+		}
+		if transmission.SeqNr, err = strconv.ParseUint(seqNr, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid seqNr: %w", err)
+		}
+		transmissions = append(transmissions, transmission)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transmissions, nil
+}
+
+// Prune keeps at most maxSize transmissions to the server, deleting the
+// oldest ones.
+func (o *transmitterORM) Prune(ctx context.Context, serverURL string, maxSize int) error {
+	_, err := o.ds.ExecContext(ctx, `
+		DELETE FROM llo_transmit_requests
+		WHERE job_id = $1 AND server_url = $2 AND
+		(config_digest, seq_nr) NOT IN (
+			SELECT config_digest, seq_nr
+			FROM llo_transmit_requests
+			WHERE job_id = $1 AND server_url = $2
+			ORDER BY seq_nr DESC
+			LIMIT $3
+		)
+	`, o.jobID, serverURL, maxSize)
+	if err != nil {
+		return fmt.Errorf("failed to prune transmissions: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package llo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func Test_TransmitterORM(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	var jobID int32
+	pgtest.MustExec(t, db, `SET CONSTRAINTS llo_transmit_requests_job_id_fkey DEFERRED`)
+	orm := NewTransmitterORM(db, jobID)
+	ctx := testutils.Context(t)
+
+	t1, t2, t3 := newTestTransmission(1), newTestTransmission(2), newTestTransmission(3)
+	other := newTestTransmission(1)
+	other.ServerURL = sURL2
+
+	t.Run("Insert and Get", func(t *testing.T) {
+		require.NoError(t, orm.Insert(ctx, []*Transmission{t1, t3, other}))
+		// inserting again is a no-op
+		require.NoError(t, orm.Insert(ctx, []*Transmission{t1, t2}))
+
+		transmissions, err := orm.Get(ctx, sURL)
+		require.NoError(t, err)
+		assert.Equal(t, []*Transmission{t3, t2, t1}, transmissions)
+
+		transmissions, err = orm.Get(ctx, sURL2)
+		require.NoError(t, err)
+		assert.Equal(t, []*Transmission{other}, transmissions)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, orm.Delete(ctx, []*Transmission{t2, other}))
+
+		transmissions, err := orm.Get(ctx, sURL)
+		require.NoError(t, err)
+		assert.Equal(t, []*Transmission{t3, t1}, transmissions)
+
+		transmissions, err = orm.Get(ctx, sURL2)
+		require.NoError(t, err)
+		assert.Empty(t, transmissions)
+	})
+
+	t.Run("Prune", func(t *testing.T) {
+		require.NoError(t, orm.Insert(ctx, []*Transmission{t2, other}))
+		require.NoError(t, orm.Prune(ctx, sURL, 2))
+
+		transmissions, err := orm.Get(ctx, sURL)
+		require.NoError(t, err)
+		assert.Equal(t, []*Transmission{t3, t2}, transmissions)
+
+		// other servers are unaffected
+		transmissions, err = orm.Get(ctx, sURL2)
+		require.NoError(t, err)
+		assert.Len(t, transmissions, 1)
+	})
+}
@@ -1,7 +1,210 @@
 package llo
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
 
-func Test_Transmitter(t *testing.T) {
-	// TODO: https://smartcontract-it.atlassian.net/browse/MERC-3659
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+)
+
+const (
+	sURL  = "wss://example.com/mercury"
+	sURL2 = "wss://mercuryserver.test"
+)
+
+type mockCfg struct{}
+
+func (m mockCfg) TransmitQueueMaxSize() uint32 {
+	return 10_000
+}
+
+func (m mockCfg) TransmitTimeout() commonconfig.Duration {
+	return *commonconfig.MustNewDuration(1 * time.Hour)
+}
+
+var (
+	sampleClientPubKey = make([]byte, 32)
+	sampleDigest       = ocr2types.ConfigDigest{1, 2, 3}
+	sampleSigs         = []ocr2types.AttributedOnchainSignature{{Signature: make([]byte, 65), Signer: 1}}
+	sampleReport       = ocr3types.ReportWithInfo[llotypes.ReportInfo]{
+		Report: []byte("report"),
+		Info:   llotypes.ReportInfo{ReportFormat: llotypes.ReportFormatEVM},
+	}
+)
+
+func Test_Transmitter_Transmit(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	db := pgtest.NewSqlxDB(t)
+	var jobID int32
+	pgtest.MustExec(t, db, `SET CONSTRAINTS llo_transmit_requests_job_id_fkey DEFERRED`)
+	orm := NewTransmitterORM(db, jobID)
+	ctx := testutils.Context(t)
+
+	t.Run("enqueues and persists the report for every server", func(t *testing.T) {
+		clients := map[string]wsrpc.Client{sURL: &mocks.MockWSRPCClient{}, sURL2: &mocks.MockWSRPCClient{}}
+		mt := NewTransmitter(lggr, mockCfg{}, clients, sampleClientPubKey, jobID, orm).(*transmitter)
+		// init the queues since we skipped starting transmitter
+		for _, s := range mt.servers {
+			s.q.Init([]*Transmission{})
+		}
+
+		err := mt.Transmit(ctx, sampleDigest, 1, sampleReport, sampleSigs)
+		require.NoError(t, err)
+
+		for _, serverURL := range []string{sURL, sURL2} {
+			require.Equal(t, 1, mt.servers[serverURL].q.(*transmitQueue).pq.Len())
+			transmissions, err := orm.Get(ctx, serverURL)
+			require.NoError(t, err)
+			require.Len(t, transmissions, 1)
+			assert.Equal(t, uint64(1), transmissions[0].SeqNr)
+			assert.Equal(t, sampleDigest, transmissions[0].ConfigDigest)
+		}
+	})
+
+	t.Run("fails on unsupported report format", func(t *testing.T) {
+		clients := map[string]wsrpc.Client{sURL: &mocks.MockWSRPCClient{}}
+		mt := NewTransmitter(lggr, mockCfg{}, clients, sampleClientPubKey, jobID, orm)
+		report := sampleReport
+		report.Info.ReportFormat = llotypes.ReportFormat(255)
+
+		err := mt.Transmit(ctx, sampleDigest, 2, report, sampleSigs)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported report format")
+	})
+}
+
+func Test_Transmitter_runQueueLoop(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	db := pgtest.NewSqlxDB(t)
+	var jobID int32
+	pgtest.MustExec(t, db, `SET CONSTRAINTS llo_transmit_requests_job_id_fkey DEFERRED`)
+	orm := NewTransmitterORM(db, jobID)
+	ctx := testutils.Context(t)
+
+	var calls atomic.Int32
+	c := &mocks.MockWSRPCClient{
+		TransmitF: func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+			if calls.Add(1) < 3 {
+				return nil, errors.New("connection refused")
+			}
+			return &pb.TransmitResponse{}, nil
+		},
+	}
+	mt := NewTransmitter(lggr, mockCfg{}, map[string]wsrpc.Client{sURL: c}, sampleClientPubKey, jobID, orm)
+	lt := mt.(*transmitter)
+	require.NoError(t, mt.Start(ctx))
+	t.Cleanup(func() { assert.NoError(t, mt.Close()) })
+
+	require.NoError(t, mt.Transmit(ctx, sampleDigest, 1, sampleReport, sampleSigs))
+
+	// retried on connection errors until it succeeded
+	require.Eventually(t, func() bool { return calls.Load() == 3 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	assert.True(t, lt.servers[sURL].q.IsEmpty())
+	// then deleted from the database
+	require.Eventually(t, func() bool {
+		transmissions, err := orm.Get(ctx, sURL)
+		require.NoError(t, err)
+		return len(transmissions) == 0
+	}, testutils.WaitTimeout(t), 100*time.Millisecond)
+}
+
+type failingStartClient struct {
+	*mocks.MockWSRPCClient
+}
+
+func (failingStartClient) Start(context.Context) error { return errors.New("failed to dial") }
+
+func Test_Transmitter_Start(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	db := pgtest.NewSqlxDB(t)
+	var jobID int32
+	pgtest.MustExec(t, db, `SET CONSTRAINTS llo_transmit_requests_job_id_fkey DEFERRED`)
+	orm := NewTransmitterORM(db, jobID)
+	ctx := testutils.Context(t)
+
+	t.Run("does not send anything if a client fails to start", func(t *testing.T) {
+		// a transmission of the healthy server is loaded from the database
+		require.NoError(t, orm.Insert(ctx, []*Transmission{newTestTransmission(1)}))
+
+		var calls atomic.Int32
+		c := &mocks.MockWSRPCClient{
+			TransmitF: func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+				calls.Add(1)
+				return &pb.TransmitResponse{}, nil
+			},
+		}
+		clients := map[string]wsrpc.Client{sURL: c, sURL2: failingStartClient{&mocks.MockWSRPCClient{}}}
+		mt := NewTransmitter(lggr, mockCfg{}, clients, sampleClientPubKey, jobID, orm)
+
+		require.ErrorContains(t, mt.Start(ctx), "failed to dial")
+		assert.Never(t, func() bool { return calls.Load() > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+	})
+}
+
+func Test_Transmitter_HealthReport(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	db := pgtest.NewSqlxDB(t)
+	var jobID int32
+	orm := NewTransmitterORM(db, jobID)
+
+	clients := map[string]wsrpc.Client{sURL: &mocks.MockWSRPCClient{}, sURL2: &mocks.MockWSRPCClient{}}
+	mt := NewTransmitter(lggr, mockCfg{}, clients, sampleClientPubKey, jobID, orm).(*transmitter)
+	for _, s := range mt.servers {
+		s.q.Init([]*Transmission{})
+	}
+
+	t.Run("no delivery lag if no transmissions are pending", func(t *testing.T) {
+		assert.Zero(t, mt.servers[sURL].deliveryLag.Lag())
+		for _, err := range mt.servers[sURL].HealthReport() {
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("measures the delivery lag from the oldest pending transmission", func(t *testing.T) {
+		s := mt.servers[sURL]
+		t1, t2 := newTestTransmission(1), newTestTransmission(2)
+		require.True(t, s.push(t1))
+		require.True(t, s.push(t2))
+		lag := s.deliveryLag.Lag()
+		assert.Greater(t, lag, time.Duration(0))
+
+		// retrying the latest transmission and then delivering it leaves the
+		// oldest one pending
+		require.Equal(t, t2, s.q.BlockingPop())
+		require.True(t, s.push(t2))
+		require.Equal(t, t2, s.q.BlockingPop())
+		s.deliveryLag.Done(t2.key())
+		assert.Greater(t, s.deliveryLag.Lag(), lag)
+
+		// evicting it from the queue means it is no longer pending
+		s.AsyncDelete(t1)
+		assert.Zero(t, s.deliveryLag.Lag())
+		for _, err := range s.HealthReport() {
+			assert.NoError(t, err)
+		}
+
+		// delivery to one server doesn't affect the others
+		transmission := newTestTransmission(1)
+		transmission.ServerURL = sURL2
+		require.True(t, mt.servers[sURL2].push(transmission))
+		assert.Greater(t, mt.servers[sURL2].deliveryLag.Lag(), time.Duration(0))
+		assert.Zero(t, s.deliveryLag.Lag())
+	})
 }
@@ -48,6 +48,8 @@ type PluginConfig struct {
 	OnchainSubscriptions                     *subscriptions.OnchainSubscriptionsConfig `json:"onchainSubscriptions"`
 	RateLimiter                              *common.RateLimiterConfig                 `json:"rateLimiter"`
 	S4Constraints                            *s4.Constraints                           `json:"s4Constraints"`
+	S4PruneFrequencySec                      uint32                                    `json:"s4PruneFrequencySec"`
+	S4PruneBatchSize                         uint32                                    `json:"s4PruneBatchSize"`
 	DecryptionQueueConfig                    *DecryptionQueueConfig                    `json:"decryptionQueueConfig"`
 	ExternalAdapterMaxRetries                *uint32                                   `json:"externalAdapterMaxRetries"`
 	ExternalAdapterExponentialBackoffBaseSec *uint32                                   `json:"externalAdapterExponentialBackoffBaseSec"`
@@ -102,6 +102,11 @@ func NewFunctionsServices(ctx context.Context, functionsOracleArgs, thresholdOra
 	var s4Storage s4.Storage
 	if pluginConfig.S4Constraints != nil {
 		s4Storage = s4.NewStorage(conf.Logger, *pluginConfig.S4Constraints, s4ORM, clockwork.NewRealClock())
+		s4PrunerConfig := s4.PrunerConfig{
+			Interval:  time.Duration(pluginConfig.S4PruneFrequencySec) * time.Second,
+			BatchSize: uint(pluginConfig.S4PruneBatchSize),
+		}
+		allServices = append(allServices, s4.NewPruner(conf.Logger, s4PrunerConfig, s4ORM, clockwork.NewRealClock()))
 	}
 
 	offchainTransmitter := functions.NewOffchainTransmitter(DefaultOffchainTransmitterChannelSize)
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net/url"
 	"regexp"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -19,9 +20,16 @@ import (
 )
 
 type PluginConfig struct {
+	// Single mercury server
+	// LEGACY: This is the old way of specifying a mercury server
 	RawServerURL string              `json:"serverURL" toml:"serverURL"`
 	ServerPubKey utils.PlainHexBytes `json:"serverPubKey" toml:"serverPubKey"`
 
+	// Multi mercury servers
+	// This is the preferred way to specify mercury server(s). Reports are
+	// delivered to every server, each with its own queue.
+	Servers map[string]utils.PlainHexBytes `json:"servers" toml:"servers"`
+
 	ChannelDefinitionsContractAddress   common.Address `json:"channelDefinitionsContractAddress" toml:"channelDefinitionsContractAddress"`
 	ChannelDefinitionsContractFromBlock int64          `json:"channelDefinitionsContractFromBlock" toml:"channelDefinitionsContractFromBlock"`
 
@@ -42,20 +50,25 @@ type PluginConfig struct {
 }
 
 func (p PluginConfig) Validate() (merr error) {
-	if p.RawServerURL == "" {
-		merr = errors.New("llo: ServerURL must be specified")
+	if len(p.Servers) > 0 {
+		if p.RawServerURL != "" || len(p.ServerPubKey) != 0 {
+			merr = errors.New("llo: Servers and RawServerURL/ServerPubKey may not be specified together")
+		} else {
+			for serverURL, serverPubKey := range p.Servers {
+				merr = errors.Join(merr, validateURL(serverURL))
+				if len(serverPubKey) != 32 {
+					merr = errors.Join(merr, fmt.Errorf("llo: ServerPubKey for %q must be a 32-byte hex string", serverURL))
+				}
+			}
+		}
 	} else {
-		var normalizedURI string
-		if schemeRegexp.MatchString(p.RawServerURL) {
-			normalizedURI = p.RawServerURL
+		if p.RawServerURL == "" {
+			merr = errors.New("llo: ServerURL must be specified")
 		} else {
-			normalizedURI = fmt.Sprintf("wss://%s", p.RawServerURL)
+			merr = validateURL(p.RawServerURL)
 		}
-		uri, err := url.ParseRequestURI(normalizedURI)
-		if err != nil {
-			merr = fmt.Errorf("llo: invalid value for ServerURL: %w", err)
-		} else if uri.Scheme != "wss" {
-			merr = fmt.Errorf(`llo: invalid scheme specified for MercuryServer, got: %q (scheme: %q) but expected a websocket url e.g. "192.0.2.2:4242" or "wss://192.0.2.2:4242"`, p.RawServerURL, uri.Scheme)
+		if len(p.ServerPubKey) != 32 {
+			merr = errors.Join(merr, errors.New("llo: ServerPubKey is required and must be a 32-byte hex string"))
 		}
 	}
 
@@ -76,15 +89,27 @@ func (p PluginConfig) Validate() (merr error) {
 		}
 	}
 
-	if len(p.ServerPubKey) != 32 {
-		merr = errors.Join(merr, errors.New("llo: ServerPubKey is required and must be a 32-byte hex string"))
-	}
-
 	merr = errors.Join(merr, validateKeyBundleIDs(p.KeyBundleIDs))
 
 	return merr
 }
 
+func validateURL(rawServerURL string) error {
+	var normalizedURI string
+	if schemeRegexp.MatchString(rawServerURL) {
+		normalizedURI = rawServerURL
+	} else {
+		normalizedURI = fmt.Sprintf("wss://%s", rawServerURL)
+	}
+	uri, err := url.ParseRequestURI(normalizedURI)
+	if err != nil {
+		return fmt.Errorf("llo: invalid value for ServerURL: %w", err)
+	} else if uri.Scheme != "wss" {
+		return fmt.Errorf(`llo: invalid scheme specified for MercuryServer, got: %q (scheme: %q) but expected a websocket url e.g. "192.0.2.2:4242" or "wss://192.0.2.2:4242"`, rawServerURL, uri.Scheme)
+	}
+	return nil
+}
+
 func validateKeyBundleIDs(keyBundleIDs map[string]string) error {
 	for k, v := range keyBundleIDs {
 		if k == "" {
@@ -109,3 +134,22 @@ var wssRegexp = regexp.MustCompile(`^wss://`)
 func (p PluginConfig) ServerURL() string {
 	return wssRegexp.ReplaceAllString(p.RawServerURL, "")
 }
+
+type Server struct {
+	URL    string
+	PubKey utils.PlainHexBytes
+}
+
+// GetServers returns the configured mercury servers, sorted by URL
+func (p PluginConfig) GetServers() (servers []Server) {
+	if p.RawServerURL != "" {
+		return []Server{{URL: p.ServerURL(), PubKey: p.ServerPubKey}}
+	}
+	for url, pubKey := range p.Servers {
+		servers = append(servers, Server{URL: wssRegexp.ReplaceAllString(url, ""), PubKey: pubKey})
+	}
+	sort.Slice(servers, func(i, j int) bool {
+		return servers[i].URL < servers[j].URL
+	})
+	return
+}
@@ -114,6 +114,36 @@ func Test_Config(t *testing.T) {
 			assert.EqualError(t, err, "llo: ChannelDefinitionsContractAddress is required if ChannelDefinitions is not specified")
 		})
 
+		t.Run("with multiple servers", func(t *testing.T) {
+			rawToml := `
+			ChannelDefinitionsContractAddress = "0xdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef"
+			[servers]
+			"example.com:80" = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+			"wss://example2.invalid:1234" = "524ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"`
+
+			var mc PluginConfig
+			err := toml.Unmarshal([]byte(rawToml), &mc)
+			require.NoError(t, err)
+
+			require.NoError(t, mc.Validate())
+			servers := mc.GetServers()
+			require.Len(t, servers, 2)
+			assert.Equal(t, "example.com:80", servers[0].URL)
+			assert.Equal(t, "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93", servers[0].PubKey.String())
+			assert.Equal(t, "example2.invalid:1234", servers[1].URL)
+			assert.Equal(t, "524ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93", servers[1].PubKey.String())
+
+			mc.RawServerURL = "example.com:80"
+			assert.EqualError(t, mc.Validate(), "llo: Servers and RawServerURL/ServerPubKey may not be specified together")
+
+			mc.RawServerURL = ""
+			mc.Servers["http://example3.invalid"] = []byte{1, 2}
+			err = mc.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), `invalid scheme specified for MercuryServer, got: "http://example3.invalid"`)
+			assert.Contains(t, err.Error(), `llo: ServerPubKey for "http://example3.invalid" must be a 32-byte hex string`)
+		})
+
 		t.Run("with invalid values", func(t *testing.T) {
 			rawToml := `
 				ChannelDefinitionsContractFromBlock = "invalid"
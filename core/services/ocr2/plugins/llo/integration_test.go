@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	chainselectors "github.com/smartcontractkit/chain-selectors"
+	"github.com/smartcontractkit/libocr/commontypes"
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/confighelper"
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3confighelper"
 	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
@@ -168,9 +169,11 @@ func TestIntegration_LLO(t *testing.T) {
 		for channelID, defn := range channelDefinitions {
 			t.Logf("Expect report for channel ID %x (definition: %#v)", channelID, defn)
 		}
-		for _, o := range oracles {
+		signers := make(map[commontypes.OracleID]ocr2types.OnchainPublicKey, len(oracles))
+		for i, o := range oracles {
 			t.Logf("Expect report from oracle %s", o.OracleIdentity.TransmitAccount)
 			seen[o.OracleIdentity.TransmitAccount] = make(map[llotypes.ChannelID]struct{})
+			signers[commontypes.OracleID(i)] = o.OracleIdentity.OnchainPublicKey
 		}
 		for req := range reqs {
 			if _, exists := seen[req.TransmitterID()]; !exists {
@@ -179,27 +182,28 @@ func TestIntegration_LLO(t *testing.T) {
 				continue
 			}
 
-			v := make(map[string]interface{})
-			err := llo.PayloadTypes.UnpackIntoMap(v, req.req.Payload)
-			require.NoError(t, err)
-			report, exists := v["report"]
-			if !exists {
-				t.Fatalf("FAIL: expected payload %#v to contain 'report'", v)
-			}
-
 			t.Logf("Got report from oracle %s with format: %d", req.pk, req.req.ReportFormat)
 
 			var r datastreamsllo.Report
 
 			switch req.req.ReportFormat {
 			case uint32(llotypes.ReportFormatJSON):
-				t.Logf("Got report (JSON) from oracle %x: %s", req.pk, string(report.([]byte)))
-				var err error
-				r, err = (datastreamsllo.JSONReportCodec{}).Decode(report.([]byte))
+				p, err := llo.DecodeJSONPayload(req.req.Payload)
+				require.NoError(t, err, "expected valid JSON payload")
+				require.NoError(t, llo.VerifyJSONPayload(nodes[0].KeyBundle, p, signers, int(fNodes)))
+				t.Logf("Got report (JSON) from oracle %x: %s", req.pk, string(p.Report))
+				assert.Equal(t, configDigest, p.ConfigDigest)
+				r, err = (llo.JSONReportCodec{}).Decode(p.Report)
 				require.NoError(t, err, "expected valid JSON")
 			case uint32(llotypes.ReportFormatEVM):
+				v := make(map[string]interface{})
+				err := llo.PayloadTypes.UnpackIntoMap(v, req.req.Payload)
+				require.NoError(t, err)
+				report, exists := v["report"]
+				if !exists {
+					t.Fatalf("FAIL: expected payload %#v to contain 'report'", v)
+				}
 				t.Logf("Got report (EVM) from oracle %s: 0x%x", req.pk, report.([]byte))
-				var err error
 				r, err = (lloevm.ReportCodec{}).Decode(report.([]byte))
 				require.NoError(t, err, "expected valid EVM encoding")
 			default:
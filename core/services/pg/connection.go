@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"net/url"
 	"os"
 	"time"
 
@@ -50,6 +51,12 @@ type ConnectionConfig interface {
 }
 
 func NewConnection(uri string, dialect dialects.DialectName, config ConnectionConfig) (*sqlx.DB, error) {
+	return newConnection(uri, dialect, config, nil)
+}
+
+// newConnection opens a connection to the database. If credentials is not nil, every new connection authenticates
+// with the user and password of the URL it returns, so that they can be rotated without re-opening the database.
+func newConnection(uri string, dialect dialects.DialectName, config ConnectionConfig, credentials func() url.URL) (*sqlx.DB, error) {
 	opts := []otelsql.Option{otelsql.WithAttributes(semconv.DBSystemPostgreSQL),
 		otelsql.WithTracerProvider(otel.GetTracerProvider()),
 		otelsql.WithSQLCommenter(true),
@@ -94,10 +101,17 @@ func NewConnection(uri string, dialect dialects.DialectName, config ConnectionCo
 			return nil, fmt.Errorf("database: failed to parse config: %w", err)
 		}
 
-		connector := stdlib.GetConnector(*connConfig, stdlib.OptionAfterConnect(func(ctx context.Context, c *pgx.Conn) (err error) {
+		connectorOpts := []stdlib.OptionOpenDB{stdlib.OptionAfterConnect(func(ctx context.Context, c *pgx.Conn) (err error) {
 			_, err = c.Exec(ctx, connParams)
 			return
-		}))
+		})}
+		if credentials != nil {
+			connectorOpts = append(connectorOpts, stdlib.OptionBeforeConnect(func(ctx context.Context, cc *pgx.ConnConfig) error {
+				setCredentials(cc, credentials())
+				return nil
+			}))
+		}
+		connector := stdlib.GetConnector(*connConfig, connectorOpts...)
 
 		// Initialize sql/sqlx
 		sqldb = otelsql.OpenDB(connector, opts...)
@@ -116,6 +130,19 @@ func NewConnection(uri string, dialect dialects.DialectName, config ConnectionCo
 	return db, disallowReplica(db)
 }
 
+// setCredentials sets the user and password of the connection config from the URL, if it has any.
+func setCredentials(cc *pgx.ConnConfig, u url.URL) {
+	if u.User == nil {
+		return
+	}
+	if user := u.User.Username(); user != "" {
+		cc.User = user
+	}
+	if password, ok := u.User.Password(); ok {
+		cc.Password = password
+	}
+}
+
 func setMaxConns(db *sqlx.DB, config ConnectionConfig) {
 	db.SetMaxOpenConns(config.MaxOpenConns())
 	db.SetMaxIdleConns(config.MaxIdleConns())
@@ -143,6 +143,7 @@ func openDB(appID uuid.UUID, cfg LockedDBConfig) (db *sqlx.DB, err error) {
 	uri := cfg.URL()
 	static.SetConsumerName(&uri, "App", &appID)
 	dialect := cfg.Dialect()
-	db, err = NewConnection(uri.String(), dialect, cfg)
+	// The URL is read again for every new connection, as the credentials may be rotated by a secrets provider
+	db, err = newConnection(uri.String(), dialect, cfg, cfg.URL)
 	return
 }
@@ -19,6 +19,7 @@ import (
 	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	cnull "github.com/smartcontractkit/chainlink/v2/core/null"
 )
@@ -29,6 +30,7 @@ const (
 	BootstrapJobType               string = "bootstrap"
 	CronJobType                    string = "cron"
 	DirectRequestJobType           string = "directrequest"
+	EVMLogJobType                  string = "evmlog"
 	FluxMonitorJobType             string = "fluxmonitor"
 	GatewayJobType                 string = "gateway"
 	KeeperJobType                  string = "keeper"
@@ -64,6 +66,7 @@ type (
 	Config interface {
 		DefaultHTTPLimit() int64
 		DefaultHTTPTimeout() commonconfig.Duration
+		MaxHTTPCacheSize() int64
 		MaxRunDuration() time.Duration
 		ReaperInterval() time.Duration
 		ReaperThreshold() time.Duration
@@ -73,6 +76,8 @@ type (
 	BridgeConfig interface {
 		BridgeResponseURL() *url.URL
 		BridgeCacheTTL() time.Duration
+		// BridgeCredentials returns the basic auth credentials for the bridge, or nil if there are none.
+		BridgeCredentials(name string) *coreconfig.BridgeCredentials
 	}
 )
 
@@ -296,10 +301,12 @@ const (
 	TaskTypeETHCall          TaskType = "ethcall"
 	TaskTypeETHTx            TaskType = "ethtx"
 	TaskTypeEstimateGasLimit TaskType = "estimategaslimit"
+	TaskTypeExpr             TaskType = "expr"
 	TaskTypeHTTP             TaskType = "http"
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
 	TaskTypeJSONParse        TaskType = "jsonparse"
+	TaskTypeJSONPath         TaskType = "jsonpath"
 	TaskTypeLength           TaskType = "length"
 	TaskTypeLessThan         TaskType = "lessthan"
 	TaskTypeLookup           TaskType = "lookup"
@@ -360,6 +367,10 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &AnyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeJSONParse:
 		task = &JSONParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeJSONPath:
+		task = &JSONPathTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeExpr:
+		task = &ExprTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMemo:
 		task = &MemoTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMultiply:
@@ -461,6 +472,17 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		}
 	}
 
+	// reject invalid paths and expressions when the spec is created, rather than on every run
+	switch t := task.(type) {
+	case *JSONPathTask:
+		err = t.validate()
+	case *ExprTask:
+		err = t.validate()
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	return task, nil
 }
 
@@ -103,6 +103,49 @@ func parseETHABIString(theABI []byte, isLog bool) (name string, args abi.Argumen
 	return name, args, indexedArgs, err
 }
 
+// ParseETHABIEvent parses an event ABI in the format accepted by the ethabidecodelog task,
+// e.g. "Transfer(address indexed from, address indexed to, uint256 value)".
+func ParseETHABIEvent(theABI string) (abi.Event, error) {
+	name, args, _, err := parseETHABIString([]byte(theABI), true)
+	if err != nil {
+		return abi.Event{}, err
+	}
+	if name == "" {
+		return abi.Event{}, errors.Errorf("bad ABI specification, missing event name: %s", theABI)
+	}
+	return abi.NewEvent(name, name, false, args), nil
+}
+
+// DecodeETHABILog decodes the data and topics of a log emitted by event into a map of argument
+// names to values, like the ethabidecodelog task. The first topic is the event signature.
+func DecodeETHABILog(event abi.Event, data []byte, topics []common.Hash) (map[string]interface{}, error) {
+	var indexedArgs abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexedArgs = append(indexedArgs, arg)
+		}
+	}
+	return decodeETHABILog(event.Inputs, indexedArgs, data, topics)
+}
+
+func decodeETHABILog(args, indexedArgs abi.Arguments, data []byte, topics []common.Hash) (map[string]interface{}, error) {
+	out := make(map[string]interface{})
+	if len(data) > 0 {
+		if err := args.UnpackIntoMap(out, data); err != nil {
+			return nil, err
+		}
+	}
+	if len(indexedArgs) > 0 {
+		if len(topics) != len(indexedArgs)+1 {
+			return nil, errors.New("topic/field count mismatch")
+		}
+		if err := abi.ParseTopicsIntoMap(out, indexedArgs, topics[1:]); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
 func convertToETHABIType(val interface{}, abiType abi.Type) (interface{}, error) {
 	srcVal := reflect.ValueOf(val)
 
@@ -2,6 +2,7 @@ package pipeline
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
 	"strings"
 	"testing"
@@ -152,3 +153,29 @@ func Test_convertToETHABIBytes_Errors(t *testing.T) {
 		})
 	}
 }
+
+func TestParseETHABIEvent(t *testing.T) {
+	t.Parallel()
+
+	event, err := ParseETHABIEvent("Transfer(address indexed from, address indexed to, uint256 value)")
+	require.NoError(t, err)
+	assert.Equal(t, "Transfer", event.Name)
+	assert.Equal(t, common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"), event.ID)
+
+	from := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(42))
+	require.NoError(t, err)
+
+	out, err := DecodeETHABILog(event, data, []common.Hash{event.ID, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"from": from, "to": to, "value": big.NewInt(42)}, out)
+
+	_, err = DecodeETHABILog(event, data, []common.Hash{event.ID})
+	require.EqualError(t, err, "topic/field count mismatch")
+
+	for _, bad := range []string{"", "(uint256 value)", "Transfer(uint256)", "Transfer(address indexed)"} {
+		_, err = ParseETHABIEvent(bad)
+		assert.Error(t, err, bad)
+	}
+}
@@ -51,6 +51,10 @@ func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, un
 	t.unrestrictedHTTPClient = unrestrictedHTTPClient
 }
 
+func (t *HTTPTask) HelperSetCache(maxSize int64) {
+	t.cache = newHTTPResponseCache(maxSize)
+}
+
 func (t *ETHCallTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer, config Config, specGasLimit *uint32, jobType string) {
 	t.legacyChains = legacyChains
 	t.config = config
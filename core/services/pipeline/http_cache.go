@@ -0,0 +1,180 @@
+package pipeline
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/singleflight"
+)
+
+var (
+	promHTTPCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_hits_total",
+		Help: "Number of HTTP task responses served from the response cache",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+	promHTTPCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_misses_total",
+		Help: "Number of HTTP task requests with a cacheTTL which were not found in the response cache",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+	promHTTPCacheCoalesced = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_coalesced_total",
+		Help: "Number of HTTP task requests which shared the response of a concurrent identical request",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+)
+
+// httpResponseCache is an LRU cache of HTTP task responses, bounded by the total size of the
+// cached bodies, and shared by all the jobs of a node. Concurrent identical requests are coalesced
+// into a single one.
+type httpResponseCache struct {
+	maxSize int64
+	group   singleflight.Group
+
+	mu      sync.Mutex
+	size    int64
+	lru     *list.List // of *httpCacheEntry, most recently used first
+	entries map[string]*list.Element
+}
+
+type httpCacheEntry struct {
+	key       string
+	resp      httpResponse
+	expiresAt time.Time
+}
+
+// httpResponse is the part of a response which is cached, and shared by coalesced requests.
+type httpResponse struct {
+	body       []byte
+	statusCode int
+}
+
+// defaultHTTPCacheFetchTimeout bounds coalesced requests when neither the task nor the node
+// configures an HTTP timeout.
+const defaultHTTPCacheFetchTimeout = 15 * time.Second
+
+// newHTTPResponseCache returns a cache holding up to maxSize bytes of response bodies, or nil if
+// maxSize is not positive, which disables caching.
+func newHTTPResponseCache(maxSize int64) *httpResponseCache {
+	if maxSize <= 0 {
+		return nil
+	}
+	return &httpResponseCache{
+		maxSize: maxSize,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// httpCacheKey identifies a request by everything that may affect its response. Whether
+// unrestricted network access is allowed is part of the key, so that responses from local
+// resources are never served to tasks which can't reach them.
+func httpCacheKey(method string, url string, body []byte, headers []string, allowUnrestrictedNetworkAccess bool) string {
+	h := sha256.New()
+	writePart := func(b []byte) {
+		var l [8]byte
+		binary.BigEndian.PutUint64(l[:], uint64(len(b)))
+		h.Write(l[:])
+		h.Write(b)
+	}
+	writePart([]byte(method))
+	writePart([]byte(url))
+	writePart(body)
+	for _, header := range headers {
+		writePart([]byte(header))
+	}
+	if allowUnrestrictedNetworkAccess {
+		writePart([]byte{1})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (c *httpResponseCache) get(key string, now time.Time) (httpResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return httpResponse{}, false
+	}
+	entry := elem.Value.(*httpCacheEntry)
+	if !now.Before(entry.expiresAt) {
+		c.remove(elem)
+		return httpResponse{}, false
+	}
+	c.lru.MoveToFront(elem)
+	return entry.resp, true
+}
+
+func (c *httpResponseCache) set(key string, resp httpResponse, expiresAt time.Time) {
+	if int64(len(resp.body)) > c.maxSize {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+	c.entries[key] = c.lru.PushFront(&httpCacheEntry{key: key, resp: resp, expiresAt: expiresAt})
+	c.size += int64(len(resp.body))
+	for c.size > c.maxSize {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *httpResponseCache) remove(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*httpCacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= int64(len(entry.resp.body))
+}
+
+// fetch returns the cached response for key if there is one. Otherwise, it calls do, or waits for
+// a concurrent call with the same key to finish, and caches a successful response until ttl elapses.
+// Responses to coalesced calls are shared, and must not be modified. A nil cache or a zero ttl
+// disables both caching and coalescing, and do is then called with ctx.
+//
+// A coalesced call outlives the caller which started it, so do is called with a context detached
+// from ctx and bounded by timeout instead. Each caller stops waiting when its own ctx is done.
+func (c *httpResponseCache) fetch(ctx context.Context, key string, ttl time.Duration, timeout time.Duration, dotID string, do func(context.Context) (httpResponse, error)) (resp httpResponse, cached bool, err error) {
+	if c == nil || ttl <= 0 {
+		resp, err = do(ctx)
+		return resp, false, err
+	}
+	if resp, ok := c.get(key, time.Now()); ok {
+		promHTTPCacheHits.WithLabelValues(dotID).Inc()
+		return resp, true, nil
+	}
+	promHTTPCacheMisses.WithLabelValues(dotID).Inc()
+
+	if timeout <= 0 {
+		timeout = defaultHTTPCacheFetchTimeout
+	}
+	ch := c.group.DoChan(key, func() (interface{}, error) {
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+		defer cancel()
+		r, err2 := do(fetchCtx)
+		if err2 == nil {
+			c.set(key, r, time.Now().Add(ttl))
+		}
+		return r, err2
+	})
+	select {
+	case res := <-ch:
+		if res.Shared {
+			promHTTPCacheCoalesced.WithLabelValues(dotID).Inc()
+		}
+		return res.Val.(httpResponse), false, res.Err
+	case <-ctx.Done():
+		return httpResponse{}, false, ctx.Err()
+	}
+}
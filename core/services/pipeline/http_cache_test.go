@@ -0,0 +1,224 @@
+package pipeline
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestHTTPResponseCache_Disabled(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, newHTTPResponseCache(0))
+
+	var c *httpResponseCache
+	calls := 0
+	for i := 0; i < 2; i++ {
+		resp, cached, err := c.fetch(testutils.Context(t), "key", time.Minute, time.Second, "ds1", func(context.Context) (httpResponse, error) {
+			calls++
+			return httpResponse{body: []byte("body")}, nil
+		})
+		require.NoError(t, err)
+		assert.False(t, cached)
+		assert.Equal(t, []byte("body"), resp.body)
+	}
+	assert.Equal(t, 2, calls)
+}
+
+func TestHTTPResponseCache_Fetch(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	c := newHTTPResponseCache(1024)
+	calls := 0
+	do := func(context.Context) (httpResponse, error) {
+		calls++
+		return httpResponse{body: []byte("body"), statusCode: http.StatusOK}, nil
+	}
+
+	resp, cached, err := c.fetch(ctx, "key", time.Minute, time.Second, "ds1", do)
+	require.NoError(t, err)
+	assert.False(t, cached)
+	assert.Equal(t, httpResponse{body: []byte("body"), statusCode: http.StatusOK}, resp)
+
+	resp, cached, err = c.fetch(ctx, "key", time.Minute, time.Second, "ds1", do)
+	require.NoError(t, err)
+	assert.True(t, cached)
+	assert.Equal(t, httpResponse{body: []byte("body"), statusCode: http.StatusOK}, resp)
+	assert.Equal(t, 1, calls)
+
+	// A zero ttl bypasses the cache
+	_, cached, err = c.fetch(ctx, "key", 0, time.Second, "ds1", do)
+	require.NoError(t, err)
+	assert.False(t, cached)
+	assert.Equal(t, 2, calls)
+
+	// Errors are not cached, and keep the status code
+	resp, _, err = c.fetch(ctx, "other", time.Minute, time.Second, "ds1", func(context.Context) (httpResponse, error) {
+		return httpResponse{statusCode: http.StatusTooManyRequests}, errors.New("boom")
+	})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, resp.statusCode)
+	_, ok := c.get("other", time.Now())
+	assert.False(t, ok)
+}
+
+func TestHTTPResponseCache_FetchContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("request outlives the caller which started it", func(t *testing.T) {
+		c := newHTTPResponseCache(1024)
+		leaderCtx, cancelLeader := context.WithCancel(testutils.Context(t))
+		started := make(chan struct{})
+		release := make(chan struct{})
+
+		leaderErr := make(chan error)
+		go func() {
+			_, _, err := c.fetch(leaderCtx, "key", time.Minute, time.Minute, "ds1", func(ctx context.Context) (httpResponse, error) {
+				close(started)
+				select {
+				case <-release:
+				case <-ctx.Done():
+					return httpResponse{}, ctx.Err()
+				}
+				return httpResponse{body: []byte("body"), statusCode: http.StatusOK}, nil
+			})
+			leaderErr <- err
+		}()
+		<-started
+
+		followerResp := make(chan httpResponse)
+		go func() {
+			resp, _, err := c.fetch(testutils.Context(t), "key", time.Minute, time.Minute, "ds1", func(context.Context) (httpResponse, error) {
+				return httpResponse{}, errors.New("should have joined the in flight request")
+			})
+			assert.NoError(t, err)
+			followerResp <- resp
+		}()
+		// Give the follower a chance to join the in flight request
+		time.Sleep(100 * time.Millisecond)
+
+		cancelLeader()
+		require.ErrorIs(t, <-leaderErr, context.Canceled)
+		close(release)
+		assert.Equal(t, httpResponse{body: []byte("body"), statusCode: http.StatusOK}, <-followerResp)
+	})
+
+	t.Run("request is bounded by the timeout", func(t *testing.T) {
+		c := newHTTPResponseCache(1024)
+		_, _, err := c.fetch(testutils.Context(t), "key", time.Minute, 10*time.Millisecond, "ds1", func(ctx context.Context) (httpResponse, error) {
+			<-ctx.Done()
+			return httpResponse{}, ctx.Err()
+		})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestHTTPResponseCache_Expiry(t *testing.T) {
+	t.Parallel()
+
+	c := newHTTPResponseCache(1024)
+	now := time.Now()
+	c.set("key", httpResponse{body: []byte("body")}, now.Add(time.Second))
+
+	_, ok := c.get("key", now)
+	assert.True(t, ok)
+	_, ok = c.get("key", now.Add(time.Second))
+	assert.False(t, ok)
+	assert.Zero(t, c.size)
+	assert.Empty(t, c.entries)
+}
+
+func TestHTTPResponseCache_Eviction(t *testing.T) {
+	t.Parallel()
+
+	c := newHTTPResponseCache(10)
+	now := time.Now()
+	expiresAt := now.Add(time.Minute)
+
+	c.set("a", httpResponse{body: []byte("aaaa")}, expiresAt)
+	c.set("b", httpResponse{body: []byte("bbbb")}, expiresAt)
+	// a is now the most recently used
+	_, ok := c.get("a", now)
+	require.True(t, ok)
+
+	c.set("c", httpResponse{body: []byte("cccc")}, expiresAt)
+	_, ok = c.get("b", now)
+	assert.False(t, ok, "least recently used entry should have been evicted")
+	_, ok = c.get("a", now)
+	assert.True(t, ok)
+	_, ok = c.get("c", now)
+	assert.True(t, ok)
+	assert.Equal(t, int64(8), c.size)
+
+	// Replacing an entry accounts for the size of the previous body
+	c.set("c", httpResponse{body: []byte("cc")}, expiresAt)
+	assert.Equal(t, int64(6), c.size)
+
+	// Bodies larger than the cache are never stored
+	c.set("d", httpResponse{body: []byte("ddddddddddd")}, expiresAt)
+	_, ok = c.get("d", now)
+	assert.False(t, ok)
+	assert.Equal(t, int64(6), c.size)
+}
+
+func TestHTTPResponseCache_Coalescing(t *testing.T) {
+	t.Parallel()
+
+	c := newHTTPResponseCache(1024)
+	var calls atomic.Int32
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	const n = 5
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			resp, _, err := c.fetch(testutils.Context(t), "key", time.Minute, time.Minute, "ds1", func(context.Context) (httpResponse, error) {
+				if calls.Add(1) == 1 {
+					close(started)
+				}
+				<-release
+				return httpResponse{body: []byte("body"), statusCode: http.StatusOK}, nil
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, httpResponse{body: []byte("body"), statusCode: http.StatusOK}, resp)
+		}()
+	}
+
+	<-started
+	// Give the other callers a chance to join the in flight request
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestHTTPCacheKey(t *testing.T) {
+	t.Parallel()
+
+	key := httpCacheKey("GET", "https://example.com", []byte("{}"), []string{"X-Key", "a"}, false)
+	assert.Equal(t, key, httpCacheKey("GET", "https://example.com", []byte("{}"), []string{"X-Key", "a"}, false))
+
+	for _, other := range []string{
+		httpCacheKey("POST", "https://example.com", []byte("{}"), []string{"X-Key", "a"}, false),
+		httpCacheKey("GET", "https://example.org", []byte("{}"), []string{"X-Key", "a"}, false),
+		httpCacheKey("GET", "https://example.com", []byte(`{"a":1}`), []string{"X-Key", "a"}, false),
+		httpCacheKey("GET", "https://example.com", []byte("{}"), []string{"X-Key", "b"}, false),
+		httpCacheKey("GET", "https://example.com", []byte("{}"), []string{"X-Key", "a"}, true),
+	} {
+		assert.NotEqual(t, key, other)
+	}
+}
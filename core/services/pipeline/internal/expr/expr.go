@@ -0,0 +1,564 @@
+// Package expr implements a small, side-effect free language for arithmetic and boolean
+// expressions over decimals.
+//
+// Supported syntax, from lowest to highest precedence:
+//
+//	c ? a : b           conditional
+//	a || b              logical or
+//	a && b              logical and
+//	a == b, a != b      equality of two decimals or two booleans
+//	a < b, a <= b, ...  comparison of decimals
+//	a + b, a - b        addition, subtraction
+//	a * b, a / b, a % b multiplication, division, remainder
+//	-a, !a              negation
+//
+// Operands are decimal literals, true and false, parenthesized expressions, function calls
+// and variables. Variable names are keypaths like "foo" or "foo.bar.0", which are resolved by
+// the caller on evaluation. The functions are min, max, abs, floor, ceil and round(x, places).
+//
+// Programs have no loops and no access to anything but the variables they are given, and their
+// size and nesting are bounded, so that untrusted expressions can be evaluated safely.
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// MaxLength is the maximum length of the source of an expression.
+	MaxLength = 4096
+	// MaxDepth is the maximum nesting depth of an expression.
+	MaxDepth = 64
+	// DivisionPrecision is the number of decimal places the result of a division is rounded to.
+	DivisionPrecision = 18
+
+	maxExponent = 100
+)
+
+var (
+	ErrDivisionByZero = errors.New("division by zero")
+	ErrType           = errors.New("type error")
+)
+
+// Resolver returns the value of a variable, which must be a decimal.Decimal or a bool.
+type Resolver func(name string) (interface{}, error)
+
+// Program is a compiled expression.
+type Program struct {
+	src  string
+	root node
+	vars []string
+}
+
+// Compile parses an expression.
+func Compile(src string) (*Program, error) {
+	if len(src) > MaxLength {
+		return nil, fmt.Errorf("expression is longer than %d characters", MaxLength)
+	}
+	p := &parser{src: src}
+	root, err := p.parse()
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", src, err)
+	}
+	return &Program{src: src, root: root, vars: p.vars}, nil
+}
+
+// String returns the source of the expression.
+func (p *Program) String() string {
+	return p.src
+}
+
+// Variables returns the names of the variables referenced by the expression, in order of first use.
+func (p *Program) Variables() []string {
+	return p.vars
+}
+
+// Eval evaluates the expression, and returns either a decimal.Decimal or a bool.
+func (p *Program) Eval(resolve Resolver) (interface{}, error) {
+	return p.root.eval(resolve)
+}
+
+type node interface {
+	eval(resolve Resolver) (interface{}, error)
+}
+
+type literal struct{ v interface{} }
+
+func (n literal) eval(Resolver) (interface{}, error) {
+	return n.v, nil
+}
+
+type variable string
+
+func (n variable) eval(resolve Resolver) (interface{}, error) {
+	v, err := resolve(string(n))
+	if err != nil {
+		return nil, fmt.Errorf("variable %s: %w", string(n), err)
+	}
+	switch v.(type) {
+	case decimal.Decimal, bool:
+		return v, nil
+	}
+	return nil, fmt.Errorf("%w: variable %s has unsupported type %T", ErrType, string(n), v)
+}
+
+type unary struct {
+	op string
+	x  node
+}
+
+func (n unary) eval(resolve Resolver) (interface{}, error) {
+	v, err := n.x.eval(resolve)
+	if err != nil {
+		return nil, err
+	}
+	if n.op == "!" {
+		b, err := asBool(n.op, v)
+		if err != nil {
+			return nil, err
+		}
+		return !b, nil
+	}
+	d, err := asDecimal(n.op, v)
+	if err != nil {
+		return nil, err
+	}
+	return d.Neg(), nil
+}
+
+type logical struct {
+	op   string
+	l, r node
+}
+
+func (n logical) eval(resolve Resolver) (interface{}, error) {
+	lv, err := n.l.eval(resolve)
+	if err != nil {
+		return nil, err
+	}
+	l, err := asBool(n.op, lv)
+	if err != nil {
+		return nil, err
+	}
+	// Short-circuit, so that the right side can be guarded, e.g. "b != 0 && a / b > 1".
+	if (n.op == "&&" && !l) || (n.op == "||" && l) {
+		return l, nil
+	}
+	rv, err := n.r.eval(resolve)
+	if err != nil {
+		return nil, err
+	}
+	return asBool(n.op, rv)
+}
+
+type conditional struct {
+	cond, then, otherwise node
+}
+
+func (n conditional) eval(resolve Resolver) (interface{}, error) {
+	cv, err := n.cond.eval(resolve)
+	if err != nil {
+		return nil, err
+	}
+	c, err := asBool("?", cv)
+	if err != nil {
+		return nil, err
+	}
+	if c {
+		return n.then.eval(resolve)
+	}
+	return n.otherwise.eval(resolve)
+}
+
+type binary struct {
+	op   string
+	l, r node
+}
+
+func (n binary) eval(resolve Resolver) (interface{}, error) {
+	lv, err := n.l.eval(resolve)
+	if err != nil {
+		return nil, err
+	}
+	rv, err := n.r.eval(resolve)
+	if err != nil {
+		return nil, err
+	}
+
+	if n.op == "==" || n.op == "!=" {
+		var eq bool
+		switch l := lv.(type) {
+		case bool:
+			r, ok := rv.(bool)
+			if !ok {
+				return nil, fmt.Errorf("%w: cannot compare bool and %s", ErrType, typeName(rv))
+			}
+			eq = l == r
+		case decimal.Decimal:
+			r, ok := rv.(decimal.Decimal)
+			if !ok {
+				return nil, fmt.Errorf("%w: cannot compare decimal and %s", ErrType, typeName(rv))
+			}
+			eq = l.Equal(r)
+		}
+		return eq == (n.op == "=="), nil
+	}
+
+	l, err := asDecimal(n.op, lv)
+	if err != nil {
+		return nil, err
+	}
+	r, err := asDecimal(n.op, rv)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "+":
+		return l.Add(r), nil
+	case "-":
+		return l.Sub(r), nil
+	case "*":
+		return l.Mul(r), nil
+	case "/":
+		if r.IsZero() {
+			return nil, ErrDivisionByZero
+		}
+		return l.DivRound(r, DivisionPrecision), nil
+	case "%":
+		if r.IsZero() {
+			return nil, ErrDivisionByZero
+		}
+		return l.Mod(r), nil
+	case "<":
+		return l.LessThan(r), nil
+	case "<=":
+		return l.LessThanOrEqual(r), nil
+	case ">":
+		return l.GreaterThan(r), nil
+	case ">=":
+		return l.GreaterThanOrEqual(r), nil
+	}
+	return nil, fmt.Errorf("unknown operator %s", n.op)
+}
+
+type call struct {
+	fn   string
+	args []node
+}
+
+// functions maps the function names to their min and max number of arguments.
+var functions = map[string][2]int{
+	"min":   {1, -1},
+	"max":   {1, -1},
+	"abs":   {1, 1},
+	"floor": {1, 1},
+	"ceil":  {1, 1},
+	"round": {1, 2},
+}
+
+func (n call) eval(resolve Resolver) (interface{}, error) {
+	args := make([]decimal.Decimal, len(n.args))
+	for i, a := range n.args {
+		v, err := a.eval(resolve)
+		if err != nil {
+			return nil, err
+		}
+		if args[i], err = asDecimal(n.fn, v); err != nil {
+			return nil, err
+		}
+	}
+	switch n.fn {
+	case "min":
+		return decimal.Min(args[0], args[1:]...), nil
+	case "max":
+		return decimal.Max(args[0], args[1:]...), nil
+	case "abs":
+		return args[0].Abs(), nil
+	case "floor":
+		return args[0].Floor(), nil
+	case "ceil":
+		return args[0].Ceil(), nil
+	case "round":
+		var places int32
+		if len(args) == 2 {
+			if !args[1].IsInteger() || args[1].Abs().GreaterThan(decimal.NewFromInt(DivisionPrecision)) {
+				return nil, fmt.Errorf("round: places must be an integer between -%d and %d", DivisionPrecision, DivisionPrecision)
+			}
+			places = int32(args[1].IntPart())
+		}
+		return args[0].Round(places), nil
+	}
+	return nil, fmt.Errorf("unknown function %s", n.fn)
+}
+
+func asDecimal(op string, v interface{}) (decimal.Decimal, error) {
+	d, ok := v.(decimal.Decimal)
+	if !ok {
+		return decimal.Decimal{}, fmt.Errorf("%w: %s expects a decimal, got %s", ErrType, op, typeName(v))
+	}
+	return d, nil
+}
+
+func asBool(op string, v interface{}) (bool, error) {
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("%w: %s expects a bool, got %s", ErrType, op, typeName(v))
+	}
+	return b, nil
+}
+
+func typeName(v interface{}) string {
+	switch v.(type) {
+	case bool:
+		return "bool"
+	case decimal.Decimal:
+		return "decimal"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+type parser struct {
+	src   string
+	pos   int
+	depth int
+	vars  []string
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("at offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.src) && unicode.IsSpace(rune(p.src[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *parser) peek() byte {
+	p.skipSpace()
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+// consume skips the operator if it is next, and is not the prefix of a longer one.
+func (p *parser) consume(op string) bool {
+	p.skipSpace()
+	if !strings.HasPrefix(p.src[p.pos:], op) {
+		return false
+	}
+	if next := p.pos + len(op); next < len(p.src) && len(op) == 1 && strings.IndexByte("<>=!", op[0]) >= 0 && p.src[next] == '=' {
+		return false
+	}
+	p.pos += len(op)
+	return true
+}
+
+func (p *parser) parse() (node, error) {
+	n, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.src) {
+		return nil, p.errorf("unexpected %q", p.src[p.pos])
+	}
+	return n, nil
+}
+
+func (p *parser) parseExpr() (node, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > MaxDepth {
+		return nil, p.errorf("expression is nested deeper than %d", MaxDepth)
+	}
+
+	cond, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+	if !p.consume("?") {
+		return cond, nil
+	}
+	then, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.consume(":") {
+		return nil, p.errorf("expected :")
+	}
+	otherwise, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	return conditional{cond, then, otherwise}, nil
+}
+
+// precedence lists the binary operators from lowest to highest precedence. Longer operators
+// come first, so that e.g. "<=" is not parsed as "<".
+var precedence = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<=", ">=", "<", ">"},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *parser) parseBinary(level int) (node, error) {
+	if level == len(precedence) {
+		return p.parseUnary()
+	}
+	left, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := ""
+		for _, o := range precedence[level] {
+			if p.consume(o) {
+				op = o
+				break
+			}
+		}
+		if op == "" {
+			return left, nil
+		}
+		right, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		if op == "&&" || op == "||" {
+			left = logical{op, left, right}
+		} else {
+			left = binary{op, left, right}
+		}
+	}
+}
+
+func (p *parser) parseUnary() (node, error) {
+	for _, op := range []string{"-", "!"} {
+		if p.consume(op) {
+			p.depth++
+			defer func() { p.depth-- }()
+			if p.depth > MaxDepth {
+				return nil, p.errorf("expression is nested deeper than %d", MaxDepth)
+			}
+			x, err := p.parseUnary()
+			if err != nil {
+				return nil, err
+			}
+			return unary{op, x}, nil
+		}
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	c := p.peek()
+	switch {
+	case c == 0:
+		return nil, p.errorf("unexpected end of expression")
+	case c == '(':
+		p.pos++
+		n, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume(")") {
+			return nil, p.errorf("expected )")
+		}
+		return n, nil
+	case c == '.' || (c >= '0' && c <= '9'):
+		start := p.pos
+		for p.pos < len(p.src) && (p.src[p.pos] == '.' || (p.src[p.pos] >= '0' && p.src[p.pos] <= '9')) {
+			p.pos++
+		}
+		// Exponents, e.g. 1e18
+		if p.pos < len(p.src) && (p.src[p.pos] == 'e' || p.src[p.pos] == 'E') {
+			p.pos++
+			if p.pos < len(p.src) && (p.src[p.pos] == '-' || p.src[p.pos] == '+') {
+				p.pos++
+			}
+			for p.pos < len(p.src) && p.src[p.pos] >= '0' && p.src[p.pos] <= '9' {
+				p.pos++
+			}
+		}
+		d, err := decimal.NewFromString(p.src[start:p.pos])
+		if err != nil {
+			return nil, p.errorf("invalid number %q", p.src[start:p.pos])
+		}
+		// Rescaling a decimal with a huge exponent is expensive.
+		if e := d.Exponent(); e > maxExponent || e < -maxExponent {
+			return nil, p.errorf("number %q is out of range", p.src[start:p.pos])
+		}
+		return literal{d}, nil
+	case c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+		start := p.pos
+		for p.pos < len(p.src) && isNameChar(p.src[p.pos]) {
+			p.pos++
+		}
+		name := p.src[start:p.pos]
+		switch name {
+		case "true":
+			return literal{true}, nil
+		case "false":
+			return literal{false}, nil
+		}
+		if p.consume("(") {
+			return p.parseCall(name)
+		}
+		if strings.HasPrefix(name, ".") || strings.HasSuffix(name, ".") || strings.Contains(name, "..") {
+			return nil, p.errorf("invalid variable name %q", name)
+		}
+		p.addVar(name)
+		return variable(name), nil
+	}
+	return nil, p.errorf("unexpected %q", c)
+}
+
+func (p *parser) parseCall(fn string) (node, error) {
+	arity, ok := functions[fn]
+	if !ok {
+		return nil, p.errorf("unknown function %q", fn)
+	}
+	var args []node
+	if !p.consume(")") {
+		for {
+			arg, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if p.consume(")") {
+				break
+			}
+			if !p.consume(",") {
+				return nil, p.errorf("expected , or )")
+			}
+		}
+	}
+	if len(args) < arity[0] || (arity[1] >= 0 && len(args) > arity[1]) {
+		return nil, p.errorf("wrong number of arguments for %s: %d", fn, len(args))
+	}
+	return call{fn, args}, nil
+}
+
+func (p *parser) addVar(name string) {
+	for _, v := range p.vars {
+		if v == name {
+			return
+		}
+	}
+	p.vars = append(p.vars, name)
+}
+
+func isNameChar(c byte) bool {
+	return c == '_' || c == '.' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,50 @@
+//go:build go1.18
+
+package expr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func FuzzCompile(f *testing.F) {
+	f.Add("1 + 2 * 3")
+	f.Add("-inputs.1 - 1")
+	f.Add("1 / 3")
+	f.Add("price * 1e18")
+	f.Add("round(price, 2)")
+	f.Add("abs(inputs.1) + min(inputs.0, inputs.1, 10) + max(1, 2)")
+	f.Add("price > 1000 && ok")
+	f.Add("price <= 1000 || !ok")
+	f.Add("price < 1000 ? 1 : inputs.0 > 0 ? 2 : 3")
+	f.Add("1 / zero")
+	f.Add("5 % 0")
+	f.Add("1 + true")
+	f.Add("round(1, 19)")
+	f.Add("1e1000")
+	f.Add("a..b")
+	f.Fuzz(func(t *testing.T, src string) {
+		p, err := Compile(src)
+		if err != nil {
+			return
+		}
+		// Variables starting with a letter up to m are decimals, the others bools.
+		_, err = p.Eval(func(name string) (interface{}, error) {
+			if name == "missing" {
+				return nil, errors.New("not found")
+			}
+			if name[0] <= 'm' {
+				return decimal.NewFromInt(int64(len(name))), nil
+			}
+			return len(name)%2 == 0, nil
+		})
+		if err != nil {
+			return
+		}
+		if p.String() != src {
+			t.Errorf("String() = %q, want %q", p.String(), src)
+		}
+	})
+}
@@ -0,0 +1,125 @@
+package expr
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgram_Eval(t *testing.T) {
+	t.Parallel()
+
+	vars := map[string]interface{}{
+		"price":          decimal.RequireFromString("1234.5678"),
+		"jobRun.meta.ok": true,
+		"inputs.0":       decimal.NewFromInt(3),
+		"inputs.1":       decimal.NewFromInt(-4),
+		"zero":           decimal.Zero,
+	}
+	resolve := func(name string) (interface{}, error) {
+		v, ok := vars[name]
+		if !ok {
+			return nil, errors.New("not found")
+		}
+		return v, nil
+	}
+
+	tests := []struct {
+		expr string
+		want interface{}
+	}{
+		{"1 + 2 * 3", "7"},
+		{"(1 + 2) * 3", "9"},
+		{"-inputs.1 - 1", "3"},
+		{"10 / 4", "2.5"},
+		{"1 / 3", "0.333333333333333333"},
+		{"10 % 4", "2"},
+		{"price * 1e18", "1234567800000000000000"},
+		{"round(price, 2)", "1234.57"},
+		{"floor(price) + ceil(0.1)", "1235"},
+		{"abs(inputs.1) + min(inputs.0, inputs.1, 10) + max(1, 2)", "2"},
+		{"price > 1000 && jobRun.meta.ok", true},
+		{"price <= 1000 || !jobRun.meta.ok", false},
+		{"inputs.0 == 3 && inputs.0 != 4 && jobRun.meta.ok == true", true},
+		{"zero != 0 && 1 / zero > 1", false},
+		{"price > 1000 ? 1 : 0", "1"},
+		{"price < 1000 ? 1 : inputs.0 > 0 ? 2 : 3", "2"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.expr, func(t *testing.T) {
+			p, err := Compile(tt.expr)
+			require.NoError(t, err)
+			got, err := p.Eval(resolve)
+			require.NoError(t, err)
+			if s, ok := tt.want.(string); ok {
+				require.IsType(t, decimal.Decimal{}, got)
+				assert.Equal(t, s, got.(decimal.Decimal).String())
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		for expr, want := range map[string]error{
+			"1 / zero":      ErrDivisionByZero,
+			"5 % 0":         ErrDivisionByZero,
+			"1 + true":      ErrType,
+			"!price":        ErrType,
+			"price ? 1 : 2": ErrType,
+			"price == true": ErrType,
+		} {
+			p, err := Compile(expr)
+			require.NoError(t, err, expr)
+			_, err = p.Eval(resolve)
+			assert.ErrorIs(t, err, want, expr)
+		}
+
+		p, err := Compile("missing + 1")
+		require.NoError(t, err)
+		_, err = p.Eval(resolve)
+		assert.ErrorContains(t, err, "variable missing: not found")
+	})
+}
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	p, err := Compile("a.b + max(c, a.b) > d ? e : 0")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.b", "c", "d", "e"}, p.Variables())
+
+	for _, tt := range []struct {
+		expr string
+		err  string
+	}{
+		{"", "at offset 0: unexpected end of expression"},
+		{"1 +", "at offset 3: unexpected end of expression"},
+		{"(1 + 2", "at offset 6: expected )"},
+		{"1 2", `at offset 2: unexpected '2'`},
+		{"a..b", `at offset 4: invalid variable name "a..b"`},
+		{"a.", `at offset 2: invalid variable name "a."`},
+		{"exec(1)", `at offset 5: unknown function "exec"`},
+		{"abs(1, 2)", "at offset 9: wrong number of arguments for abs: 2"},
+		{"round()", "at offset 7: wrong number of arguments for round: 0"},
+		{"max(1 2)", "at offset 6: expected , or )"},
+		{"1 ? 2", "at offset 5: expected :"},
+		{"a = 1", `at offset 2: unexpected '='`},
+		{"#", `at offset 0: unexpected '#'`},
+		{"1.2.3", `at offset 5: invalid number "1.2.3"`},
+		{"1e1000", `at offset 6: number "1e1000" is out of range`},
+		{"1e-101", `at offset 6: number "1e-101" is out of range`},
+		{strings.Repeat("(", MaxDepth+1) + "1" + strings.Repeat(")", MaxDepth+1), fmt.Sprintf("expression is nested deeper than %d", MaxDepth)},
+		{strings.Repeat("-", MaxDepth+1) + "1", fmt.Sprintf("expression is nested deeper than %d", MaxDepth)},
+		{strings.Repeat("1+", MaxLength) + "1", fmt.Sprintf("expression is longer than %d characters", MaxLength)},
+	} {
+		_, err := Compile(tt.expr)
+		assert.ErrorContains(t, err, tt.err, tt.expr)
+	}
+}
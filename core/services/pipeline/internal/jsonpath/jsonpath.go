@@ -0,0 +1,772 @@
+// Package jsonpath implements JSONPath queries over values decoded with encoding/json.
+//
+// Supported syntax:
+//
+//	$                   the root value
+//	.name, ['name']     object member, several names can be selected with ['a','b']
+//	.*, [*]             all members of an object or elements of an array
+//	[0], [-1], [0,2]    array elements, negative indexes count from the end
+//	[start:end:step]    array slices, all parts are optional
+//	..name, ..*, ..[0]  recursive descent
+//	[?(<filter>)]       elements for which the filter holds, e.g. [?(@.price > 10 && @.currency == 'USD')]
+//
+// Filters support the current (@) and root ($) values followed by a path, number, string,
+// true, false and null literals, the comparison operators == != < <= > >=, the logical
+// operators && || ! and parentheses. A path on its own tests for existence.
+//
+// Object members are visited in key order, so that results are deterministic.
+package jsonpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/shopspring/decimal"
+)
+
+// Path is a compiled JSONPath query.
+type Path struct {
+	src      string
+	segments []segment
+}
+
+// Compile parses a JSONPath query.
+func Compile(src string) (*Path, error) {
+	p := &parser{src: src}
+	segments, err := p.parsePath()
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSONPath %q: %w", src, err)
+	}
+	return &Path{src: src, segments: segments}, nil
+}
+
+// String returns the source of the query.
+func (p *Path) String() string {
+	return p.src
+}
+
+// Definite returns true if the query can select at most one value, i.e. it only consists of
+// names and indexes.
+func (p *Path) Definite() bool {
+	for _, s := range p.segments {
+		if s.recursive || len(s.selectors) != 1 {
+			return false
+		}
+		switch s.selectors[0].(type) {
+		case nameSelector, indexSelector:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Get returns all values selected by the query, in document order.
+func (p *Path) Get(data interface{}) []interface{} {
+	return evalSegments(p.segments, data, data)
+}
+
+func evalSegments(segments []segment, current, root interface{}) []interface{} {
+	nodes := []interface{}{current}
+	for _, s := range segments {
+		var next []interface{}
+		for _, node := range nodes {
+			if s.recursive {
+				for _, d := range descendants(node, nil) {
+					next = s.apply(d, root, next)
+				}
+			} else {
+				next = s.apply(node, root, next)
+			}
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+// descendants returns node and all the values nested in it, depth first.
+func descendants(node interface{}, out []interface{}) []interface{} {
+	out = append(out, node)
+	switch v := node.(type) {
+	case map[string]interface{}:
+		for _, k := range sortedKeys(v) {
+			out = descendants(v[k], out)
+		}
+	case []interface{}:
+		for _, e := range v {
+			out = descendants(e, out)
+		}
+	}
+	return out
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+type segment struct {
+	recursive bool
+	selectors []selector
+}
+
+func (s segment) apply(node, root interface{}, out []interface{}) []interface{} {
+	for _, sel := range s.selectors {
+		out = sel.apply(node, root, out)
+	}
+	return out
+}
+
+type selector interface {
+	apply(node, root interface{}, out []interface{}) []interface{}
+}
+
+type nameSelector string
+
+func (s nameSelector) apply(node, _ interface{}, out []interface{}) []interface{} {
+	if m, ok := node.(map[string]interface{}); ok {
+		if v, exists := m[string(s)]; exists {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+type wildcardSelector struct{}
+
+func (wildcardSelector) apply(node, _ interface{}, out []interface{}) []interface{} {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		for _, k := range sortedKeys(v) {
+			out = append(out, v[k])
+		}
+	case []interface{}:
+		out = append(out, v...)
+	}
+	return out
+}
+
+type indexSelector int
+
+func (s indexSelector) apply(node, _ interface{}, out []interface{}) []interface{} {
+	if a, ok := node.([]interface{}); ok {
+		i := int(s)
+		if i < 0 {
+			i += len(a)
+		}
+		if i >= 0 && i < len(a) {
+			out = append(out, a[i])
+		}
+	}
+	return out
+}
+
+type sliceSelector struct {
+	start, end *int
+	step       int
+}
+
+func (s sliceSelector) apply(node, _ interface{}, out []interface{}) []interface{} {
+	a, ok := node.([]interface{})
+	if !ok || s.step == 0 {
+		return out
+	}
+	n := len(a)
+	normalize := func(i int) int {
+		if i < 0 {
+			return i + n
+		}
+		return i
+	}
+	if s.step > 0 {
+		start, end := 0, n
+		if s.start != nil {
+			start = max(normalize(*s.start), 0)
+		}
+		if s.end != nil {
+			end = min(normalize(*s.end), n)
+		}
+		for i := start; i < end; i += s.step {
+			out = append(out, a[i])
+			// Stop before i += s.step overflows.
+			if s.step >= end-i {
+				break
+			}
+		}
+		return out
+	}
+	start, end := n-1, -1
+	if s.start != nil {
+		start = min(normalize(*s.start), n-1)
+	}
+	if s.end != nil {
+		end = max(normalize(*s.end), -1)
+	}
+	for i := start; i > end; i += s.step {
+		out = append(out, a[i])
+		if s.step <= end-i {
+			break
+		}
+	}
+	return out
+}
+
+type filterSelector struct {
+	filter filterExpr
+}
+
+func (s filterSelector) apply(node, root interface{}, out []interface{}) []interface{} {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		for _, k := range sortedKeys(v) {
+			if s.filter.test(v[k], root) {
+				out = append(out, v[k])
+			}
+		}
+	case []interface{}:
+		for _, e := range v {
+			if s.filter.test(e, root) {
+				out = append(out, e)
+			}
+		}
+	}
+	return out
+}
+
+type filterExpr interface {
+	test(current, root interface{}) bool
+}
+
+type orExpr struct{ left, right filterExpr }
+
+func (e orExpr) test(current, root interface{}) bool {
+	return e.left.test(current, root) || e.right.test(current, root)
+}
+
+type andExpr struct{ left, right filterExpr }
+
+func (e andExpr) test(current, root interface{}) bool {
+	return e.left.test(current, root) && e.right.test(current, root)
+}
+
+type notExpr struct{ expr filterExpr }
+
+func (e notExpr) test(current, root interface{}) bool {
+	return !e.expr.test(current, root)
+}
+
+// literalExpr holds if the literal is true.
+type literalExpr struct{ v interface{} }
+
+func (e literalExpr) test(_, _ interface{}) bool {
+	b, ok := e.v.(bool)
+	return ok && b
+}
+
+type compareExpr struct {
+	op          string
+	left, right operand
+}
+
+func (e compareExpr) test(current, root interface{}) bool {
+	l, lok := e.left.value(current, root)
+	r, rok := e.right.value(current, root)
+	if !lok || !rok {
+		// A missing value is only equal to another missing value.
+		switch e.op {
+		case "==", "<=", ">=":
+			return !lok && !rok
+		case "!=":
+			return lok != rok
+		}
+		return false
+	}
+	switch e.op {
+	case "==":
+		return equal(l, r)
+	case "!=":
+		return !equal(l, r)
+	}
+	c, ok := compare(l, r)
+	if !ok {
+		return false
+	}
+	switch e.op {
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
+
+func equal(l, r interface{}) bool {
+	if c, ok := compare(l, r); ok {
+		return c == 0
+	}
+	switch lv := l.(type) {
+	case bool:
+		rv, ok := r.(bool)
+		return ok && lv == rv
+	case nil:
+		return r == nil
+	}
+	return false
+}
+
+// compare orders numbers and strings, and returns false for any other pair of values.
+func compare(l, r interface{}) (int, bool) {
+	if ld, ok := toDecimal(l); ok {
+		if rd, ok := toDecimal(r); ok {
+			return ld.Cmp(rd), true
+		}
+		return 0, false
+	}
+	if ls, ok := l.(string); ok {
+		if rs, ok := r.(string); ok {
+			return strings.Compare(ls, rs), true
+		}
+	}
+	return 0, false
+}
+
+func toDecimal(v interface{}) (decimal.Decimal, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		d, err := decimal.NewFromString(n.String())
+		return d, err == nil
+	case decimal.Decimal:
+		return n, true
+	case float64:
+		return decimal.NewFromFloat(n), true
+	case float32:
+		return decimal.NewFromFloat32(n), true
+	case int:
+		return decimal.NewFromInt(int64(n)), true
+	case int64:
+		return decimal.NewFromInt(n), true
+	case int32:
+		return decimal.NewFromInt32(n), true
+	}
+	return decimal.Decimal{}, false
+}
+
+type operand interface {
+	// value returns the value of the operand, or false if a path doesn't select exactly one value.
+	value(current, root interface{}) (interface{}, bool)
+}
+
+type literalOperand struct{ v interface{} }
+
+func (o literalOperand) value(_, _ interface{}) (interface{}, bool) {
+	return o.v, true
+}
+
+type pathOperand struct {
+	absolute bool
+	segments []segment
+}
+
+func (o pathOperand) value(current, root interface{}) (interface{}, bool) {
+	start := current
+	if o.absolute {
+		start = root
+	}
+	nodes := evalSegments(o.segments, start, root)
+	if len(nodes) != 1 {
+		return nil, false
+	}
+	return nodes[0], true
+}
+
+// exists is used instead of value for existence tests, where any number of selected values is fine.
+func (o pathOperand) exists(current, root interface{}) bool {
+	start := current
+	if o.absolute {
+		start = root
+	}
+	return len(evalSegments(o.segments, start, root)) > 0
+}
+
+const maxFilterDepth = 64
+
+type parser struct {
+	src   string
+	pos   int
+	depth int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("at offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *parser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *parser) skipSpace() {
+	for !p.eof() && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t' || p.src[p.pos] == '\n' || p.src[p.pos] == '\r') {
+		p.pos++
+	}
+}
+
+func (p *parser) consume(s string) bool {
+	if strings.HasPrefix(p.src[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *parser) parsePath() ([]segment, error) {
+	p.skipSpace()
+	if !p.consume("$") {
+		return nil, p.errorf("path must start with $")
+	}
+	segments, err := p.parseSegments()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if !p.eof() {
+		return nil, p.errorf("unexpected %q", p.peek())
+	}
+	return segments, nil
+}
+
+// parseSegments parses segments until a character which can't start a segment.
+func (p *parser) parseSegments() ([]segment, error) {
+	var segments []segment
+	for {
+		switch {
+		case p.consume(".."):
+			s, err := p.parseSegmentAfterDot()
+			if err != nil {
+				return nil, err
+			}
+			s.recursive = true
+			segments = append(segments, s)
+		case p.consume("."):
+			s, err := p.parseSegmentAfterDot()
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, s)
+		case p.peek() == '[':
+			s, err := p.parseBracket()
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, s)
+		default:
+			return segments, nil
+		}
+	}
+}
+
+func (p *parser) parseSegmentAfterDot() (segment, error) {
+	if p.consume("*") {
+		return segment{selectors: []selector{wildcardSelector{}}}, nil
+	}
+	if p.peek() == '[' {
+		return p.parseBracket()
+	}
+	start := p.pos
+	for !p.eof() {
+		r := rune(p.src[p.pos])
+		if r == '_' || r == '-' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r) || r >= 0x80 {
+			p.pos++
+			continue
+		}
+		break
+	}
+	if start == p.pos {
+		return segment{}, p.errorf("expected a member name")
+	}
+	return segment{selectors: []selector{nameSelector(p.src[start:p.pos])}}, nil
+}
+
+func (p *parser) parseBracket() (segment, error) {
+	p.pos++ // [
+	p.skipSpace()
+	var s segment
+	switch {
+	case p.consume("*"):
+		s.selectors = []selector{wildcardSelector{}}
+	case p.consume("?"):
+		p.skipSpace()
+		f, err := p.parseFilter()
+		if err != nil {
+			return s, err
+		}
+		s.selectors = []selector{filterSelector{f}}
+	default:
+		for {
+			p.skipSpace()
+			sel, err := p.parseUnionMember()
+			if err != nil {
+				return s, err
+			}
+			s.selectors = append(s.selectors, sel)
+			p.skipSpace()
+			if !p.consume(",") {
+				break
+			}
+		}
+	}
+	p.skipSpace()
+	if !p.consume("]") {
+		return s, p.errorf("expected ]")
+	}
+	return s, nil
+}
+
+func (p *parser) parseUnionMember() (selector, error) {
+	if c := p.peek(); c == '\'' || c == '"' {
+		name, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		return nameSelector(name), nil
+	}
+	var parts [3]*int
+	i := 0
+	for {
+		p.skipSpace()
+		if c := p.peek(); c == '-' || (c >= '0' && c <= '9') {
+			n, err := p.parseInt()
+			if err != nil {
+				return nil, err
+			}
+			parts[i] = &n
+		}
+		p.skipSpace()
+		if i < 2 && p.consume(":") {
+			i++
+			continue
+		}
+		break
+	}
+	if i == 0 {
+		if parts[0] == nil {
+			return nil, p.errorf("expected a name, an index or a slice")
+		}
+		return indexSelector(*parts[0]), nil
+	}
+	step := 1
+	if parts[2] != nil {
+		step = *parts[2]
+		if step == 0 {
+			return nil, p.errorf("slice step must not be zero")
+		}
+	}
+	return sliceSelector{start: parts[0], end: parts[1], step: step}, nil
+}
+
+func (p *parser) parseInt() (int, error) {
+	start := p.pos
+	if p.peek() == '-' {
+		p.pos++
+	}
+	for !p.eof() && p.src[p.pos] >= '0' && p.src[p.pos] <= '9' {
+		p.pos++
+	}
+	n, err := strconv.Atoi(p.src[start:p.pos])
+	if err != nil {
+		return 0, p.errorf("invalid integer %q", p.src[start:p.pos])
+	}
+	return n, nil
+}
+
+func (p *parser) parseString() (string, error) {
+	quote := p.src[p.pos]
+	p.pos++
+	var sb strings.Builder
+	for !p.eof() {
+		c := p.src[p.pos]
+		switch {
+		case c == quote:
+			p.pos++
+			return sb.String(), nil
+		case c == '\\' && p.pos+1 < len(p.src):
+			p.pos++
+			switch e := p.src[p.pos]; e {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			default:
+				sb.WriteByte(e)
+			}
+		default:
+			sb.WriteByte(c)
+		}
+		p.pos++
+	}
+	return "", p.errorf("unterminated string")
+}
+
+func (p *parser) parseFilter() (filterExpr, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxFilterDepth {
+		return nil, p.errorf("filter is nested too deeply")
+	}
+	return p.parseOr()
+}
+
+func (p *parser) parseOr() (filterExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpace()
+		if !p.consume("||") {
+			return left, nil
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orExpr{left, right}
+	}
+}
+
+func (p *parser) parseAnd() (filterExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpace()
+		if !p.consume("&&") {
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andExpr{left, right}
+	}
+}
+
+func (p *parser) parseUnary() (filterExpr, error) {
+	p.skipSpace()
+	if p.peek() == '!' && !strings.HasPrefix(p.src[p.pos:], "!=") {
+		p.pos++
+		p.depth++
+		defer func() { p.depth-- }()
+		if p.depth > maxFilterDepth {
+			return nil, p.errorf("filter is nested too deeply")
+		}
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{expr}, nil
+	}
+	if p.consume("(") {
+		expr, err := p.parseFilter()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if !p.consume(")") {
+			return nil, p.errorf("expected )")
+		}
+		return expr, nil
+	}
+	return p.parseComparison()
+}
+
+var comparisonOperators = []string{"==", "!=", "<=", ">=", "<", ">"}
+
+func (p *parser) parseComparison() (filterExpr, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	for _, op := range comparisonOperators {
+		if p.consume(op) {
+			p.skipSpace()
+			right, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			return compareExpr{op: op, left: left, right: right}, nil
+		}
+	}
+	if path, ok := left.(pathOperand); ok {
+		return existsExpr{path}, nil
+	}
+	return literalExpr{left.(literalOperand).v}, nil
+}
+
+// existsExpr holds if the path selects at least one value.
+type existsExpr struct{ path pathOperand }
+
+func (e existsExpr) test(current, root interface{}) bool {
+	return e.path.exists(current, root)
+}
+
+func (p *parser) parseOperand() (operand, error) {
+	p.skipSpace()
+	c := p.peek()
+	switch {
+	case c == '@' || c == '$':
+		p.pos++
+		segments, err := p.parseSegments()
+		if err != nil {
+			return nil, err
+		}
+		return pathOperand{absolute: c == '$', segments: segments}, nil
+	case c == '\'' || c == '"':
+		s, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		return literalOperand{s}, nil
+	case c == '-' || (c >= '0' && c <= '9'):
+		start := p.pos
+		p.pos++
+		for !p.eof() && strings.IndexByte("0123456789.eE+-", p.src[p.pos]) >= 0 {
+			p.pos++
+		}
+		d, err := decimal.NewFromString(p.src[start:p.pos])
+		if err != nil {
+			return nil, p.errorf("invalid number %q", p.src[start:p.pos])
+		}
+		return literalOperand{d}, nil
+	case p.consume("true"):
+		return literalOperand{true}, nil
+	case p.consume("false"):
+		return literalOperand{false}, nil
+	case p.consume("null"):
+		return literalOperand{nil}, nil
+	}
+	if p.eof() {
+		return nil, p.errorf("unexpected end of filter")
+	}
+	return nil, p.errorf("unexpected %q in filter", c)
+}
@@ -0,0 +1,34 @@
+//go:build go1.18
+
+package jsonpath
+
+import (
+	"testing"
+)
+
+func FuzzCompile(f *testing.F) {
+	f.Add("$.expensive")
+	f.Add("$['store']['bicycle'].color")
+	f.Add("$.store.book[-1].author")
+	f.Add("$.store.book[0,2].title")
+	f.Add("$.store.book[::-2].title")
+	f.Add("$.store.book[1::9223372036854775807]")
+	f.Add("$..author")
+	f.Add("$.store.*.color")
+	f.Add("$.store.book[?(@.price > $.expensive && @.category == 'fiction')].title")
+	f.Add(`$.store.book[?(@.author == "Nigel Rees" || (@.price >= 20 && @.price <= 30))].title`)
+	f.Add("$..[?(!@.isbn)]")
+	f.Add("$.store[?(@.price > 1]")
+	f.Add("$.store['book]")
+	f.Fuzz(func(t *testing.T, src string) {
+		p, err := Compile(src)
+		if err != nil {
+			return
+		}
+		data := decode(t, store)
+		selected := p.Get(data)
+		if p.Definite() && len(selected) > 1 {
+			t.Errorf("definite path %q selected %d values", src, len(selected))
+		}
+	})
+}
@@ -0,0 +1,114 @@
+package jsonpath
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const store = `{
+	"store": {
+		"book": [
+			{"category": "reference", "author": "Nigel Rees", "title": "Sayings of the Century", "price": 8.95},
+			{"category": "fiction", "author": "Evelyn Waugh", "title": "Sword of Honour", "price": 12.99},
+			{"category": "fiction", "author": "Herman Melville", "title": "Moby Dick", "isbn": "0-553-21311-3", "price": 8.99},
+			{"category": "fiction", "author": "J. R. R. Tolkien", "title": "The Lord of the Rings", "isbn": "0-395-19395-8", "price": 22.99}
+		],
+		"bicycle": {"color": "red", "price": 19.95}
+	},
+	"expensive": 10
+}`
+
+func decode(t *testing.T, s string) interface{} {
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader([]byte(s)))
+	d.UseNumber()
+	require.NoError(t, d.Decode(&v))
+	return v
+}
+
+func TestPath_Get(t *testing.T) {
+	t.Parallel()
+
+	data := decode(t, store)
+	tests := []struct {
+		path     string
+		definite bool
+		want     []interface{}
+	}{
+		{"$.expensive", true, []interface{}{json.Number("10")}},
+		{"$['store']['bicycle'].color", true, []interface{}{"red"}},
+		{"$.store.book[-1].author", true, []interface{}{"J. R. R. Tolkien"}},
+		{"$.store.book[7].author", true, nil},
+		{"$.store.book[*].author", false, []interface{}{"Nigel Rees", "Evelyn Waugh", "Herman Melville", "J. R. R. Tolkien"}},
+		{"$.store.book[0,2].title", false, []interface{}{"Sayings of the Century", "Moby Dick"}},
+		{"$.store.book[1:3].title", false, []interface{}{"Sword of Honour", "Moby Dick"}},
+		{"$.store.book[::-2].title", false, []interface{}{"The Lord of the Rings", "Sword of Honour"}},
+		{"$.store.book[:-3].title", false, []interface{}{"Sayings of the Century"}},
+		{"$..author", false, []interface{}{"Nigel Rees", "Evelyn Waugh", "Herman Melville", "J. R. R. Tolkien"}},
+		{"$.store.*.color", false, []interface{}{"red"}},
+		{"$.store.bicycle['color','price']", false, []interface{}{"red", json.Number("19.95")}},
+		{"$.store.book[?(@.isbn)].title", false, []interface{}{"Moby Dick", "The Lord of the Rings"}},
+		{"$.store.book[?(!@.isbn)].title", false, []interface{}{"Sayings of the Century", "Sword of Honour"}},
+		{"$.store.book[?(@.price < 10)].price", false, []interface{}{json.Number("8.95"), json.Number("8.99")}},
+		{"$.store.book[?(@.price > $.expensive && @.category == 'fiction')].title", false, []interface{}{"Sword of Honour", "The Lord of the Rings"}},
+		{`$.store.book[?(@.author == "Nigel Rees" || (@.price >= 20 && @.price <= 30))].title`, false, []interface{}{"Sayings of the Century", "The Lord of the Rings"}},
+		{"$.store.book[?(@.category != 'fiction')].title", false, []interface{}{"Sayings of the Century"}},
+		{"$..book[?(@.missing == null)].title", false, nil},
+		{"$..[?(@.price > 19)].price", false, []interface{}{json.Number("19.95"), json.Number("22.99")}},
+		{"$.store.book[1::9223372036854775807].title", false, []interface{}{"Sword of Honour"}},
+		{"$.store.book[2::-9223372036854775808].title", false, []interface{}{"Moby Dick"}},
+		{"$.store.book[-9223372036854775808:9223372036854775807:2].title", false, []interface{}{"Sayings of the Century", "Moby Dick"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.path, func(t *testing.T) {
+			p, err := Compile(tt.path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.definite, p.Definite())
+			assert.Equal(t, tt.want, p.Get(data))
+		})
+	}
+}
+
+func TestCompile_Errors(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		path string
+		err  string
+	}{
+		{"", "at offset 0: path must start with $"},
+		{"store.book", "at offset 0: path must start with $"},
+		{"$.", "at offset 2: expected a member name"},
+		{"$..", "at offset 3: expected a member name"},
+		{"$.store[", "at offset 8: expected a name, an index or a slice"},
+		{"$.store[0", "at offset 9: expected ]"},
+		{"$.store[0,]", "at offset 10: expected a name, an index or a slice"},
+		{"$.store[1:2:0]", "at offset 13: slice step must not be zero"},
+		{"$.store[1:2:3:4]", "at offset 13: expected ]"},
+		{"$.store[-]", `at offset 9: invalid integer "-"`},
+		{"$.store[99999999999999999999]", `at offset 28: invalid integer "99999999999999999999"`},
+		{"$.store['book]", "at offset 14: unterminated string"},
+		{`$.store["book\"]`, "at offset 16: unterminated string"},
+		{"$.store[?(@.price <)]", `at offset 19: unexpected ')' in filter`},
+		{"$.store[?(@.price > 1]", "at offset 21: expected )"},
+		{"$.store[?(@.price > 1)", "at offset 22: expected ]"},
+		{"$.store[?(@.price > 1e)]", `at offset 22: invalid number "1e"`},
+		{"$.store[?(@.price == nil)]", `at offset 21: unexpected 'n' in filter`},
+		{"$.store[?(@.price ==", "at offset 20: unexpected end of filter"},
+		{"$.store.book extra", `at offset 13: unexpected 'e'`},
+		{"$[?(" + repeat("(", 100) + "@.a" + repeat(")", 100) + ")]", "filter is nested too deeply"},
+		{"$[?(" + repeat("!", 100) + "@.a)]", "filter is nested too deeply"},
+	} {
+		_, err := Compile(tt.path)
+		assert.ErrorContains(t, err, tt.err, tt.path)
+	}
+}
+
+func repeat(s string, n int) string {
+	return string(bytes.Repeat([]byte(s), n))
+}
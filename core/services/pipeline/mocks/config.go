@@ -50,6 +50,24 @@ func (_m *Config) DefaultHTTPTimeout() config.Duration {
 	return r0
 }
 
+// MaxHTTPCacheSize provides a mock function with given fields:
+func (_m *Config) MaxHTTPCacheSize() int64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxHTTPCacheSize")
+	}
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
 // MaxRunDuration provides a mock function with given fields:
 func (_m *Config) MaxRunDuration() time.Duration {
 	ret := _m.Called()
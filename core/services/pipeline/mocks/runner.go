@@ -287,6 +287,45 @@ func (_m *Runner) Run(ctx context.Context, run *pipeline.Run, l logger.Logger, s
 	return r0, r1
 }
 
+// SimulateRun provides a mock function with given fields: ctx, spec, vars, l
+func (_m *Runner) SimulateRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, l logger.Logger) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, spec, vars, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateRun")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, logger.Logger) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, spec, vars, l)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, logger.Logger) *pipeline.Run); ok {
+		r0 = rf(ctx, spec, vars, l)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, pipeline.Vars, logger.Logger) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, spec, vars, l)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.Spec, pipeline.Vars, logger.Logger) error); ok {
+		r2 = rf(ctx, spec, vars, l)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Runner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -42,6 +42,10 @@ type Runner interface {
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (run *Run, trrs TaskRunResults, err error)
+	// SimulateRun executes a new run in-memory like ExecuteRun, but stubs out side-effecting tasks:
+	// ethtx tasks never create transactions, async bridges are rejected and bridge responses are not cached.
+	// The results are never persisted.
+	SimulateRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (run *Run, trrs TaskRunResults, err error)
 	// InsertFinishedRun saves the run results in the database.
 	// ds is an optional override, for example when executing a transaction.
 	InsertFinishedRun(ctx context.Context, ds sqlutil.DataSource, run *Run, saveSuccessfulTaskRuns bool) error
@@ -70,6 +74,7 @@ type runner struct {
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	httpCache              *httpResponseCache
 
 	// test helper
 	runFinished func(*Run)
@@ -123,6 +128,7 @@ func NewRunner(orm ORM, btORM bridges.ORM, cfg Config, bridgeCfg BridgeConfig, l
 		lggr:                   lggr.Named("PipelineRunner"),
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		httpCache:              newHTTPResponseCache(cfg.MaxHTTPCacheSize()),
 	}
 	r.runReaperWorker = commonutils.NewSleeperTask(
 		commonutils.SleeperFuncTask(r.runReaper, "PipelineRunnerReaper"),
@@ -293,6 +299,32 @@ func (r *runner) ExecuteRun(
 	return run, taskRunResults, nil
 }
 
+func (r *runner) SimulateRun(
+	ctx context.Context,
+	spec Spec,
+	vars Vars,
+	l logger.Logger,
+) (*Run, TaskRunResults, error) {
+	// Always parse a fresh pipeline, a pre-initialized one may be shared with a running job.
+	spec.Pipeline = nil
+	pipeline, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, task := range pipeline.Tasks {
+		switch task.Type() {
+		case TaskTypeETHTx:
+			task.(*ETHTxTask).simulate = true
+		case TaskTypeBridge:
+			task.(*BridgeTask).simulate = true
+		default:
+		}
+	}
+	spec.Pipeline = pipeline
+
+	return r.ExecuteRun(ctx, spec, vars, l.Named("Simulation"))
+}
+
 func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 	pipeline, err = spec.GetOrParsePipeline()
 	if err != nil {
@@ -308,6 +340,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*HTTPTask).config = r.config
 			task.(*HTTPTask).httpClient = r.httpClient
 			task.(*HTTPTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
+			task.(*HTTPTask).cache = r.httpCache
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).bridgeConfig = r.bridgeConfig
@@ -1024,3 +1024,69 @@ succeed;
 		assert.Equal(t, "1", trrs[0].Result.Value.(pipeline.ObjectParam).DecimalValue.Decimal().String())
 	})
 }
+
+func Test_PipelineRunner_SimulateRun(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	s1 := httptest.NewServer(fakeStringResponder(t, `{"data":{"result":"9700"}}`))
+	defer s1.Close()
+	bridgeFeedURL, err := url.ParseRequestURI(s1.URL)
+	require.NoError(t, err)
+	_, bt := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: bridgeFeedURL.String()})
+
+	// no UpsertBridgeResponse expectation: simulated runs must not write to the bridge cache
+	btORM := bridgesMocks.NewORM(t)
+	btORM.On("FindBridge", mock.Anything, bt.Name).Return(*bt, nil)
+
+	// no ORM expectations: simulated runs must never be persisted
+	r, _ := newRunner(t, db, btORM, cfg)
+	lggr := logger.TestLogger(t)
+
+	t.Run("executes the pipeline without persisting", func(t *testing.T) {
+		s := fmt.Sprintf(`
+ds1 [type=bridge name="%s" cacheTTL=30 requestData=<{"data": {"coin": $(coin)}}>]
+ds1_parse [type=jsonparse lax=false path="data,result"]
+ds1_multiply [type=multiply times=$(times)]
+ds1->ds1_parse->ds1_multiply;
+`, bt.Name.String())
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"coin": "BTC", "times": 10})
+
+		run, trrs, err := r.SimulateRun(testutils.Context(t), pipeline.Spec{DotDagSource: s}, vars, lggr)
+		require.NoError(t, err)
+		require.Len(t, trrs, 3)
+		assert.False(t, run.HasErrors())
+		assert.Equal(t, int64(0), run.ID)
+
+		final := trrs.FinalResult(lggr)
+		require.NoError(t, final.FatalErrors[0])
+		assert.Equal(t, "97000", final.Values[0].(decimal.Decimal).String())
+		for _, trr := range trrs {
+			assert.True(t, trr.FinishedAt.Valid)
+			assert.False(t, trr.CreatedAt.After(trr.FinishedAt.Time))
+		}
+	})
+
+	t.Run("rejects async bridges", func(t *testing.T) {
+		s := fmt.Sprintf(`ds1 [type=bridge async=true name="%s"]`, bt.Name.String())
+
+		run, trrs, err := r.SimulateRun(testutils.Context(t), pipeline.Spec{DotDagSource: s}, pipeline.NewVarsFrom(nil), lggr)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		assert.True(t, run.HasFatalErrors())
+		require.Error(t, trrs[0].Result.Error)
+		assert.Contains(t, trrs[0].Result.Error.Error(), "cannot be used in a simulated run")
+	})
+
+	t.Run("does not mutate a pre-initialized pipeline", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `succeed [type=memo value=1]`}
+		spec.Pipeline, err = spec.ParsePipeline()
+		require.NoError(t, err)
+		before := spec.Pipeline.Tasks[0]
+
+		_, trrs, err := r.SimulateRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), lggr)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		assert.NotSame(t, before, trrs[0].Task)
+	})
+}
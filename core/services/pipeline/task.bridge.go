@@ -3,6 +3,7 @@ package pipeline
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"net/url"
@@ -69,6 +70,8 @@ type BridgeTask struct {
 	config       Config
 	bridgeConfig BridgeConfig
 	httpClient   *http.Client
+	// simulate is set by Runner.SimulateRun, async requests are rejected and the response cache is left untouched
+	simulate bool
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -109,6 +112,14 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		return Result{Error: errors.Errorf("headers must have an even number of elements")}, runInfo
 	}
 
+	if creds := t.bridgeConfig.BridgeCredentials(string(name)); creds != nil {
+		reqHeaders = append(reqHeaders, "Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(creds.Username+":"+creds.Password)))
+	}
+
+	if t.simulate && t.Async == "true" {
+		return Result{Error: errors.Errorf("async bridge %q cannot be used in a simulated run", name)}, runInfo
+	}
+
 	overtimeCtx, cancel := overtimeContext(ctx)
 	defer cancel()
 
@@ -219,7 +230,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		}
 	}
 
-	if !cachedResponse && cacheTTL > 0 {
+	if !cachedResponse && cacheTTL > 0 && !t.simulate {
 		err := t.orm.UpsertBridgeResponse(overtimeCtx, t.dotID, t.specId, responseBytes)
 		if err != nil {
 			lggr.Errorw("Bridge task: failed to upsert response in bridge cache", "err", err)
@@ -23,6 +23,7 @@ import (
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
@@ -1044,6 +1045,42 @@ func TestBridgeTask_Headers(t *testing.T) {
 
 		assert.Equal(t, []string{"Content-Length", "38", "Content-Type", "footype", "User-Agent", "Go-http-client/1.1", "X-Header-1", "foo", "X-Header-2", "bar"}, allHeaders(headers))
 	})
+
+	t.Run("sends rotated bridge credentials as basic auth", func(t *testing.T) {
+		cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			s.Database.AllowSimplePasswords = ptr(true)
+			s.Bridges.Credentials = map[string]toml.BridgeCredentials{
+				bridge.Name.String(): {Username: models.NewSecret("user"), Password: models.NewSecret("pass")},
+			}
+		})
+		task := pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+			Name:        bridge.Name.String(),
+			RequestData: btcUSDPairing,
+		}
+
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		trORM := pipeline.NewORM(db, logger.TestLogger(t), cfg.JobPipeline().MaxSuccessfulRuns())
+		specID, err := trORM.CreateSpec(testutils.Context(t), pipeline.Pipeline{}, *models.NewInterval(5 * time.Minute))
+		require.NoError(t, err)
+		task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, specID, uuid.UUID{}, c)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		username, password, ok := (&http.Request{Header: headers}).BasicAuth()
+		require.True(t, ok)
+		assert.Equal(t, "user", username)
+		assert.Equal(t, "pass", password)
+
+		require.NoError(t, cfg.UpdateSecrets(map[string]string{
+			"Bridges.Credentials." + bridge.Name.String() + ".Password": "rotated",
+		}))
+		result, _ = task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		_, password, ok = (&http.Request{Header: headers}).BasicAuth()
+		require.True(t, ok)
+		assert.Equal(t, "rotated", password)
+	})
 }
 
 func TestBridgeTask_AdapterResponseStatusFailure(t *testing.T) {
@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/hex"
 	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -38,12 +39,16 @@ type ETHTxTask struct {
 	FailOnRevert    string `json:"failOnRevert"`
 	EVMChainID      string `json:"evmChainID" mapstructure:"evmChainID"`
 	TransmitChecker string `json:"transmitChecker"`
+	// Priority is the priority class of the transaction: low, normal, high or critical
+	Priority string `json:"priority"`
 
 	forwardingAllowed bool
 	specGasLimit      *uint32
 	keyStore          ETHKeyStore
 	legacyChains      legacyevm.LegacyChainContainer
 	jobType           string
+	// simulate is set by Runner.SimulateRun, the transaction is never handed to the TXM
+	simulate bool
 }
 
 type ETHKeyStore interface {
@@ -94,6 +99,7 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		maybeMinConfirmations MaybeUint64Param
 		transmitCheckerMap    MapParam
 		failOnRevert          BoolParam
+		priorityName          StringParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
@@ -104,10 +110,15 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(VarExpr(t.MinConfirmations, vars), NonemptyString(t.MinConfirmations), "")), "minConfirmations"),
 		errors.Wrap(ResolveParam(&transmitCheckerMap, From(VarExpr(t.TransmitChecker, vars), JSONWithVarExprs(t.TransmitChecker, vars, false), MapParam{})), "transmitChecker"),
 		errors.Wrap(ResolveParam(&failOnRevert, From(NonemptyString(t.FailOnRevert), false)), "failOnRevert"),
+		errors.Wrap(ResolveParam(&priorityName, From(NonemptyString(t.Priority), txmgrtypes.TxPriorityNormal.String())), "priority"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	priority, err := txmgrtypes.NewTxPriority(string(priorityName))
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "priority")}, runInfo
+	}
 	var minOutgoingConfirmations uint64
 	if min, isSet := maybeMinConfirmations.Uint64(); isSet {
 		minOutgoingConfirmations = min
@@ -156,6 +167,7 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		Strategy:         strategy,
 		Checker:          transmitChecker,
 		SignalCallback:   true,
+		Priority:         priority,
 	}
 
 	if minOutgoingConfirmations > 0 {
@@ -164,6 +176,16 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		txRequest.MinConfirmations = clnull.Uint32From(uint32(minOutgoingConfirmations))
 	}
 
+	if t.simulate {
+		lggr.Infow("ETHTxTask: simulated run, skipping transaction creation",
+			"fromAddress", fromAddr,
+			"toAddress", txRequest.ToAddress,
+			"feeLimit", txRequest.FeeLimit,
+			"forwarderAddress", forwarderAddress,
+		)
+		return Result{Value: nil}, runInfo
+	}
+
 	_, err = txManager.CreateTransaction(ctx, txRequest)
 	if err != nil {
 		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while creating transaction: %v", err)}, retryableRunInfo()
@@ -12,6 +12,7 @@ import (
 
 	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
@@ -605,4 +606,57 @@ func TestETHTxTask(t *testing.T) {
 	}
 }
 
+func TestETHTxTask_Priority(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+
+	newTask := func(t *testing.T, priority string, txManager *txmmocks.MockEvmTxManager) pipeline.ETHTxTask {
+		task := pipeline.ETHTxTask{
+			BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+			From:             `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+			To:               "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+			Data:             "foobar",
+			MinConfirmations: "0",
+			EVMChainID:       "0",
+			Priority:         priority,
+		}
+		keyStore := keystoremocks.NewEth(t)
+		keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, from).Return(from, nil).Maybe()
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewGeneralConfig(t, nil)
+		relayExtenders := evmtest.NewChainRelayExtenders(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg,
+			TxManager: txManager, KeyStore: keyStore})
+		task.HelperSetDependencies(evmrelay.NewLegacyChainsFromRelayerExtenders(relayExtenders), keyStore, nil, pipeline.DirectRequestJobType)
+		return task
+	}
+
+	t.Run("queues the transaction in the given priority class", func(t *testing.T) {
+		txManager := txmmocks.NewMockEvmTxManager(t)
+		txManager.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(tx txmgr.TxRequest) bool {
+			return tx.Priority == txmgrtypes.TxPriorityHigh
+		})).Return(txmgr.Tx{}, nil).Once()
+		task := newTask(t, "high", txManager)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+	})
+
+	t.Run("defaults to the normal priority class", func(t *testing.T) {
+		txManager := txmmocks.NewMockEvmTxManager(t)
+		txManager.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(tx txmgr.TxRequest) bool {
+			return tx.Priority == txmgrtypes.TxPriorityNormal
+		})).Return(txmgr.Tx{}, nil).Once()
+		task := newTask(t, "", txManager)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+	})
+
+	t.Run("errors on an unknown priority class", func(t *testing.T) {
+		task := newTask(t, "urgent", txmmocks.NewMockEvmTxManager(t))
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorContains(t, result.Error, "invalid tx priority")
+	})
+}
+
 func ptr[T any](t T) *T { return &t }
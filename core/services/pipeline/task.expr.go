@@ -0,0 +1,94 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/internal/expr"
+)
+
+// ExprTask evaluates an arithmetic or boolean expression over decimals, e.g.
+// `ds1 > 0 && abs(ds1 - ds2) / ds1 < 0.01 ? (ds1 + ds2) / 2 : ds1`.
+//
+// Variables in the expression are pipeline variables, e.g. the results of other tasks by their
+// dot ID, or `jobRun.meta.threshold`. The inputs of the task are available as `inputs.0`,
+// `inputs.1` and so on, and the first input also as `input`. Booleans are kept as is and all
+// other values are converted to decimals. Divisions are rounded to 18 decimal places.
+//
+// Return types:
+//
+//	decimal.Decimal
+//	bool
+type ExprTask struct {
+	BaseTask `mapstructure:",squash"`
+	Expr     string `json:"expr"`
+
+	// program is compiled by validate when the spec is parsed, and shared by all runs.
+	program *expr.Program
+}
+
+var _ Task = (*ExprTask)(nil)
+
+func (t *ExprTask) Type() TaskType {
+	return TaskTypeExpr
+}
+
+func (t *ExprTask) validate() error {
+	program, err := expr.Compile(t.Expr)
+	if err != nil {
+		return errors.Wrap(err, "expr")
+	}
+	t.program = program
+	return nil
+}
+
+func (t *ExprTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	program := t.program
+	if program == nil {
+		program, err = expr.Compile(t.Expr)
+		if err != nil {
+			return Result{Error: errors.Wrap(ErrBadInput, err.Error())}, runInfo
+		}
+	}
+
+	inputValues := make([]interface{}, len(inputs))
+	for i, input := range inputs {
+		inputValues[i] = input.Value
+	}
+	exprVars := vars.Copy()
+	if err = exprVars.Set("inputs", inputValues); err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if len(inputValues) > 0 {
+		if err = exprVars.Set("input", inputValues[0]); err != nil {
+			return Result{Error: err}, runInfo
+		}
+	}
+
+	value, err := program.Eval(func(name string) (interface{}, error) {
+		v, err2 := exprVars.Get(name)
+		if err2 != nil {
+			return nil, err2
+		}
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+		var d DecimalParam
+		if err2 = d.UnmarshalPipelineParam(v); err2 != nil {
+			return nil, err2
+		}
+		return d.Decimal(), nil
+	})
+	if err != nil {
+		return Result{Error: errors.Wrap(ErrBadInput, err.Error())}, runInfo
+	}
+
+	return Result{Value: value}, runInfo
+}
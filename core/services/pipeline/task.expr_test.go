@@ -0,0 +1,90 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestExprTask(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"ds1":    "100.5",
+		"ds2":    int64(101),
+		"jobRun": map[string]interface{}{"meta": map[string]interface{}{"maxDeviation": 0.01, "enabled": true}},
+	})
+
+	tests := []struct {
+		name              string
+		expr              string
+		inputs            []pipeline.Result
+		want              interface{}
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{"arithmetic over vars", "(ds1 + ds2) / 2", nil, decimal.RequireFromString("100.75"), nil, ""},
+		{"boolean over vars", "jobRun.meta.enabled && abs(ds1 - ds2) / ds1 < jobRun.meta.maxDeviation", nil, true, nil, ""},
+		{"conditional", "ds1 > ds2 ? ds1 : ds2", nil, decimal.NewFromInt(101), nil, ""},
+		{"inputs", "input * 1e18 + inputs.1", []pipeline.Result{{Value: "0.5"}, {Value: 2}}, decimal.RequireFromString("500000000000000002"), nil, ""},
+		{"missing var", "ds3 + 1", nil, nil, pipeline.ErrBadInput, "variable ds3"},
+		{"division by zero", "ds1 / (ds2 - 101)", nil, nil, pipeline.ErrBadInput, "division by zero"},
+		{"type error", "ds1 + jobRun.meta.enabled", nil, nil, pipeline.ErrBadInput, "type error"},
+		{"input error", "input", []pipeline.Result{{Error: errors.New("foo")}}, nil, pipeline.ErrTooManyErrors, "task inputs"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ExprTask{
+				BaseTask: pipeline.NewBaseTask(0, "expr", nil, nil, 0),
+				Expr:     test.expr,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				return
+			}
+			require.NoError(t, result.Error)
+			if want, ok := test.want.(decimal.Decimal); ok {
+				require.True(t, want.Equal(result.Value.(decimal.Decimal)), "want %s, got %s", want, result.Value)
+			} else {
+				require.Equal(t, test.want, result.Value)
+			}
+		})
+	}
+}
+
+func TestExprTask_ValidatedOnParse(t *testing.T) {
+	t.Parallel()
+
+	_, err := pipeline.Parse(`calc [type=expr expr="ds1 +"]`)
+	require.ErrorContains(t, err, "invalid expression")
+
+	_, err = pipeline.Parse(`calc [type=expr expr="system(1)"]`)
+	require.ErrorContains(t, err, "unknown function")
+
+	p, err := pipeline.Parse(`calc [type=expr expr="ds1 > 0 ? ds1 : 0"]`)
+	require.NoError(t, err)
+
+	// The parsed task reuses the expression compiled on parse for every run.
+	require.Len(t, p.Tasks, 1)
+	for _, ds1 := range []int64{5, -5} {
+		result, _ := p.Tasks[0].Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(map[string]interface{}{"ds1": ds1}), nil)
+		require.NoError(t, result.Error)
+		assert.True(t, decimal.NewFromInt(max(ds1, 0)).Equal(result.Value.(decimal.Decimal)))
+	}
+}
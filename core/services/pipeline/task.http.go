@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -24,10 +25,15 @@ type HTTPTask struct {
 	RequestData                    string `json:"requestData"`
 	AllowUnrestrictedNetworkAccess string
 	Headers                        string
+	// CacheTTL enables caching successful responses in the node-wide HTTP response cache for the given
+	// duration, shared with other tasks making the same request. Concurrent identical requests are
+	// then coalesced into a single one.
+	CacheTTL string `json:"cacheTTL"`
 
 	config                 Config
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	cache                  *httpResponseCache
 }
 
 var _ Task = (*HTTPTask)(nil)
@@ -63,6 +69,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		requestData                    MapParam
 		allowUnrestrictedNetworkAccess BoolParam
 		reqHeaders                     StringSliceParam
+		cacheTTL                       Uint64Param
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), "GET")), "method"),
@@ -73,6 +80,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		// You must set allowUnrestrictedNetworkAccess=true on the task to enable variable-interpolated URLs to make restricted network requests
 		errors.Wrap(ResolveParam(&allowUnrestrictedNetworkAccess, From(NonemptyString(t.AllowUnrestrictedNetworkAccess), !variableRegexp.MatchString(t.URL))), "allowUnrestrictedNetworkAccess"),
 		errors.Wrap(ResolveParam(&reqHeaders, From(NonemptyString(t.Headers), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&cacheTTL, From(ValidDurationInSeconds(t.CacheTTL), 0)), "cacheTTL"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -103,27 +111,45 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	} else {
 		client = t.httpClient
 	}
-	responseBytes, statusCode, respHeaders, elapsed, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+	cacheKey := httpCacheKey(string(method), url.String(), requestDataJSON, reqHeaders, bool(allowUnrestrictedNetworkAccess))
+	resp, cached, err := t.cache.fetch(requestCtx, cacheKey, time.Duration(cacheTTL)*time.Second, t.httpTimeout(), t.DotID(), func(fetchCtx context.Context) (httpResponse, error) {
+		body, statusCode, respHeaders, elapsed, err2 := makeHTTPRequest(fetchCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+		if err2 != nil {
+			return httpResponse{statusCode: statusCode}, err2
+		}
+
+		lggr.Debugw("HTTP task got response",
+			"response", string(body),
+			"respHeaders", respHeaders,
+			"url", url.String(),
+			"dotID", t.DotID(),
+		)
+
+		promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
+		promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(body)))
+		return httpResponse{body: body, statusCode: statusCode}, nil
+	})
 	if err != nil {
 		if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
 			err = errors.Wrap(err, `connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess="true" in the pipeline task spec, e.g. fetch [type="http" method=GET url="$(decode_cbor.url)" allowUnrestrictedNetworkAccess="true"]`)
 		}
-		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(resp.statusCode, err)}
+	}
+	if cached {
+		lggr.Debugw("HTTP task: using cached response", "url", url.String(), "dotID", t.DotID())
 	}
-
-	lggr.Debugw("HTTP task got response",
-		"response", string(responseBytes),
-		"respHeaders", respHeaders,
-		"url", url.String(),
-		"dotID", t.DotID(),
-	)
-
-	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
-	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
 
 	// NOTE: We always stringify the response since this is required for all current jobs.
 	// If a binary response is required we might consider adding an adapter
 	// flag such as  "BinaryMode: true" which passes through raw binary as the
 	// value instead.
-	return Result{Value: string(responseBytes)}, runInfo
+	return Result{Value: string(resp.body)}, runInfo
+}
+
+// httpTimeout returns the timeout of the task if set, or else the default HTTP timeout.
+func (t *HTTPTask) httpTimeout() time.Duration {
+	if timeout, isSet := t.TaskTimeout(); isSet {
+		return timeout
+	}
+	return t.config.DefaultHTTPTimeout().Duration()
 }
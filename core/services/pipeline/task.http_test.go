@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -412,3 +413,63 @@ func TestHTTPTask_Headers(t *testing.T) {
 		assert.Equal(t, []string{"Content-Length", "38", "Content-Type", "footype", "User-Agent", "Go-http-client/1.1", "X-Header-1", "foo", "X-Header-2", "bar"}, allHeaders(headers))
 	})
 }
+
+func TestHTTPTask_CacheTTL(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+	var hits atomic.Int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"result": 42}`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	newTask := func(cacheTTL string) pipeline.HTTPTask {
+		task := pipeline.HTTPTask{
+			BaseTask: pipeline.NewBaseTask(0, "ds1", nil, nil, 0),
+			Method:   "GET",
+			URL:      server.URL,
+			CacheTTL: cacheTTL,
+		}
+		task.HelperSetDependencies(config.JobPipeline(), c, c)
+		return task
+	}
+
+	t.Run("caches responses until the ttl elapses", func(t *testing.T) {
+		task := newTask("1m")
+		task.HelperSetCache(1024)
+		hits.Store(0)
+
+		for i := 0; i < 3; i++ {
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			require.NoError(t, result.Error)
+			assert.False(t, runInfo.IsRetryable)
+			assert.Equal(t, `{"result": 42}`, result.Value)
+		}
+		assert.Equal(t, int32(1), hits.Load())
+	})
+
+	t.Run("does not cache without a ttl", func(t *testing.T) {
+		task := newTask("")
+		task.HelperSetCache(1024)
+		hits.Store(0)
+
+		for i := 0; i < 3; i++ {
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			require.NoError(t, result.Error)
+		}
+		assert.Equal(t, int32(3), hits.Load())
+	})
+
+	t.Run("rejects invalid ttl", func(t *testing.T) {
+		task := newTask("forever")
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "cacheTTL")
+	})
+}
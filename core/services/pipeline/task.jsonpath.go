@@ -0,0 +1,119 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/internal/jsonpath"
+)
+
+// JSONPathTask queries JSON data with a JSONPath expression, e.g. `$.data[?(@.volume > 1000)].price`.
+//
+// Paths which can only select one value (made of names and indexes) return that value, and fail
+// if it doesn't exist unless lax is set. Any other path returns an array of all the selected values.
+//
+// Return types:
+//
+//	float64
+//	string
+//	bool
+//	map[string]interface{}
+//	[]interface{}
+//	nil
+type JSONPathTask struct {
+	BaseTask `mapstructure:",squash"`
+	Path     string `json:"path"`
+	Data     string `json:"data"`
+	// Lax when enabled will return nil with no error if a definite path does not exist
+	Lax string `json:"lax"`
+
+	// path is compiled by validate when the spec is parsed, unless it is only known at run time.
+	path *jsonpath.Path
+}
+
+var _ Task = (*JSONPathTask)(nil)
+
+func (t *JSONPathTask) Type() TaskType {
+	return TaskTypeJSONPath
+}
+
+// validate compiles the path, unless it is only known at run time.
+func (t *JSONPathTask) validate() error {
+	if strings.Contains(t.Path, "$(") {
+		return nil
+	}
+	path, err := jsonpath.Compile(t.Path)
+	if err != nil {
+		return errors.Wrap(err, "path")
+	}
+	t.path = path
+	return nil
+}
+
+func (t *JSONPathTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		pathStr StringParam
+		data    BytesParam
+		lax     BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&pathStr, From(VarExpr(t.Path, vars), NonemptyString(t.Path))), "path"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	path := t.path
+	if path == nil {
+		path, err = jsonpath.Compile(string(pathStr))
+		if err != nil {
+			return Result{Error: errors.Wrap(ErrBadInput, err.Error())}, runInfo
+		}
+	}
+
+	var decoded interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	err = d.Decode(&decoded)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	var value interface{}
+	selected := path.Get(decoded)
+	if path.Definite() {
+		if len(selected) == 0 {
+			if !bool(lax) {
+				return Result{Error: errors.Wrapf(ErrKeypathNotFound, `could not resolve path %s in %s`, path, data)}, runInfo
+			}
+		} else {
+			value = selected[0]
+		}
+	} else {
+		if selected == nil {
+			selected = []interface{}{}
+		}
+		value = selected
+	}
+
+	value, err = jsonserializable.ReinterpretJSONNumbers(value)
+	if err != nil {
+		return Result{Error: multierr.Combine(ErrBadInput, err)}, runInfo
+	}
+
+	return Result{Value: value}, runInfo
+}
@@ -0,0 +1,168 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestJSONPathTask(t *testing.T) {
+	t.Parallel()
+
+	const prices = `{"data":[{"exchange":"a","price":10.5,"volume":100},{"exchange":"b","price":11,"volume":5000},{"exchange":"c","price":12,"volume":2000}]}`
+
+	tests := []struct {
+		name              string
+		data              string
+		path              string
+		lax               string
+		vars              pipeline.Vars
+		inputs            []pipeline.Result
+		wantData          interface{}
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{
+			"definite path",
+			"",
+			"$.data[0].exchange",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			"a",
+			nil,
+			"",
+		},
+		{
+			"filter",
+			"",
+			"$.data[?(@.volume >= 1000)].price",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			[]interface{}{int64(11), int64(12)},
+			nil,
+			"",
+		},
+		{
+			"wildcard",
+			"",
+			"$.data[*].exchange",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			[]interface{}{"a", "b", "c"},
+			nil,
+			"",
+		},
+		{
+			"no matches",
+			"",
+			"$.data[?(@.volume > 1000000)].price",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			[]interface{}{},
+			nil,
+			"",
+		},
+		{
+			"path and data from vars",
+			"$(foo.data)",
+			"$(foo.path)",
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{"foo": map[string]interface{}{"data": prices, "path": "$..exchange"}}),
+			nil,
+			[]interface{}{"a", "b", "c"},
+			nil,
+			"",
+		},
+		{
+			"missing definite path",
+			"",
+			"$.data[5].price",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			pipeline.ErrKeypathNotFound,
+			"",
+		},
+		{
+			"missing definite path, lax",
+			"",
+			"$.data[5].price",
+			"true",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			nil,
+			"",
+		},
+		{
+			"invalid path from vars",
+			"",
+			"$(path)",
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{"path": "data[0]"}),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			pipeline.ErrBadInput,
+			"path must start with $",
+		},
+		{
+			"input error",
+			"",
+			"$.data",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Error: errors.New("foo")}},
+			nil,
+			pipeline.ErrTooManyErrors,
+			"task inputs",
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.JSONPathTask{
+				BaseTask: pipeline.NewBaseTask(0, "jsonpath", nil, nil, 0),
+				Path:     test.path,
+				Data:     test.data,
+				Lax:      test.lax,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				if test.wantErrorContains != "" {
+					require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				}
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.wantData, result.Value)
+			}
+		})
+	}
+}
+
+func TestJSONPathTask_ValidatedOnParse(t *testing.T) {
+	t.Parallel()
+
+	_, err := pipeline.Parse(`ds [type=jsonpath path="$.data[?(@.price >)]"]`)
+	require.ErrorContains(t, err, "invalid JSONPath")
+
+	_, err = pipeline.Parse(`ds [type=jsonpath path="$(jobRun.path)"]`)
+	require.NoError(t, err)
+}
@@ -8,17 +8,20 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
 	"github.com/smartcontractkit/libocr/gethwrappers2/ocr2aggregator"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
 )
 
 var sampleAddress = testutils.NewAddress()
@@ -75,3 +78,31 @@ func TestContractTransmitter(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, sampleAddress.String(), string(from))
 }
+
+func Test_newTxStrategy(t *testing.T) {
+	t.Parallel()
+
+	subject := uuid.New()
+
+	t.Run("queues without a priority class by default", func(t *testing.T) {
+		strategy, err := newTxStrategy(subject, types.RelayConfig{DefaultTransactionQueueDepth: 2})
+		require.NoError(t, err)
+		assert.Equal(t, uuid.NullUUID{UUID: subject, Valid: true}, strategy.Subject())
+		_, ok := strategy.(txmgrtypes.PriorityTxStrategy)
+		assert.False(t, ok)
+	})
+
+	t.Run("queues in the configured priority class", func(t *testing.T) {
+		strategy, err := newTxStrategy(subject, types.RelayConfig{DefaultTransactionQueueDepth: 2, TransactionPriority: "critical"})
+		require.NoError(t, err)
+		assert.Equal(t, uuid.NullUUID{UUID: subject, Valid: true}, strategy.Subject())
+		priorityStrategy, ok := strategy.(txmgrtypes.PriorityTxStrategy)
+		require.True(t, ok)
+		assert.Equal(t, txmgrtypes.TxPriorityCritical, priorityStrategy.Priority())
+	})
+
+	t.Run("rejects an unknown priority class", func(t *testing.T) {
+		_, err := newTxStrategy(subject, types.RelayConfig{TransactionPriority: "urgent"})
+		require.ErrorContains(t, err, "invalid transactionPriority")
+	})
+}
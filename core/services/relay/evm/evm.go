@@ -28,6 +28,7 @@ import (
 	coretypes "github.com/smartcontractkit/chainlink-common/pkg/types/core"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	txm "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -359,12 +360,16 @@ func (r *Relayer) NewLLOProvider(rargs commontypes.RelayArgs, pargs commontypes.
 		r.lggr.Info("Benchmark mode enabled, using dummy transmitter. NOTE: THIS WILL NOT TRANSMIT ANYTHING")
 		transmitter = bm.NewTransmitter(r.lggr, privKey.PublicKey)
 	} else {
-		var client wsrpc.Client
-		client, err = r.mercuryPool.Checkout(context.Background(), privKey, lloCfg.ServerPubKey, lloCfg.ServerURL())
-		if err != nil {
-			return nil, err
+		clients := make(map[string]wsrpc.Client)
+		for _, server := range lloCfg.GetServers() {
+			var client wsrpc.Client
+			client, err = r.mercuryPool.Checkout(context.Background(), privKey, server.PubKey, server.URL)
+			if err != nil {
+				return nil, err
+			}
+			clients[server.URL] = client
 		}
-		transmitter = llo.NewTransmitter(r.lggr, client, privKey.PublicKey)
+		transmitter = llo.NewTransmitter(r.lggr, r.transmitterCfg, clients, privKey.PublicKey, rargs.JobID, llo.NewTransmitterORM(r.ds, rargs.JobID))
 	}
 
 	cdc, err := r.cdcFactory.NewCache(lloCfg)
@@ -531,6 +536,19 @@ type configTransmitterOpts struct {
 	subjectID *uuid.UUID
 }
 
+// newTxStrategy returns the strategy to queue transmissions with. If the relay config sets a
+// transactionPriority, transmissions are queued in that priority class.
+func newTxStrategy(subject uuid.UUID, relayConfig types.RelayConfig) (txmgrtypes.TxStrategy, error) {
+	if relayConfig.TransactionPriority == "" {
+		return txmgrcommon.NewQueueingTxStrategy(subject, relayConfig.DefaultTransactionQueueDepth), nil
+	}
+	priority, err := txmgrtypes.NewTxPriority(relayConfig.TransactionPriority)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transactionPriority: %w", err)
+	}
+	return txmgrcommon.NewPriorityStrategy(subject, priority, relayConfig.DefaultTransactionQueueDepth), nil
+}
+
 // newOnChainContractTransmitter creates a new contract transmitter.
 func newOnChainContractTransmitter(ctx context.Context, lggr logger.Logger, rargs commontypes.RelayArgs, transmitterID string, ethKeystore keystore.Eth, configWatcher *configWatcher, opts configTransmitterOpts, transmissionContractABI abi.ABI, transmissionContractRetention time.Duration) (*contractTransmitter, error) {
 	var relayConfig types.RelayConfig
@@ -565,7 +583,10 @@ func newOnChainContractTransmitter(ctx context.Context, lggr logger.Logger, rarg
 	if opts.subjectID != nil {
 		subject = *opts.subjectID
 	}
-	strategy := txmgrcommon.NewQueueingTxStrategy(subject, relayConfig.DefaultTransactionQueueDepth)
+	strategy, err := newTxStrategy(subject, relayConfig)
+	if err != nil {
+		return nil, err
+	}
 
 	var checker txm.TransmitCheckerSpec
 	if relayConfig.SimulateTransactions {
@@ -582,7 +603,6 @@ func newOnChainContractTransmitter(ctx context.Context, lggr logger.Logger, rarg
 	}
 
 	var transmitter Transmitter
-	var err error
 
 	switch commontypes.OCR2PluginType(rargs.ProviderType) {
 	case commontypes.Median:
@@ -85,6 +85,12 @@ var (
 	},
 		[]string{"feedID", "serverURL", "code"},
 	)
+	transmitDeliveryLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mercury_transmit_delivery_lag_seconds",
+		Help: "Time the oldest report pending for the mercury server has been queued; zero if no reports are pending",
+	},
+		[]string{"feedID", "serverURL"},
+	)
 )
 
 type Transmitter interface {
@@ -159,21 +165,51 @@ type server struct {
 
 	url string
 
+	deliveryLag *mercuryutils.DeliveryLagTracker[*pb.TransmitRequest]
+
 	transmitSuccessCount          prometheus.Counter
 	transmitDuplicateCount        prometheus.Counter
 	transmitConnectionErrorCount  prometheus.Counter
 	transmitQueueDeleteErrorCount prometheus.Counter
 	transmitQueueInsertErrorCount prometheus.Counter
 	transmitQueuePushErrorCount   prometheus.Counter
+	transmitDeliveryLag           prometheus.Gauge
 }
 
 func (s *server) HealthReport() map[string]error {
 	report := map[string]error{}
 	services.CopyHealth(report, s.c.HealthReport())
 	services.CopyHealth(report, s.q.HealthReport())
+	if err := s.deliveryLag.Check(s.url); err != nil {
+		report[s.lggr.Name()] = err
+	}
 	return report
 }
 
+// initQueue loads the persisted transmissions into the queue; their delivery
+// lag is measured from when they were loaded.
+func (s *server) initQueue(transmissions []*Transmission) {
+	for _, t := range transmissions {
+		s.deliveryLag.Enqueued(t.Req)
+	}
+	s.q.Init(transmissions)
+}
+
+// push queues the request, keeping track of how long it is pending
+func (s *server) push(req *pb.TransmitRequest, reportCtx ocrtypes.ReportContext) (ok bool) {
+	s.deliveryLag.Enqueued(req)
+	if ok = s.q.Push(req, reportCtx); !ok {
+		s.deliveryLag.Done(req)
+	}
+	return ok
+}
+
+// AsyncDelete is called by the queue when it evicts a request
+func (s *server) AsyncDelete(req *pb.TransmitRequest) {
+	s.deliveryLag.Done(req)
+	s.pm.AsyncDelete(req)
+}
+
 func (s *server) runDeleteQueueLoop(stopCh services.StopChan, wg *sync.WaitGroup) {
 	defer wg.Done()
 	runloopCtx, cancel := stopCh.Ctx(context.Background())
@@ -242,10 +278,11 @@ func (s *server) runQueueLoop(stopCh services.StopChan, wg *sync.WaitGroup, feed
 		} else if err != nil {
 			s.transmitConnectionErrorCount.Inc()
 			s.lggr.Errorw("Transmit report failed", "err", err, "reportCtx", t.ReportCtx)
-			if ok := s.q.Push(t.Req, t.ReportCtx); !ok {
+			if ok := s.push(t.Req, t.ReportCtx); !ok {
 				s.lggr.Error("Failed to push report to transmit queue; queue is closed")
 				return
 			}
+			s.transmitDeliveryLag.Set(s.deliveryLag.Lag().Seconds())
 			// Wait a backoff duration before pulling the most recent transmission
 			// the heap
 			select {
@@ -257,6 +294,8 @@ func (s *server) runQueueLoop(stopCh services.StopChan, wg *sync.WaitGroup, feed
 		}
 
 		b.Reset()
+		s.deliveryLag.Done(t.Req)
+		s.transmitDeliveryLag.Set(s.deliveryLag.Lag().Seconds())
 		if res.Error == "" {
 			s.transmitSuccessCount.Inc()
 			s.lggr.Debugw("Transmit report success", "payload", hexutil.Encode(t.Req.Payload), "response", res, "repts", t.ReportCtx.ReportTimestamp)
@@ -284,21 +323,27 @@ func (s *server) runQueueLoop(stopCh services.StopChan, wg *sync.WaitGroup, feed
 }
 
 func newServer(lggr logger.Logger, cfg TransmitterConfig, client wsrpc.Client, pm *PersistenceManager, serverURL, feedIDHex string) *server {
-	return &server{
+	s := &server{
 		lggr,
 		cfg.TransmitTimeout().Duration(),
 		client,
 		pm,
-		NewTransmitQueue(lggr, serverURL, feedIDHex, int(cfg.TransmitQueueMaxSize()), pm),
+		nil, // q is set below
 		make(chan *pb.TransmitRequest, int(cfg.TransmitQueueMaxSize())),
 		serverURL,
+		mercuryutils.NewDeliveryLagTracker[*pb.TransmitRequest](),
 		transmitSuccessCount.WithLabelValues(feedIDHex, serverURL),
 		transmitDuplicateCount.WithLabelValues(feedIDHex, serverURL),
 		transmitConnectionErrorCount.WithLabelValues(feedIDHex, serverURL),
 		transmitQueueDeleteErrorCount.WithLabelValues(feedIDHex, serverURL),
 		transmitQueueInsertErrorCount.WithLabelValues(feedIDHex, serverURL),
 		transmitQueuePushErrorCount.WithLabelValues(feedIDHex, serverURL),
+		transmitDeliveryLag.WithLabelValues(feedIDHex, serverURL),
 	}
+	// the server sits between the queue and pm so that it learns about
+	// evicted transmissions
+	s.q = NewTransmitQueue(lggr, serverURL, feedIDHex, int(cfg.TransmitQueueMaxSize()), s)
+	return s
 }
 
 func NewTransmitter(lggr logger.Logger, cfg TransmitterConfig, clients map[string]wsrpc.Client, fromAccount ed25519.PublicKey, jobID int32, feedID [32]byte, orm ORM, codec TransmitterReportDecoder, triggerCapability *triggers.MercuryTriggerService) *mercuryTransmitter {
@@ -336,7 +381,7 @@ func (mt *mercuryTransmitter) Start(ctx context.Context) (err error) {
 				if err != nil {
 					return err
 				}
-				s.q.Init(transmissions)
+				s.initQueue(transmissions)
 				// starting pm after loading from it is fine because it simply spawns some garbage collection/prune goroutines
 				startClosers = append(startClosers, s.c, s.q, s.pm)
 
@@ -454,7 +499,7 @@ func (mt *mercuryTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.R
 	for _, s := range mt.servers {
 		s := s // https://golang.org/doc/faq#closures_and_goroutines
 		g.Go(func() error {
-			if ok := s.q.Push(req, reportCtx); !ok {
+			if ok := s.push(req, reportCtx); !ok {
 				s.transmitQueuePushErrorCount.Inc()
 				return errors.New("transmit queue is closed")
 			}
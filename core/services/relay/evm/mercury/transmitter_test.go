@@ -578,3 +578,34 @@ func Test_MercuryTransmitter_runQueueLoop(t *testing.T) {
 		wg.Wait()
 	})
 }
+
+func Test_MercuryTransmitter_deliveryLag(t *testing.T) {
+	feedIDHex := utils.NewHash().Hex()
+	lggr := logger.TestLogger(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(db)
+	pm := NewPersistenceManager(lggr, sURL, orm, 0, 0, 0, 0)
+
+	s := newServer(lggr, mockCfg{}, &mocks.MockWSRPCClient{}, pm, sURL, feedIDHex)
+	s.q.Init([]*Transmission{})
+	assert.Zero(t, s.deliveryLag.Lag())
+
+	oldest := &pb.TransmitRequest{Payload: []byte{1}}
+	latest := &pb.TransmitRequest{Payload: []byte{2}}
+	require.True(t, s.push(oldest, ocrtypes.ReportContext{ReportTimestamp: ocrtypes.ReportTimestamp{Epoch: 1, Round: 1}}))
+	require.True(t, s.push(latest, ocrtypes.ReportContext{ReportTimestamp: ocrtypes.ReportTimestamp{Epoch: 2, Round: 2}}))
+	lag := s.deliveryLag.Lag()
+	assert.Greater(t, lag, time.Duration(0))
+
+	// delivering the latest report leaves the oldest one pending
+	require.Equal(t, latest, s.q.BlockingPop().Req)
+	s.deliveryLag.Done(latest)
+	assert.Greater(t, s.deliveryLag.Lag(), lag)
+	for _, err := range s.HealthReport() {
+		assert.NoError(t, err)
+	}
+
+	// evicting it from the queue means it is no longer pending
+	s.AsyncDelete(oldest)
+	assert.Zero(t, s.deliveryLag.Lag())
+}
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// MaxHealthyDeliveryLag is the delivery lag above which a mercury server is
+// reported as unhealthy
+const MaxHealthyDeliveryLag = time.Minute
+
+// DeliveryLagTracker measures how far behind the delivery of reports to a
+// mercury server is, i.e. how long the oldest report still pending for it has
+// been queued. K identifies a report, and must stay the same when a report is
+// queued again after a failed transmission.
+type DeliveryLagTracker[K comparable] struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	pending map[K]*list.Element
+	// queued holds the pending reports in the order they were first queued,
+	// so the oldest is always at the front
+	queued *list.List
+}
+
+type pendingReport[K comparable] struct {
+	key        K
+	enqueuedAt time.Time
+}
+
+func NewDeliveryLagTracker[K comparable]() *DeliveryLagTracker[K] {
+	return &DeliveryLagTracker[K]{
+		now:     time.Now,
+		pending: make(map[K]*list.Element),
+		queued:  list.New(),
+	}
+}
+
+// Enqueued records that the report was queued. A report which is already
+// pending, e.g. because it is retried, keeps its original enqueue time.
+func (d *DeliveryLagTracker[K]) Enqueued(k K) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, exists := d.pending[k]; exists {
+		return
+	}
+	d.pending[k] = d.queued.PushBack(pendingReport[K]{k, d.now()})
+}
+
+// Done records that the report is no longer pending, because it was either
+// delivered or dropped from the queue.
+func (d *DeliveryLagTracker[K]) Done(k K) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if e, exists := d.pending[k]; exists {
+		d.queued.Remove(e)
+		delete(d.pending, k)
+	}
+}
+
+// Lag is the time since the oldest pending report was queued, or zero if no
+// reports are pending.
+func (d *DeliveryLagTracker[K]) Lag() time.Duration {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	oldest := d.queued.Front()
+	if oldest == nil {
+		return 0
+	}
+	return d.now().Sub(oldest.Value.(pendingReport[K]).enqueuedAt)
+}
+
+// Check returns an error if the delivery lag to serverURL exceeds
+// MaxHealthyDeliveryLag.
+func (d *DeliveryLagTracker[K]) Check(serverURL string) error {
+	if lag := d.Lag(); lag > MaxHealthyDeliveryLag {
+		return fmt.Errorf("delivery lag of %s to %s exceeds %s", lag, serverURL, MaxHealthyDeliveryLag)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DeliveryLagTracker(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	d := NewDeliveryLagTracker[int]()
+	d.now = func() time.Time { return now }
+
+	t.Run("no lag if no reports are pending", func(t *testing.T) {
+		assert.Zero(t, d.Lag())
+		assert.NoError(t, d.Check("example.com"))
+	})
+
+	t.Run("measures the lag from the oldest pending report", func(t *testing.T) {
+		d.Enqueued(1)
+		now = now.Add(10 * time.Second)
+		d.Enqueued(2)
+		now = now.Add(10 * time.Second)
+		assert.Equal(t, 20*time.Second, d.Lag())
+
+		// retrying a report keeps its original enqueue time
+		d.Enqueued(1)
+		assert.Equal(t, 20*time.Second, d.Lag())
+
+		// delivering a newer report doesn't catch up with the oldest
+		d.Done(2)
+		assert.Equal(t, 20*time.Second, d.Lag())
+
+		d.Enqueued(3)
+		d.Done(1)
+		assert.Zero(t, d.Lag())
+		d.Done(3)
+		assert.Zero(t, d.Lag())
+
+		// unknown reports are ignored
+		d.Done(4)
+		assert.Zero(t, d.Lag())
+	})
+
+	t.Run("reports lag over MaxHealthyDeliveryLag as an error", func(t *testing.T) {
+		d.Enqueued(5)
+		now = now.Add(MaxHealthyDeliveryLag)
+		assert.NoError(t, d.Check("example.com"))
+
+		now = now.Add(time.Second)
+		err := d.Check("example.com")
+		require.Error(t, err)
+		assert.Equal(t, "delivery lag of 1m1s to example.com exceeds 1m0s", err.Error())
+	})
+}
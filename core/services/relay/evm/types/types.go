@@ -146,6 +146,7 @@ type RelayConfig struct {
 
 	DefaultTransactionQueueDepth uint32 `json:"defaultTransactionQueueDepth"`
 	SimulateTransactions         bool   `json:"simulateTransactions"`
+	TransactionPriority          string `json:"transactionPriority"`
 
 	// Contract-specific
 	SendingKeys pq.StringArray `json:"sendingKeys"`
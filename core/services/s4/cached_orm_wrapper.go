@@ -50,6 +50,12 @@ func (c CachedORM) Update(ctx context.Context, row *Row) error {
 	return c.underlayingORM.Update(ctx, row)
 }
 
+func (c CachedORM) UpdateWithQuota(ctx context.Context, row *Row, maxBytes uint64, now time.Time) error {
+	c.deleteRowFromSnapshotCache(row)
+
+	return c.underlayingORM.UpdateWithQuota(ctx, row, maxBytes, now)
+}
+
 func (c CachedORM) DeleteExpired(ctx context.Context, limit uint, utcNow time.Time) (int64, error) {
 	deletedRows, err := c.underlayingORM.DeleteExpired(ctx, limit, utcNow)
 	if err != nil {
@@ -51,6 +51,27 @@ func (o *inMemoryOrm) Update(ctx context.Context, row *Row) error {
 	o.mu.Lock()
 	defer o.mu.Unlock()
 
+	return o.update(row)
+}
+
+func (o *inMemoryOrm) UpdateWithQuota(ctx context.Context, row *Row, maxBytes uint64, now time.Time) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	address := row.Address.Hex()
+	bytesUsed := uint64(len(row.Payload))
+	for k, mrow := range o.rows {
+		if k.address == address && k.slot != row.SlotId && mrow.Row.Expiration > now.UnixMilli() {
+			bytesUsed += uint64(len(mrow.Row.Payload))
+		}
+	}
+	if bytesUsed > maxBytes {
+		return ErrQuotaExceeded
+	}
+	return o.update(row)
+}
+
+func (o *inMemoryOrm) update(row *Row) error {
 	mkey := key{
 		address: row.Address.Hex(),
 		slot:    row.SlotId,
@@ -94,20 +115,21 @@ func (o *inMemoryOrm) DeleteExpired(ctx context.Context, limit uint, now time.Ti
 	return int64(len(queue)), nil
 }
 
-func (o *inMemoryOrm) GetSnapshot(ctx context.Context, _ *AddressRange) ([]*SnapshotRow, error) {
+func (o *inMemoryOrm) GetSnapshot(ctx context.Context, addressRange *AddressRange) ([]*SnapshotRow, error) {
 	o.mu.RLock()
 	defer o.mu.RUnlock()
 
 	now := time.Now().UnixMilli()
 	var rows []*SnapshotRow
 	for _, mrow := range o.rows {
-		if mrow.Row.Expiration > now {
+		if mrow.Row.Expiration > now && addressRange.Contains(mrow.Row.Address) {
 			rows = append(rows, &SnapshotRow{
-				Address:    big.New(mrow.Row.Address.ToInt()),
-				SlotId:     mrow.Row.SlotId,
-				Version:    mrow.Row.Version,
-				Expiration: mrow.Row.Expiration,
-				Confirmed:  mrow.Row.Confirmed,
+				Address:     big.New(mrow.Row.Address.ToInt()),
+				SlotId:      mrow.Row.SlotId,
+				Version:     mrow.Row.Version,
+				Expiration:  mrow.Row.Expiration,
+				Confirmed:   mrow.Row.Confirmed,
+				PayloadSize: uint64(len(mrow.Row.Payload)),
 			})
 		}
 	}
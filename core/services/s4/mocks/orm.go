@@ -155,6 +155,24 @@ func (_m *ORM) Update(ctx context.Context, row *s4.Row) error {
 	return r0
 }
 
+// UpdateWithQuota provides a mock function with given fields: ctx, row, maxBytes, now
+func (_m *ORM) UpdateWithQuota(ctx context.Context, row *s4.Row, maxBytes uint64, now time.Time) error {
+	ret := _m.Called(ctx, row, maxBytes, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWithQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s4.Row, uint64, time.Time) error); ok {
+		r0 = rf(ctx, row, maxBytes, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewORM creates a new instance of ORM. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewORM(t interface {
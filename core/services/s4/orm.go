@@ -44,6 +44,12 @@ type ORM interface {
 	// UpdatedAt field value is ignored.
 	Update(ctx context.Context, row *Row) error
 
+	// UpdateWithQuota is like Update, but returns ErrQuotaExceeded instead if the row would take the total
+	// payload size of the address's rows not expired at now over maxBytes. The row currently stored in the
+	// same slot doesn't count, as it would be replaced. Concurrent updates for the same address are serialized,
+	// so they can't exceed the quota together.
+	UpdateWithQuota(ctx context.Context, row *Row, maxBytes uint64, now time.Time) error
+
 	// DeleteExpired deletes any entries having Expiration < utcNow,
 	// up to the given limit.
 	// Returns the number of deleted rows.
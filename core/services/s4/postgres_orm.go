@@ -70,6 +70,27 @@ RETURNING id;`, o.tableName)
 	return err
 }
 
+func (o *orm) UpdateWithQuota(ctx context.Context, row *Row, maxBytes uint64, now time.Time) error {
+	return sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		// A new slot has no row to lock yet, so concurrent updates for the same address are serialized
+		// with an advisory lock instead.
+		lockKey := fmt.Sprintf("%s:%s:%s", o.tableName, o.namespace, row.Address.String())
+		if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1));`, lockKey); err != nil {
+			return err
+		}
+		stmt := fmt.Sprintf(`SELECT COALESCE(SUM(octet_length(payload)), 0) FROM %s WHERE namespace = $1 AND address = $2 AND slot_id <> $3 AND expiration > $4;`, o.tableName)
+		var bytesUsed uint64
+		if err := tx.GetContext(ctx, &bytesUsed, stmt, o.namespace, row.Address, row.SlotId, now.UnixMilli()); err != nil {
+			return err
+		}
+		if bytesUsed+uint64(len(row.Payload)) > maxBytes {
+			return ErrQuotaExceeded
+		}
+		txOrm := &orm{ds: tx, tableName: o.tableName, namespace: o.namespace}
+		return txOrm.Update(ctx, row)
+	})
+}
+
 func (o *orm) DeleteExpired(ctx context.Context, limit uint, utcNow time.Time) (int64, error) {
 	with := fmt.Sprintf(`WITH rows AS (SELECT id FROM %s WHERE namespace = $1 AND expiration < $2 LIMIT $3)`, o.tableName)
 	stmt := fmt.Sprintf(`%s DELETE FROM %s WHERE id IN (SELECT id FROM rows);`, with, o.tableName)
@@ -115,6 +115,42 @@ func TestPostgresORM_UpdateSimpleFlow(t *testing.T) {
 	assert.Error(t, orm.Update(ctx, row))
 }
 
+func TestPostgresORM_UpdateWithQuota(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm := setupORM(t, "test")
+	now := time.Now()
+	address := big.New(testutils.NewAddress().Big())
+	newRow := func(slotId uint, version uint64, payloadSize int, expiration time.Time) *s4.Row {
+		return &s4.Row{
+			Address:    address,
+			SlotId:     slotId,
+			Payload:    cltest.MustRandomBytes(t, payloadSize),
+			Version:    version,
+			Expiration: expiration.UnixMilli(),
+			Signature:  cltest.MustRandomBytes(t, 32),
+		}
+	}
+
+	assert.NoError(t, orm.UpdateWithQuota(ctx, newRow(0, 1, 20, now.Add(time.Hour)), 50, now))
+	assert.NoError(t, orm.UpdateWithQuota(ctx, newRow(1, 1, 20, now.Add(time.Hour)), 50, now))
+	assert.ErrorIs(t, orm.UpdateWithQuota(ctx, newRow(2, 1, 20, now.Add(time.Hour)), 50, now), s4.ErrQuotaExceeded)
+
+	// the row being replaced doesn't count
+	assert.NoError(t, orm.UpdateWithQuota(ctx, newRow(0, 2, 30, now.Add(time.Hour)), 50, now))
+
+	// expired rows don't count
+	assert.NoError(t, orm.Update(ctx, newRow(3, 1, 32, now.Add(-time.Hour))))
+	assert.ErrorIs(t, orm.UpdateWithQuota(ctx, newRow(2, 1, 1, now.Add(time.Hour)), 50, now), s4.ErrQuotaExceeded)
+	assert.ErrorIs(t, orm.UpdateWithQuota(ctx, newRow(1, 1, 20, now.Add(time.Hour)), 50, now), s4.ErrVersionTooLow)
+
+	// other addresses have their own quota
+	other := newRow(0, 1, 50, now.Add(time.Hour))
+	other.Address = big.New(testutils.NewAddress().Big())
+	assert.NoError(t, orm.UpdateWithQuota(ctx, other, 50, now))
+}
+
 func TestPostgresORM_DeleteExpired(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -0,0 +1,125 @@
+package s4
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	DefaultPruneInterval         = time.Minute
+	DefaultPruneBatchSize   uint = 1000
+	defaultMaxBatchesPerRun      = 100
+)
+
+var promPrunedExpiredRecords = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "s4_pruned_expired_records",
+	Help: "Number of expired S4 records deleted by the pruner",
+})
+
+// PrunerConfig configures the background deletion of expired records.
+type PrunerConfig struct {
+	// Interval between pruning runs, defaults to DefaultPruneInterval.
+	Interval time.Duration
+	// BatchSize is the max number of records deleted per query, defaults to DefaultPruneBatchSize.
+	// A run keeps deleting batches until a batch is not full, up to defaultMaxBatchesPerRun batches.
+	BatchSize uint
+}
+
+type pruner struct {
+	services.StateMachine
+	lggr   logger.Logger
+	config PrunerConfig
+	orm    ORM
+	clock  clockwork.Clock
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+var _ services.Service = (*pruner)(nil)
+
+// NewPruner returns a service which periodically deletes expired records from the given ORM, in batches,
+// so that they don't accumulate until the same slot is overwritten.
+func NewPruner(lggr logger.Logger, config PrunerConfig, orm ORM, clock clockwork.Clock) services.Service {
+	if config.Interval == 0 {
+		config.Interval = DefaultPruneInterval
+	}
+	if config.BatchSize == 0 {
+		config.BatchSize = DefaultPruneBatchSize
+	}
+	return &pruner{
+		lggr:   lggr.Named("S4Pruner"),
+		config: config,
+		orm:    orm,
+		clock:  clock,
+		stopCh: make(services.StopChan),
+	}
+}
+
+func (p *pruner) Start(context.Context) error {
+	return p.StartOnce("S4Pruner", func() error {
+		p.wg.Add(1)
+		go p.run()
+		return nil
+	})
+}
+
+func (p *pruner) Close() error {
+	return p.StopOnce("S4Pruner", func() error {
+		close(p.stopCh)
+		p.wg.Wait()
+		return nil
+	})
+}
+
+func (p *pruner) Name() string {
+	return p.lggr.Name()
+}
+
+func (p *pruner) HealthReport() map[string]error {
+	return map[string]error{p.Name(): p.Healthy()}
+}
+
+func (p *pruner) run() {
+	defer p.wg.Done()
+	ctx, cancel := p.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := p.clock.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.Chan():
+			p.pruneExpired(ctx)
+		}
+	}
+}
+
+func (p *pruner) pruneExpired(ctx context.Context) {
+	var total int64
+	for i := 0; i < defaultMaxBatchesPerRun; i++ {
+		deleted, err := p.orm.DeleteExpired(ctx, p.config.BatchSize, p.clock.Now().UTC())
+		if err != nil {
+			p.lggr.Errorw("Failed to delete expired records", "err", err, "deleted", total)
+			return
+		}
+		total += deleted
+		promPrunedExpiredRecords.Add(float64(deleted))
+		if deleted < int64(p.config.BatchSize) {
+			break
+		}
+	}
+	if total > 0 {
+		p.lggr.Debugw("Deleted expired records", "deleted", total)
+	}
+}
@@ -0,0 +1,68 @@
+package s4_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4/mocks"
+)
+
+func TestPruner_DeletesExpiredInBatches(t *testing.T) {
+	t.Parallel()
+
+	orm := mocks.NewORM(t)
+	clock := clockwork.NewFakeClock()
+	config := s4.PrunerConfig{Interval: time.Minute, BatchSize: 10}
+	pruner := s4.NewPruner(logger.TestLogger(t), config, orm, clock)
+
+	done := make(chan struct{})
+	orm.On("DeleteExpired", mock.Anything, uint(10), clock.Now().Add(time.Minute).UTC()).Return(int64(10), nil).Twice()
+	orm.On("DeleteExpired", mock.Anything, uint(10), clock.Now().Add(time.Minute).UTC()).Return(int64(3), nil).Once().Run(func(mock.Arguments) {
+		close(done)
+	})
+
+	require.NoError(t, pruner.Start(testutils.Context(t)))
+	t.Cleanup(func() { require.NoError(t, pruner.Close()) })
+
+	require.NoError(t, clock.BlockUntilContext(testutils.Context(t), 1))
+	clock.Advance(time.Minute)
+
+	select {
+	case <-done:
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for pruner")
+	}
+}
+
+func TestPruner_StopsRunOnError(t *testing.T) {
+	t.Parallel()
+
+	orm := mocks.NewORM(t)
+	clock := clockwork.NewFakeClock()
+	pruner := s4.NewPruner(logger.TestLogger(t), s4.PrunerConfig{}, orm, clock)
+
+	done := make(chan struct{})
+	orm.On("DeleteExpired", mock.Anything, s4.DefaultPruneBatchSize, mock.Anything).Return(int64(0), errors.New("boom")).Once().Run(func(mock.Arguments) {
+		close(done)
+	})
+
+	require.NoError(t, pruner.Start(testutils.Context(t)))
+	t.Cleanup(func() { require.NoError(t, pruner.Close()) })
+
+	require.NoError(t, clock.BlockUntilContext(testutils.Context(t), 1))
+	clock.Advance(s4.DefaultPruneInterval)
+
+	select {
+	case <-done:
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for pruner")
+	}
+}
@@ -2,6 +2,8 @@ package s4
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/jonboulle/clockwork"
 
@@ -16,6 +18,9 @@ type Constraints struct {
 	MaxPayloadSizeBytes    uint   `json:"maxPayloadSizeBytes"`
 	MaxSlotsPerUser        uint   `json:"maxSlotsPerUser"`
 	MaxExpirationLengthSec uint64 `json:"maxExpirationLengthSec"`
+	// MaxBytesPerUser limits the total payload size of all non-expired records of a single address.
+	// Zero means no limit.
+	MaxBytesPerUser uint64 `json:"maxBytesPerUser"`
 }
 
 // Key identifies a versioned user record.
@@ -44,6 +49,27 @@ type Metadata struct {
 	Signature []byte
 }
 
+// Usage summarizes the storage used by a single address.
+type Usage struct {
+	// SlotsUsed is the number of slots holding a non-expired record.
+	SlotsUsed uint
+	// BytesUsed is the total payload size of the non-expired records.
+	BytesUsed uint64
+}
+
+// UsageOf computes the Usage of the given snapshot (as returned by List), ignoring records expired at now.
+func UsageOf(snapshot []*SnapshotRow, now time.Time) Usage {
+	var usage Usage
+	for _, row := range snapshot {
+		if row.Expiration <= now.UnixMilli() {
+			continue
+		}
+		usage.SlotsUsed++
+		usage.BytesUsed += row.PayloadSize
+	}
+	return usage
+}
+
 //go:generate mockery --quiet --name Storage --output ./mocks/ --case=underscore
 
 // Storage represents S4 storage access interface.
@@ -160,5 +186,12 @@ func (s *storage) Put(ctx context.Context, key *Key, record *Record, signature [
 	copy(row.Payload, record.Payload)
 	copy(row.Signature, signature)
 
-	return s.orm.Update(ctx, row)
+	if s.contraints.MaxBytesPerUser == 0 {
+		return s.orm.Update(ctx, row)
+	}
+	err = s.orm.UpdateWithQuota(ctx, row, s.contraints.MaxBytesPerUser, s.clock.Now())
+	if errors.Is(err, ErrQuotaExceeded) {
+		s.lggr.Debugw("S4 quota exceeded", "address", key.Address, "payloadSize", len(row.Payload), "maxBytesPerUser", s.contraints.MaxBytesPerUser)
+	}
+	return err
 }
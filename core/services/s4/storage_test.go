@@ -235,3 +235,61 @@ func TestStorage_List(t *testing.T) {
 		}
 	}
 }
+
+func TestStorage_Quota(t *testing.T) {
+	t.Parallel()
+
+	quotaConstraints := constraints
+	quotaConstraints.MaxBytesPerUser = 50
+	storage := s4.NewStorage(logger.TestLogger(t), quotaConstraints, s4.NewInMemoryORM(), clockwork.NewRealClock())
+	privateKey, address := testutils.NewPrivateKeyAndAddress(t)
+	expiration := time.Now().Add(time.Hour).UnixMilli()
+
+	put := func(slotId uint, version uint64, payloadSize int) error {
+		key := &s4.Key{
+			Address: address,
+			SlotId:  slotId,
+			Version: version,
+		}
+		record := &s4.Record{
+			Payload:    make([]byte, payloadSize),
+			Expiration: expiration,
+		}
+		signature, err := s4.NewEnvelopeFromRecord(key, record).Sign(privateKey)
+		require.NoError(t, err)
+		return storage.Put(testutils.Context(t), key, record, signature)
+	}
+
+	require.NoError(t, put(0, 0, 20))
+	require.NoError(t, put(1, 0, 20))
+	assert.ErrorIs(t, put(2, 0, 20), s4.ErrQuotaExceeded)
+
+	// overwriting a slot only counts the new payload
+	require.NoError(t, put(0, 1, 30))
+	assert.ErrorIs(t, put(1, 1, 21), s4.ErrQuotaExceeded)
+
+	// other addresses have their own quota
+	otherKey, otherAddress := testutils.NewPrivateKeyAndAddress(t)
+	key := &s4.Key{Address: otherAddress, SlotId: 0}
+	record := &s4.Record{Payload: make([]byte, 30), Expiration: expiration}
+	signature, err := s4.NewEnvelopeFromRecord(key, record).Sign(otherKey)
+	require.NoError(t, err)
+	require.NoError(t, storage.Put(testutils.Context(t), key, record, signature))
+
+	rows, err := storage.List(testutils.Context(t), address)
+	require.NoError(t, err)
+	assert.Equal(t, s4.Usage{SlotsUsed: 2, BytesUsed: 50}, s4.UsageOf(rows, time.Now()))
+}
+
+func TestUsageOf(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	rows := []*s4.SnapshotRow{
+		{SlotId: 0, Expiration: now.Add(time.Minute).UnixMilli(), PayloadSize: 10},
+		{SlotId: 1, Expiration: now.Add(-time.Minute).UnixMilli(), PayloadSize: 20},
+		{SlotId: 2, Expiration: now.Add(time.Hour).UnixMilli(), PayloadSize: 5},
+	}
+	assert.Equal(t, s4.Usage{SlotsUsed: 2, BytesUsed: 15}, s4.UsageOf(rows, now))
+	assert.Equal(t, s4.Usage{}, s4.UsageOf(nil, now))
+}
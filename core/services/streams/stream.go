@@ -101,6 +101,20 @@ func (s *stream) executeRun(ctx context.Context) (*pipeline.Run, pipeline.TaskRu
 // extract any desired type that matches a particular pipeline run output.
 // Returns error on parse errors: if results are wrong type
 func ExtractBigInt(trrs pipeline.TaskRunResults) (*big.Int, error) {
+	res, err := extractResult(trrs)
+	if err != nil {
+		return nil, err
+	}
+	val, err := toBigInt(res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse BenchmarkPrice: %w", err)
+	}
+	return val, nil
+}
+
+// extractResult returns the value of the single terminal result of a pipeline
+// run
+func extractResult(trrs pipeline.TaskRunResults) (interface{}, error) {
 	// pipeline.TaskRunResults comes ordered asc by index, this is guaranteed
 	// by the pipeline executor
 	finaltrrs := trrs.Terminals()
@@ -112,11 +126,7 @@ func ExtractBigInt(trrs pipeline.TaskRunResults) (*big.Int, error) {
 	if res.Error != nil {
 		return nil, res.Error
 	}
-	val, err := toBigInt(res.Value)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse BenchmarkPrice: %w", err)
-	}
-	return val, nil
+	return res.Value, nil
 }
 
 func toBigInt(val interface{}) (*big.Int, error) {
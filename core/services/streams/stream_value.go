@@ -0,0 +1,254 @@
+package streams
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+type StreamValueType uint8
+
+const (
+	StreamValueTypeDecimal StreamValueType = iota
+	StreamValueTypeQuote
+	StreamValueTypeBool
+)
+
+func (t StreamValueType) String() string {
+	switch t {
+	case StreamValueTypeDecimal:
+		return "decimal"
+	case StreamValueTypeQuote:
+		return "quote"
+	case StreamValueTypeBool:
+		return "bool"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(t))
+	}
+}
+
+// StreamValue is the typed value observed for a stream
+type StreamValue interface {
+	Type() StreamValueType
+	// Validate returns an error if the value must not be reported
+	Validate() error
+	json.Marshaler
+}
+
+var (
+	_ StreamValue = Decimal{}
+	_ StreamValue = Quote{}
+	_ StreamValue = Bool(false)
+)
+
+// Decimal is an arbitrary precision decimal, e.g. a price. Its precision is
+// explicit: "1.50" is encoded with two decimal places.
+type Decimal struct {
+	decimal.Decimal
+}
+
+func (Decimal) Type() StreamValueType { return StreamValueTypeDecimal }
+func (Decimal) Validate() error       { return nil }
+
+// MarshalJSON encodes the decimal as a string, keeping its trailing zeros
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if exp := d.Exponent(); exp < 0 {
+		return json.Marshal(d.StringFixed(-exp))
+	}
+	return json.Marshal(d.String())
+}
+
+// Quote is a bid/benchmark/ask triple, e.g. the prices of an order book.
+type Quote struct {
+	Bid       decimal.Decimal `json:"bid"`
+	Benchmark decimal.Decimal `json:"benchmark"`
+	Ask       decimal.Decimal `json:"ask"`
+}
+
+func (Quote) Type() StreamValueType { return StreamValueTypeQuote }
+
+// Validate checks that bid <= benchmark <= ask
+func (q Quote) Validate() error {
+	if q.Bid.GreaterThan(q.Benchmark) {
+		return fmt.Errorf("invalid quote: bid %s is greater than benchmark %s", q.Bid, q.Benchmark)
+	}
+	if q.Benchmark.GreaterThan(q.Ask) {
+		return fmt.Errorf("invalid quote: benchmark %s is greater than ask %s", q.Benchmark, q.Ask)
+	}
+	return nil
+}
+
+func (q Quote) MarshalJSON() ([]byte, error) {
+	type quote Quote
+	return json.Marshal(quote(q))
+}
+
+// Bool is a flag, e.g. whether a market is open. Statuses with more than two
+// states, e.g. of a market, are reported as integer codes, i.e. Decimals.
+type Bool bool
+
+func (Bool) Type() StreamValueType { return StreamValueTypeBool }
+func (Bool) Validate() error       { return nil }
+
+func (b Bool) MarshalJSON() ([]byte, error) { return json.Marshal(bool(b)) }
+
+// ParseStreamValue converts the result of a pipeline run to a StreamValue:
+//   - a map with "bid", "benchmark" and "ask" keys is a Quote
+//   - a bool is a Bool
+//   - anything else convertible to a decimal is a Decimal
+func ParseStreamValue(val interface{}) (StreamValue, error) {
+	switch v := val.(type) {
+	case map[string]interface{}:
+		return parseQuote(v)
+	case bool:
+		return Bool(v), nil
+	default:
+		dec, err := utils.ToDecimal(v)
+		if err != nil {
+			return nil, err
+		}
+		return Decimal{dec}, nil
+	}
+}
+
+func parseQuote(m map[string]interface{}) (q Quote, err error) {
+	for _, f := range []struct {
+		key string
+		dst *decimal.Decimal
+	}{
+		{"bid", &q.Bid},
+		{"benchmark", &q.Benchmark},
+		{"ask", &q.Ask},
+	} {
+		v, ok := m[f.key]
+		if !ok {
+			return q, fmt.Errorf("invalid quote: missing %q", f.key)
+		}
+		if *f.dst, err = utils.ToDecimal(v); err != nil {
+			return q, fmt.Errorf("invalid quote: %q: %w", f.key, err)
+		}
+	}
+	return q, nil
+}
+
+// ExtractStreamValue returns the result of a pipeline run that returns one
+// single result, as a validated StreamValue.
+func ExtractStreamValue(trrs pipeline.TaskRunResults) (StreamValue, error) {
+	res, err := extractResult(trrs)
+	if err != nil {
+		return nil, err
+	}
+	val, err := ParseStreamValue(res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse stream value: %w", err)
+	}
+	if err := val.Validate(); err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+const (
+	bigIntTypeBits = 2
+	quoteFieldBits = 192
+	typedValueBits = 640
+)
+
+var (
+	// typedValueOffset is where the integers of values other than decimals
+	// start, out of the range of decimals
+	typedValueOffset = new(big.Int).Lsh(big.NewInt(1), typedValueBits)
+	// quoteFieldOffset makes the int192 fields of a quote non-negative
+	quoteFieldOffset = new(big.Int).Lsh(big.NewInt(1), quoteFieldBits-1)
+)
+
+// EncodeBigInt packs the value into an integer, so that it can be carried by
+// LLO observations and reports, which only hold integers, and recovered with
+// DecodeBigInt:
+//   - decimals are truncated to integers, like ExtractBigInt does, so streams
+//     which report scaled prices keep doing so
+//   - quotes and bools are offset out of the range of decimals; the two lowest
+//     bits hold the type of the value and the rest its payload, i.e. the
+//     benchmark, bid and ask of quotes truncated to int192s, or 0 or 1 for
+//     bools
+//
+// Integers of values of the same type are ordered like the decimals, or the
+// benchmarks of the quotes, so their median encodes the median value.
+func EncodeBigInt(val StreamValue) (*big.Int, error) {
+	var payload *big.Int
+	switch v := val.(type) {
+	case Decimal:
+		i := v.BigInt()
+		if i.CmpAbs(typedValueOffset) >= 0 {
+			return nil, fmt.Errorf("cannot encode decimal: %s overflows %d bits", v, typedValueBits)
+		}
+		return i, nil
+	case Quote:
+		payload = new(big.Int)
+		for _, d := range []decimal.Decimal{v.Benchmark, v.Bid, v.Ask} {
+			field := d.BigInt()
+			field.Add(field, quoteFieldOffset)
+			if field.Sign() < 0 || field.BitLen() > quoteFieldBits {
+				return nil, fmt.Errorf("cannot encode quote: %s overflows int192", d)
+			}
+			payload.Lsh(payload, quoteFieldBits).Add(payload, field)
+		}
+	case Bool:
+		payload = big.NewInt(0)
+		if v {
+			payload.SetInt64(1)
+		}
+	default:
+		return nil, fmt.Errorf("cannot encode %s stream value as integer", val.Type())
+	}
+	payload.Lsh(payload, bigIntTypeBits)
+	payload.Add(payload, big.NewInt(int64(val.Type())))
+	return payload.Add(payload, typedValueOffset), nil
+}
+
+// DecodeBigInt returns the value packed into the integer by EncodeBigInt.
+// Integers which are not the encoding of a quote or bool are decimals.
+func DecodeBigInt(i *big.Int) (StreamValue, error) {
+	if i == nil {
+		return nil, errors.New("cannot decode nil integer")
+	}
+	if i.Cmp(typedValueOffset) < 0 {
+		return Decimal{decimal.NewFromBigInt(i, 0)}, nil
+	}
+	packed := new(big.Int).Sub(i, typedValueOffset)
+	if packed.BitLen() > typedValueBits {
+		return nil, fmt.Errorf("cannot decode stream value: %s overflows %d bits", i, typedValueBits)
+	}
+	payload, typ := splitLowBits(packed, bigIntTypeBits)
+	switch t := StreamValueType(typ.Uint64()); t {
+	case StreamValueTypeQuote:
+		rest, ask := splitLowBits(payload, quoteFieldBits)
+		benchmark, bid := splitLowBits(rest, quoteFieldBits)
+		return Quote{
+			Bid:       decimal.NewFromBigInt(bid.Sub(bid, quoteFieldOffset), 0),
+			Benchmark: decimal.NewFromBigInt(benchmark.Sub(benchmark, quoteFieldOffset), 0),
+			Ask:       decimal.NewFromBigInt(ask.Sub(ask, quoteFieldOffset), 0),
+		}, nil
+	case StreamValueTypeBool:
+		if payload.Sign() != 0 && payload.Cmp(big.NewInt(1)) != 0 {
+			return nil, fmt.Errorf("cannot decode bool from %s", payload)
+		}
+		return Bool(payload.Sign() != 0), nil
+	default:
+		return nil, fmt.Errorf("cannot decode %s stream value from integer", t)
+	}
+}
+
+// splitLowBits returns high and low such that i = high<<n + low, with
+// 0 <= low < 1<<n.
+func splitLowBits(i *big.Int, n uint) (high, low *big.Int) {
+	high = new(big.Int).Rsh(i, n)
+	low = new(big.Int).Sub(i, new(big.Int).Lsh(high, n))
+	return high, low
+}
@@ -0,0 +1,178 @@
+package streams
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func Test_ParseStreamValue(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    interface{}
+		expected StreamValue
+	}{
+		{"decimal from string", "1.50", Decimal{decimal.RequireFromString("1.50")}},
+		{"decimal from number", 42, Decimal{decimal.New(42, 0)}},
+		{"decimal from big.Int", big.NewInt(7), Decimal{decimal.New(7, 0)}},
+		{"quote", map[string]interface{}{"bid": "1.1", "benchmark": 2, "ask": "3.3"}, Quote{decimal.RequireFromString("1.1"), decimal.New(2, 0), decimal.RequireFromString("3.3")}},
+		{"bool", true, Bool(true)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			val, err := ParseStreamValue(tc.input)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, val)
+		})
+	}
+
+	t.Run("quote with missing field", func(t *testing.T) {
+		_, err := ParseStreamValue(map[string]interface{}{"bid": "1", "ask": "2"})
+		assert.EqualError(t, err, `invalid quote: missing "benchmark"`)
+	})
+	t.Run("string which is not a number", func(t *testing.T) {
+		_, err := ParseStreamValue("open")
+		assert.Error(t, err)
+	})
+	t.Run("unsupported type", func(t *testing.T) {
+		_, err := ParseStreamValue([]byte{1, 2, 3})
+		assert.EqualError(t, err, "type []uint8 cannot be converted to decimal.Decimal ([1 2 3])")
+	})
+}
+
+func Test_StreamValue_Validate(t *testing.T) {
+	q := Quote{Bid: decimal.New(1, 0), Benchmark: decimal.New(2, 0), Ask: decimal.New(3, 0)}
+	assert.NoError(t, q.Validate())
+
+	q.Bid = decimal.New(4, 0)
+	assert.EqualError(t, q.Validate(), "invalid quote: bid 4 is greater than benchmark 2")
+
+	q.Bid, q.Ask = decimal.New(1, 0), decimal.New(1, 0)
+	assert.EqualError(t, q.Validate(), "invalid quote: benchmark 2 is greater than ask 1")
+}
+
+func Test_StreamValue_MarshalJSON(t *testing.T) {
+	vals := []StreamValue{
+		Decimal{decimal.RequireFromString("1.50")},
+		Quote{decimal.RequireFromString("1.1"), decimal.New(2, 0), decimal.RequireFromString("3.3")},
+		Bool(false),
+	}
+	b, err := json.Marshal(vals)
+	require.NoError(t, err)
+	assert.Equal(t, `["1.50",{"bid":"1.1","benchmark":"2","ask":"3.3"},false]`, string(b))
+}
+
+func Test_ExtractStreamValue(t *testing.T) {
+	t.Run("wrong number of inputs", func(t *testing.T) {
+		_, err := ExtractStreamValue([]pipeline.TaskRunResult{})
+		assert.EqualError(t, err, "invalid number of results, expected: 1, got: 0")
+	})
+	t.Run("invalid value", func(t *testing.T) {
+		trrs := []pipeline.TaskRunResult{
+			{
+				Result: pipeline.Result{Value: map[string]interface{}{"bid": "3", "benchmark": "2", "ask": "1"}},
+				Task:   &MockTask{},
+			},
+		}
+
+		_, err := ExtractStreamValue(trrs)
+		assert.EqualError(t, err, "invalid quote: bid 3 is greater than benchmark 2")
+	})
+	t.Run("correct inputs", func(t *testing.T) {
+		trrs := []pipeline.TaskRunResult{
+			{
+				Result: pipeline.Result{Value: "122.345"},
+				Task:   &MockTask{},
+			},
+		}
+
+		val, err := ExtractStreamValue(trrs)
+		require.NoError(t, err)
+		assert.Equal(t, Decimal{decimal.RequireFromString("122.345")}, val)
+	})
+}
+
+func Test_EncodeBigInt(t *testing.T) {
+	t.Run("round trips", func(t *testing.T) {
+		for _, val := range []StreamValue{
+			Decimal{decimal.New(122345, 0)},
+			Decimal{decimal.New(-1, 0)},
+			Decimal{decimal.Zero},
+			Quote{Bid: decimal.New(-15, 0), Benchmark: decimal.New(-12, 0), Ask: decimal.New(-1, 0)},
+			Quote{Bid: decimal.New(995, 0), Benchmark: decimal.New(1002, 0), Ask: decimal.New(1010, 0)},
+			Bool(true),
+			Bool(false),
+		} {
+			i, err := EncodeBigInt(val)
+			require.NoError(t, err)
+			decoded, err := DecodeBigInt(i)
+			require.NoError(t, err)
+			require.Equal(t, val.Type(), decoded.Type())
+			assertStreamValueEqual(t, val, decoded)
+		}
+	})
+	t.Run("encodes decimals as their integer parts", func(t *testing.T) {
+		i, err := EncodeBigInt(Decimal{decimal.RequireFromString("122.345")})
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(122), i)
+
+		i, err = EncodeBigInt(Decimal{decimal.RequireFromString("-2.5")})
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(-2), i)
+	})
+	t.Run("decodes any integer outside of the range of quotes and bools as a decimal", func(t *testing.T) {
+		val, err := DecodeBigInt(big.NewInt(40602))
+		require.NoError(t, err)
+		assert.Equal(t, Decimal{decimal.New(40602, 0)}, val)
+	})
+	t.Run("keeps the order of decimals and of the benchmarks of quotes", func(t *testing.T) {
+		quote := func(bid, benchmark, ask int64) StreamValue {
+			return Quote{Bid: decimal.New(bid, 0), Benchmark: decimal.New(benchmark, 0), Ask: decimal.New(ask, 0)}
+		}
+		for _, ordered := range [][]StreamValue{
+			{Decimal{decimal.New(-25, 0)}, Decimal{decimal.New(-24, 0)}, Decimal{decimal.Zero}, Decimal{decimal.New(1, 0)}},
+			{quote(-3, -2, 5), quote(-5, -1, -1), quote(0, 0, 0), quote(-100, 1, 100)},
+		} {
+			var prev *big.Int
+			for _, val := range ordered {
+				i, err := EncodeBigInt(val)
+				require.NoError(t, err)
+				if prev != nil {
+					assert.Equal(t, 1, i.Cmp(prev), "expected %s to encode greater than the previous value", val)
+				}
+				prev = i
+			}
+		}
+	})
+	t.Run("errors on decimals overflowing into the range of quotes and bools", func(t *testing.T) {
+		_, err := EncodeBigInt(Decimal{decimal.New(1, 200)})
+		assert.ErrorContains(t, err, "overflows 640 bits")
+	})
+	t.Run("errors on quotes overflowing int192", func(t *testing.T) {
+		_, err := EncodeBigInt(Quote{Bid: decimal.Zero, Benchmark: decimal.New(1, 60), Ask: decimal.New(1, 60)})
+		assert.EqualError(t, err, "cannot encode quote: 1000000000000000000000000000000000000000000000000000000000000 overflows int192")
+	})
+	t.Run("errors on unknown types", func(t *testing.T) {
+		_, err := DecodeBigInt(new(big.Int).Add(typedValueOffset, big.NewInt(3)))
+		assert.EqualError(t, err, "cannot decode unknown(3) stream value from integer")
+	})
+}
+
+func assertStreamValueEqual(t *testing.T, expected, actual StreamValue) {
+	t.Helper()
+	switch e := expected.(type) {
+	case Decimal:
+		assert.True(t, e.Equal(actual.(Decimal).Decimal), "expected %s, got %s", e, actual)
+	case Quote:
+		a := actual.(Quote)
+		assert.True(t, e.Bid.Equal(a.Bid) && e.Benchmark.Equal(a.Benchmark) && e.Ask.Equal(a.Ask), "expected %v, got %v", e, a)
+	default:
+		assert.Equal(t, expected, actual)
+	}
+}
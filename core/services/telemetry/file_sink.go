@@ -0,0 +1,163 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+var _ Sink = &FileSink{}
+
+// FileSink writes telemetry to a file as newline delimited JSON, rotating it once it grows too large. Messages
+// are buffered and written in the background, dropping new messages while the buffer is full.
+type FileSink struct {
+	services.StateMachine
+	lggr logger.Logger
+	path string
+	w    *lumberjack.Logger
+
+	chMessages       chan SinkMessage
+	dropMessageCount atomic.Uint32
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+// fileSinkRecord is a line of the file. The telemetry is encoded as base64, as it is usually a serialized protobuf.
+type fileSinkRecord struct {
+	Timestamp     time.Time                     `json:"timestamp"`
+	Network       string                        `json:"network"`
+	ChainID       string                        `json:"chainID"`
+	ContractID    string                        `json:"contractID"`
+	TelemetryType synchronization.TelemetryType `json:"telemetryType"`
+	Telemetry     []byte                        `json:"telemetry"`
+}
+
+// NewFileSink creates a FileSink writing to path. The file is rotated once it reaches maxSize, keeping
+// up to maxBackups rotated files. A maxSize of 0 disables rotation, and a maxBackups of 0 keeps all files.
+// Up to bufferSize messages are buffered while waiting to be written.
+func NewFileSink(path string, maxSize utils.FileSize, maxBackups int64, bufferSize uint, lggr logger.Logger) *FileSink {
+	w := &lumberjack.Logger{
+		Filename:   path,
+		MaxBackups: int(maxBackups),
+	}
+	if maxSize > 0 {
+		// lumberjack only supports sizes in megabytes
+		w.MaxSize = int((maxSize + utils.MB - 1) / utils.MB)
+	} else {
+		// lumberjack defaults to 100mb when MaxSize is unset
+		w.MaxSize = math.MaxInt32
+	}
+	return &FileSink{
+		lggr:       lggr.Named("TelemetryFileSink"),
+		path:       path,
+		w:          w,
+		chMessages: make(chan SinkMessage, bufferSize),
+		stopCh:     make(services.StopChan),
+	}
+}
+
+func (s *FileSink) Start(context.Context) error {
+	return s.StartOnce("TelemetryFileSink", func() error {
+		if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+			return errors.Wrap(err, "failed to create telemetry directory")
+		}
+		s.lggr.Infow("Writing telemetry to file", "path", s.path)
+
+		s.wg.Add(1)
+		go s.run()
+		return nil
+	})
+}
+
+// Close writes the buffered messages before closing the file.
+func (s *FileSink) Close() error {
+	return s.StopOnce("TelemetryFileSink", func() error {
+		close(s.stopCh)
+		s.wg.Wait()
+		return s.w.Close()
+	})
+}
+
+func (s *FileSink) Name() string {
+	return s.lggr.Name()
+}
+
+func (s *FileSink) HealthReport() map[string]error {
+	return map[string]error{s.Name(): s.Healthy()}
+}
+
+// Send queues the message to be appended to the file
+func (s *FileSink) Send(msg SinkMessage) {
+	s.IfStarted(func() {
+		select {
+		case s.chMessages <- msg:
+			s.dropMessageCount.Store(0)
+		default:
+			s.logBufferFullWithExpBackoff()
+		}
+	})
+}
+
+// logBufferFullWithExpBackoff logs messages at 1, 2, 4, 8, 16, 32, 64, 100, 200, 300, etc. dropped messages
+func (s *FileSink) logBufferFullWithExpBackoff() {
+	promSinkDroppedMessages.WithLabelValues("file").Inc()
+	count := s.dropMessageCount.Add(1)
+	if count > 0 && (count%100 == 0 || count&(count-1) == 0) {
+		s.lggr.Warnw("telemetry file sink buffer full, dropping message", "droppedCount", count)
+	}
+}
+
+func (s *FileSink) run() {
+	defer s.wg.Done()
+
+	for {
+		select {
+		case <-s.stopCh:
+			for {
+				select {
+				case msg := <-s.chMessages:
+					s.write(msg)
+				default:
+					return
+				}
+			}
+		case msg := <-s.chMessages:
+			s.write(msg)
+		}
+	}
+}
+
+func (s *FileSink) write(msg SinkMessage) {
+	line, err := json.Marshal(fileSinkRecord{
+		Timestamp:     msg.Timestamp,
+		Network:       msg.Network,
+		ChainID:       msg.ChainID,
+		ContractID:    msg.ContractID,
+		TelemetryType: msg.TelemType,
+		Telemetry:     msg.Telemetry,
+	})
+	if err != nil {
+		s.lggr.Errorw("Failed to encode telemetry", "err", err)
+		return
+	}
+	line = append(line, '\n')
+
+	if _, err := s.w.Write(line); err != nil {
+		s.lggr.Errorw("Failed to write telemetry", "err", err, "path", s.path)
+	}
+}
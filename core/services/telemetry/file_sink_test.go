@@ -0,0 +1,78 @@
+package telemetry
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "telemetry", "telemetry.ndjson")
+	sink := NewFileSink(path, utils.MB, 1, 10, logger.TestLogger(t))
+
+	// Messages sent before the sink is started are dropped
+	sink.Send(SinkMessage{Telemetry: []byte("dropped")})
+
+	require.NoError(t, sink.Start(testutils.Context(t)))
+	now := time.Now().UTC()
+	for _, telem := range []string{"first", "second"} {
+		sink.Send(SinkMessage{
+			Timestamp:  now,
+			Network:    "EVM",
+			ChainID:    "1",
+			ContractID: "0xabc",
+			TelemType:  synchronization.OCR2Median,
+			Telemetry:  []byte(telem),
+		})
+	}
+	require.NoError(t, sink.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var records []fileSinkRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var r fileSinkRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &r))
+		records = append(records, r)
+	}
+	require.NoError(t, scanner.Err())
+
+	require.Len(t, records, 2)
+	assert.Equal(t, []byte("first"), records[0].Telemetry)
+	assert.Equal(t, []byte("second"), records[1].Telemetry)
+	assert.Equal(t, "EVM", records[0].Network)
+	assert.Equal(t, "1", records[0].ChainID)
+	assert.Equal(t, "0xabc", records[0].ContractID)
+	assert.Equal(t, synchronization.OCR2Median, records[0].TelemetryType)
+	assert.True(t, now.Equal(records[0].Timestamp))
+}
+
+func TestFileSink_BufferFull(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "telemetry.ndjson")
+	sink := NewFileSink(path, utils.MB, 1, 1, logger.TestLogger(t))
+	// Mark the sink as started without running the writer, so that the buffer fills up
+	require.NoError(t, sink.StartOnce("TelemetryFileSink", func() error { return nil }))
+
+	dropped := promtestutil.ToFloat64(promSinkDroppedMessages.WithLabelValues("file"))
+	sink.Send(SinkMessage{Telemetry: []byte("buffered")})
+	sink.Send(SinkMessage{Telemetry: []byte("dropped")})
+	assert.Equal(t, dropped+1, promtestutil.ToFloat64(promSinkDroppedMessages.WithLabelValues("file")))
+	assert.Equal(t, uint32(1), sink.dropMessageCount.Load())
+	assert.Len(t, sink.chMessages, 1)
+	require.NoError(t, sink.Close())
+}
@@ -30,6 +30,7 @@ type Manager struct {
 	sendTimeout                 time.Duration
 	uniConn                     bool
 	useBatchSend                bool
+	sinks                       []Sink
 	MonitoringEndpointGenerator MonitoringEndpointGenerator
 }
 
@@ -60,6 +61,12 @@ func NewManager(cfg config.TelemetryIngress, csaKeyStore keystore.CSA, lggr logg
 			m.lggr.Error(err)
 		}
 	}
+	if fs := cfg.FileSink(); fs.Enabled() {
+		m.sinks = append(m.sinks, NewFileSink(fs.Path(), fs.MaxSize(), fs.MaxBackups(), m.bufferSize, m.lggr))
+	}
+	if otlp := cfg.OTLPSink(); otlp.Enabled() {
+		m.sinks = append(m.sinks, NewOTLPSink(otlp, m.bufferSize, m.maxBatchSize, m.sendInterval, m.sendTimeout, m.lggr))
+	}
 	return m
 }
 
@@ -69,6 +76,9 @@ func (m *Manager) Start(ctx context.Context) error {
 		for _, e := range m.endpoints {
 			err = multierr.Append(err, e.client.Start(ctx))
 		}
+		for _, s := range m.sinks {
+			err = multierr.Append(err, s.Start(ctx))
+		}
 		return err
 	})
 }
@@ -78,6 +88,9 @@ func (m *Manager) Close() error {
 		for _, e := range m.endpoints {
 			err = multierr.Append(err, e.client.Close())
 		}
+		for _, s := range m.sinks {
+			err = multierr.Append(err, s.Close())
+		}
 		return err
 	})
 }
@@ -92,11 +105,23 @@ func (m *Manager) HealthReport() map[string]error {
 	for _, e := range m.endpoints {
 		services.CopyHealth(hr, e.client.HealthReport())
 	}
+	for _, s := range m.sinks {
+		services.CopyHealth(hr, s.HealthReport())
+	}
 	return hr
 }
 
 // GenMonitoringEndpoint creates a new monitoring endpoints based on the existing available endpoints defined in the core config TOML, if no endpoint for the network and chainID exists, a NOOP agent will be used and the telemetry will not be sent
+// to the ingress server. If any sinks are enabled, the telemetry is sent to them regardless.
 func (m *Manager) GenMonitoringEndpoint(network string, chainID string, contractID string, telemType synchronization.TelemetryType) commontypes.MonitoringEndpoint {
+	endpoint := m.genIngressMonitoringEndpoint(network, chainID, contractID, telemType)
+	if len(m.sinks) == 0 {
+		return endpoint
+	}
+	return NewSinkAgent(endpoint, m.sinks, network, chainID, contractID, telemType)
+}
+
+func (m *Manager) genIngressMonitoringEndpoint(network string, chainID string, contractID string, telemType synchronization.TelemetryType) commontypes.MonitoringEndpoint {
 	e, found := m.getEndpoint(network, chainID)
 
 	if !found {
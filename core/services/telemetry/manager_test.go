@@ -1,9 +1,11 @@
 package telemetry
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"net/url"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -13,6 +15,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
@@ -21,6 +25,7 @@ import (
 	mocks3 "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
 	mocks2 "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func setupMockConfig(t *testing.T, useBatchSend bool) *mocks.TelemetryIngress {
@@ -33,6 +38,13 @@ func setupMockConfig(t *testing.T, useBatchSend bool) *mocks.TelemetryIngress {
 	tic.On("UniConn").Return(true)
 	tic.On("UseBatchSend").Return(useBatchSend)
 
+	fs := mocks.NewTelemetryFileSink(t)
+	fs.On("Enabled").Return(false)
+	tic.On("FileSink").Return(fs)
+	otlp := mocks.NewTelemetryOTLPSink(t)
+	otlp.On("Enabled").Return(false)
+	tic.On("OTLPSink").Return(otlp)
+
 	return tic
 }
 
@@ -280,3 +292,56 @@ func TestCorrectEndpointRouting(t *testing.T) {
 		require.Equal(t, []byte(e.chainID), clientSent[i].Telemetry)
 	}
 }
+
+type fakeSink struct {
+	services.StateMachine
+	sent []SinkMessage
+}
+
+func (f *fakeSink) Start(context.Context) error    { return nil }
+func (f *fakeSink) Close() error                   { return nil }
+func (f *fakeSink) Name() string                   { return "fakeSink" }
+func (f *fakeSink) HealthReport() map[string]error { return map[string]error{f.Name(): nil} }
+func (f *fakeSink) Send(msg SinkMessage)           { f.sent = append(f.sent, msg) }
+
+func TestManagerSinks(t *testing.T) {
+	tic := mocks.NewTelemetryIngress(t)
+	tic.On("BufferSize").Return(uint(123))
+	tic.On("Logging").Return(true)
+	tic.On("MaxBatchSize").Return(uint(51))
+	tic.On("SendInterval").Return(time.Millisecond * 512)
+	tic.On("SendTimeout").Return(time.Second * 7)
+	tic.On("UniConn").Return(true)
+	tic.On("UseBatchSend").Return(true)
+	tic.On("Endpoints").Return(nil)
+
+	fs := mocks.NewTelemetryFileSink(t)
+	fs.On("Enabled").Return(true)
+	fs.On("Path").Return(filepath.Join(t.TempDir(), "telemetry.ndjson"))
+	fs.On("MaxSize").Return(utils.MB)
+	fs.On("MaxBackups").Return(int64(1))
+	tic.On("FileSink").Return(fs)
+	otlp := mocks.NewTelemetryOTLPSink(t)
+	otlp.On("Enabled").Return(true)
+	tic.On("OTLPSink").Return(otlp)
+
+	lggr, _ := logger.TestLoggerObserved(t, zapcore.InfoLevel)
+	tm := NewManager(tic, mocks3.NewCSA(t), lggr)
+	require.Len(t, tm.sinks, 2)
+	require.Equal(t, "*telemetry.FileSink", reflect.TypeOf(tm.sinks[0]).String())
+	require.Equal(t, "*telemetry.OTLPSink", reflect.TypeOf(tm.sinks[1]).String())
+
+	// Telemetry is sent to the sinks even without an ingress endpoint
+	sink := &fakeSink{}
+	tm.sinks = []Sink{sink}
+	me := tm.GenMonitoringEndpoint("network-1", "network-1-chainID-1", "contractID", "some-type")
+	require.Equal(t, "*telemetry.SinkAgent", reflect.TypeOf(me).String())
+	me.SendLog([]byte("telemetry"))
+
+	require.Len(t, sink.sent, 1)
+	require.Equal(t, "network-1", sink.sent[0].Network)
+	require.Equal(t, "network-1-chainID-1", sink.sent[0].ChainID)
+	require.Equal(t, "contractID", sink.sent[0].ContractID)
+	require.Equal(t, synchronization.TelemetryType("some-type"), sink.sent[0].TelemType)
+	require.Equal(t, []byte("telemetry"), sink.sent[0].Telemetry)
+}
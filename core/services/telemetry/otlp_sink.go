@@ -0,0 +1,209 @@
+package telemetry
+
+import (
+	"context"
+	"crypto/tls"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/static"
+)
+
+var _ Sink = &OTLPSink{}
+
+// OTLPSink exports telemetry as OTLP log records to a collector over gRPC. Messages are buffered and
+// exported in batches, dropping new messages while the buffer is full.
+type OTLPSink struct {
+	services.StateMachine
+	lggr         logger.Logger
+	cfg          config.TelemetryOTLPSink
+	maxBatchSize uint
+	sendInterval time.Duration
+	sendTimeout  time.Duration
+
+	chMessages       chan SinkMessage
+	dropMessageCount atomic.Uint32
+
+	conn   *grpc.ClientConn
+	client collogspb.LogsServiceClient
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+// NewOTLPSink creates an OTLPSink, which batches telemetry the same way as the ingress batch client.
+func NewOTLPSink(cfg config.TelemetryOTLPSink, bufferSize uint, maxBatchSize uint, sendInterval time.Duration, sendTimeout time.Duration, lggr logger.Logger) *OTLPSink {
+	return &OTLPSink{
+		lggr:         lggr.Named("TelemetryOTLPSink"),
+		cfg:          cfg,
+		maxBatchSize: maxBatchSize,
+		sendInterval: sendInterval,
+		sendTimeout:  sendTimeout,
+		chMessages:   make(chan SinkMessage, bufferSize),
+		stopCh:       make(services.StopChan),
+	}
+}
+
+func (s *OTLPSink) Start(context.Context) error {
+	return s.StartOnce("TelemetryOTLPSink", func() error {
+		creds, err := s.transportCredentials()
+		if err != nil {
+			return err
+		}
+		// Dial does not block, the connection is established on the first export
+		s.conn, err = grpc.Dial(s.cfg.CollectorTarget(), grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return errors.Wrapf(err, "failed to dial OTLP collector %s", s.cfg.CollectorTarget())
+		}
+		s.client = collogspb.NewLogsServiceClient(s.conn)
+
+		s.wg.Add(1)
+		go s.run()
+		return nil
+	})
+}
+
+func (s *OTLPSink) transportCredentials() (credentials.TransportCredentials, error) {
+	if s.cfg.Mode() == "unencrypted" {
+		return insecure.NewCredentials(), nil
+	}
+	if path := s.cfg.TLSCertPath(); path != "" {
+		creds, err := credentials.NewClientTLSFromFile(path, "")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load OTLP collector TLS certificate")
+		}
+		return creds, nil
+	}
+	return credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12}), nil
+}
+
+func (s *OTLPSink) Close() error {
+	return s.StopOnce("TelemetryOTLPSink", func() error {
+		close(s.stopCh)
+		s.wg.Wait()
+		return s.conn.Close()
+	})
+}
+
+func (s *OTLPSink) Name() string {
+	return s.lggr.Name()
+}
+
+func (s *OTLPSink) HealthReport() map[string]error {
+	return map[string]error{s.Name(): s.Healthy()}
+}
+
+// Send queues the message to be exported with the next batch
+func (s *OTLPSink) Send(msg SinkMessage) {
+	select {
+	case s.chMessages <- msg:
+		s.dropMessageCount.Store(0)
+	default:
+		s.logBufferFullWithExpBackoff()
+	}
+}
+
+// logBufferFullWithExpBackoff logs messages at
+// 1
+// 2
+// 4
+// 8
+// 16
+// 32
+// 64
+// 100
+// 200
+// 300
+// etc...
+func (s *OTLPSink) logBufferFullWithExpBackoff() {
+	promSinkDroppedMessages.WithLabelValues("otlp").Inc()
+	count := s.dropMessageCount.Add(1)
+	if count > 0 && (count%100 == 0 || count&(count-1) == 0) {
+		s.lggr.Warnw("telemetry OTLP sink buffer full, dropping message", "droppedCount", count)
+	}
+}
+
+func (s *OTLPSink) run() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.sendInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			for len(s.chMessages) > 0 {
+				s.export(s.buildRequest())
+			}
+		}
+	}
+}
+
+// buildRequest reads messages off the buffer up to the max batch size and converts them to log records
+func (s *OTLPSink) buildRequest() *collogspb.ExportLogsServiceRequest {
+	var records []*logspb.LogRecord
+	for len(s.chMessages) > 0 && len(records) < int(s.maxBatchSize) {
+		msg := <-s.chMessages
+		records = append(records, &logspb.LogRecord{
+			TimeUnixNano:         uint64(msg.Timestamp.UnixNano()),
+			ObservedTimeUnixNano: uint64(msg.Timestamp.UnixNano()),
+			SeverityNumber:       logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+			SeverityText:         "INFO",
+			Body:                 &commonpb.AnyValue{Value: &commonpb.AnyValue_BytesValue{BytesValue: msg.Telemetry}},
+			Attributes: []*commonpb.KeyValue{
+				stringAttribute("network", msg.Network),
+				stringAttribute("chain_id", msg.ChainID),
+				stringAttribute("contract_id", msg.ContractID),
+				stringAttribute("telemetry_type", string(msg.TelemType)),
+			},
+		})
+	}
+
+	return &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: &resourcepb.Resource{
+				Attributes: []*commonpb.KeyValue{
+					stringAttribute("service.name", "chainlink"),
+					stringAttribute("service.version", static.Version),
+				},
+			},
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope:      &commonpb.InstrumentationScope{Name: "telemetry"},
+				LogRecords: records,
+			}},
+		}},
+	}
+}
+
+func (s *OTLPSink) export(req *collogspb.ExportLogsServiceRequest) {
+	ctx, cancel := s.stopCh.CtxCancel(context.WithTimeout(context.Background(), s.sendTimeout))
+	defer cancel()
+
+	resp, err := s.client.Export(ctx, req)
+	if err != nil {
+		s.lggr.Warnw("Could not export telemetry to OTLP collector", "err", err, "target", s.cfg.CollectorTarget())
+		return
+	}
+	if ps := resp.GetPartialSuccess(); ps != nil && ps.GetRejectedLogRecords() > 0 {
+		s.lggr.Warnw("OTLP collector rejected telemetry", "rejected", ps.GetRejectedLogRecords(), "msg", ps.GetErrorMessage())
+	}
+}
+
+func stringAttribute(key string, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}}
+}
@@ -0,0 +1,114 @@
+package telemetry
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/grpc"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
+)
+
+type fakeLogsServer struct {
+	collogspb.UnimplementedLogsServiceServer
+
+	mu       sync.Mutex
+	requests []*collogspb.ExportLogsServiceRequest
+}
+
+func (f *fakeLogsServer) Export(_ context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, req)
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
+
+func (f *fakeLogsServer) records() []*logspb.LogRecord {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var records []*logspb.LogRecord
+	for _, req := range f.requests {
+		for _, rl := range req.ResourceLogs {
+			for _, sl := range rl.ScopeLogs {
+				records = append(records, sl.LogRecords...)
+			}
+		}
+	}
+	return records
+}
+
+func TestOTLPSink(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &fakeLogsServer{}
+	s := grpc.NewServer()
+	collogspb.RegisterLogsServiceServer(s, server)
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	cfg := mocks.NewTelemetryOTLPSink(t)
+	cfg.On("CollectorTarget").Return(lis.Addr().String())
+	cfg.On("Mode").Return("unencrypted")
+
+	sink := NewOTLPSink(cfg, 10, 2, 10*time.Millisecond, time.Second, logger.TestLogger(t))
+	require.NoError(t, sink.Start(testutils.Context(t)))
+	t.Cleanup(func() { require.NoError(t, sink.Close()) })
+
+	now := time.Now()
+	for _, telem := range []string{"first", "second", "third"} {
+		sink.Send(SinkMessage{
+			Timestamp:  now,
+			Network:    "EVM",
+			ChainID:    "1",
+			ContractID: "0xabc",
+			TelemType:  synchronization.OCR2Median,
+			Telemetry:  []byte(telem),
+		})
+	}
+
+	require.Eventually(t, func() bool { return len(server.records()) == 3 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+	records := server.records()
+	assert.Equal(t, []byte("first"), records[0].Body.GetBytesValue())
+	assert.Equal(t, []byte("third"), records[2].Body.GetBytesValue())
+	assert.Equal(t, uint64(now.UnixNano()), records[0].TimeUnixNano)
+
+	attributes := map[string]string{}
+	for _, kv := range records[0].Attributes {
+		attributes[kv.Key] = kv.Value.GetStringValue()
+	}
+	assert.Equal(t, map[string]string{
+		"network":        "EVM",
+		"chain_id":       "1",
+		"contract_id":    "0xabc",
+		"telemetry_type": "ocr2-median",
+	}, attributes)
+
+	// The batch size is respected
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	for _, req := range server.requests {
+		assert.LessOrEqual(t, len(req.ResourceLogs[0].ScopeLogs[0].LogRecords), 2)
+	}
+}
+
+func TestOTLPSink_DropsWhenBufferFull(t *testing.T) {
+	cfg := mocks.NewTelemetryOTLPSink(t)
+	sink := NewOTLPSink(cfg, 1, 1, time.Hour, time.Second, logger.TestLogger(t))
+
+	sink.Send(SinkMessage{Telemetry: []byte("buffered")})
+	sink.Send(SinkMessage{Telemetry: []byte("dropped")})
+
+	assert.Len(t, sink.chMessages, 1)
+	assert.Equal(t, uint32(1), sink.dropMessageCount.Load())
+}
@@ -0,0 +1,77 @@
+package telemetry
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ocrtypes "github.com/smartcontractkit/libocr/commontypes"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
+)
+
+var promSinkDroppedMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "telemetry_sink_dropped_messages_total",
+	Help: "Number of telemetry messages dropped by a sink because its buffer was full",
+}, []string{"sink"})
+
+// Sink receives a copy of every telemetry message, in addition to the ingress server. Sinks are
+// used to inspect telemetry locally or to feed it into another observability stack.
+type Sink interface {
+	services.Service
+	// Send must not block, as it is called by the monitoring endpoints of the plugins.
+	Send(msg SinkMessage)
+}
+
+// SinkMessage is a telemetry message along with where it originates from.
+type SinkMessage struct {
+	Timestamp  time.Time
+	Network    string
+	ChainID    string
+	ContractID string
+	TelemType  synchronization.TelemetryType
+	Telemetry  []byte
+}
+
+var _ ocrtypes.MonitoringEndpoint = &SinkAgent{}
+
+// SinkAgent sends telemetry to the sinks, as well as to the wrapped monitoring endpoint of the ingress server.
+type SinkAgent struct {
+	endpoint   ocrtypes.MonitoringEndpoint
+	sinks      []Sink
+	network    string
+	chainID    string
+	contractID string
+	telemType  synchronization.TelemetryType
+}
+
+// NewSinkAgent creates a new SinkAgent for a given contractID
+func NewSinkAgent(endpoint ocrtypes.MonitoringEndpoint, sinks []Sink, network string, chainID string, contractID string, telemType synchronization.TelemetryType) *SinkAgent {
+	return &SinkAgent{
+		endpoint,
+		sinks,
+		network,
+		chainID,
+		contractID,
+		telemType,
+	}
+}
+
+// SendLog sends a telemetry log to the ingress server and the sinks
+func (t *SinkAgent) SendLog(telemetry []byte) {
+	t.endpoint.SendLog(telemetry)
+
+	msg := SinkMessage{
+		Timestamp:  time.Now(),
+		Network:    t.network,
+		ChainID:    t.chainID,
+		ContractID: t.contractID,
+		TelemType:  t.telemType,
+		Telemetry:  telemetry,
+	}
+	for _, s := range t.sinks {
+		s.Send(msg)
+	}
+}
@@ -2,7 +2,9 @@ package webhook
 
 import (
 	"context"
+	"net/http"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -26,16 +28,19 @@ type (
 
 	JobRunner interface {
 		RunJob(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
+		// VerifyRequest checks a request to run the job against the signature and rate limit settings of its spec.
+		// Rejected requests are recorded as job spec errors.
+		VerifyRequest(ctx context.Context, jobUUID uuid.UUID, header http.Header, requestBody []byte) error
 	}
 )
 
 var _ job.Delegate = (*Delegate)(nil)
 
-func NewDelegate(runner pipeline.Runner, externalInitiatorManager ExternalInitiatorManager, lggr logger.Logger) *Delegate {
+func NewDelegate(runner pipeline.Runner, externalInitiatorManager ExternalInitiatorManager, jobORM job.ORM, lggr logger.Logger) *Delegate {
 	lggr = lggr.Named("Webhook")
 	return &Delegate{
 		externalInitiatorManager: externalInitiatorManager,
-		webhookJobRunner:         newWebhookJobRunner(runner, lggr),
+		webhookJobRunner:         newWebhookJobRunner(runner, jobORM, lggr),
 		lggr:                     lggr,
 		stopCh:                   make(services.StopChan),
 	}
@@ -105,13 +110,15 @@ type webhookJobRunner struct {
 	specsByUUID   map[uuid.UUID]registeredJob
 	muSpecsByUUID sync.RWMutex
 	runner        pipeline.Runner
+	jobORM        job.ORM
 	lggr          logger.Logger
 }
 
-func newWebhookJobRunner(runner pipeline.Runner, lggr logger.Logger) *webhookJobRunner {
+func newWebhookJobRunner(runner pipeline.Runner, jobORM job.ORM, lggr logger.Logger) *webhookJobRunner {
 	return &webhookJobRunner{
 		specsByUUID: make(map[uuid.UUID]registeredJob),
 		runner:      runner,
+		jobORM:      jobORM,
 		lggr:        lggr.Named("JobRunner"),
 	}
 }
@@ -119,6 +126,7 @@ func newWebhookJobRunner(runner pipeline.Runner, lggr logger.Logger) *webhookJob
 type registeredJob struct {
 	job.Job
 	chRemove services.StopChan
+	verifier *requestVerifier
 }
 
 func (r *webhookJobRunner) addSpec(spec job.Job) error {
@@ -128,7 +136,11 @@ func (r *webhookJobRunner) addSpec(spec job.Job) error {
 	if exists {
 		return errors.Errorf("a webhook job with that UUID already exists (uuid: %v)", spec.ExternalJobID)
 	}
-	r.specsByUUID[spec.ExternalJobID] = registeredJob{spec, make(chan struct{})}
+	var verifier *requestVerifier
+	if spec.WebhookSpec != nil {
+		verifier = newRequestVerifier(*spec.WebhookSpec)
+	}
+	r.specsByUUID[spec.ExternalJobID] = registeredJob{spec, make(chan struct{}), verifier}
 	return nil
 }
 
@@ -151,6 +163,19 @@ func (r *webhookJobRunner) spec(externalJobID uuid.UUID) (registeredJob, bool) {
 
 var ErrJobNotExists = errors.New("job does not exist")
 
+func (r *webhookJobRunner) VerifyRequest(ctx context.Context, jobUUID uuid.UUID, header http.Header, requestBody []byte) error {
+	spec, exists := r.spec(jobUUID)
+	if !exists {
+		return ErrJobNotExists
+	}
+	if err := spec.verifier.verify(header, requestBody, time.Now()); err != nil {
+		r.lggr.Debugw("Rejected webhook request", "jobID", spec.ID, "uuid", spec.ExternalJobID, "err", err)
+		r.jobORM.TryRecordError(ctx, spec.ID, err.Error())
+		return err
+	}
+	return nil
+}
+
 func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error) {
 	spec, exists := r.spec(jobUUID)
 	if !exists {
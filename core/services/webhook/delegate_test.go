@@ -1,7 +1,10 @@
 package webhook_test
 
 import (
+	"net/http"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"gopkg.in/guregu/null.v4"
@@ -15,10 +18,12 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	jobmocks "github.com/smartcontractkit/chainlink/v2/core/services/job/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	pipelinemocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	webhookmocks "github.com/smartcontractkit/chainlink/v2/core/services/webhook/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 func TestWebhookDelegate(t *testing.T) {
@@ -49,7 +54,7 @@ func TestWebhookDelegate(t *testing.T) {
 		}
 		runner    = pipelinemocks.NewRunner(t)
 		eiManager = new(webhookmocks.ExternalInitiatorManager)
-		delegate  = webhook.NewDelegate(runner, eiManager, logger.TestLogger(t))
+		delegate  = webhook.NewDelegate(runner, eiManager, jobmocks.NewORM(t), logger.TestLogger(t))
 	)
 
 	services, err := delegate.ServicesForSpec(ctx, *spec)
@@ -95,3 +100,88 @@ func TestWebhookDelegate(t *testing.T) {
 	_, err = delegate.WebhookJobRunner().RunJob(ctx, spec.ExternalJobID, requestBody, meta)
 	require.Equal(t, webhook.ErrJobNotExists, errors.Cause(err))
 }
+
+func TestWebhookDelegate_VerifyRequest(t *testing.T) {
+	ctx := testutils.Context(t)
+	const secret = "partner-secret"
+	spec := job.Job{
+		ID:            123,
+		Type:          job.Webhook,
+		SchemaVersion: 1,
+		ExternalJobID: uuid.New(),
+		WebhookSpec: &job.WebhookSpec{
+			SignatureSecret:    secret,
+			SignatureTolerance: models.Interval(time.Minute),
+			RateLimitRPS:       0.001,
+			RateLimitBurst:     2,
+		},
+		PipelineSpec: &pipeline.Spec{},
+	}
+	jobORM := jobmocks.NewORM(t)
+	delegate := webhook.NewDelegate(pipelinemocks.NewRunner(t), new(webhookmocks.ExternalInitiatorManager), jobORM, logger.TestLogger(t))
+	services, err := delegate.ServicesForSpec(ctx, spec)
+	require.NoError(t, err)
+	require.NoError(t, services[0].Start(ctx))
+	t.Cleanup(func() { require.NoError(t, services[0].Close()) })
+	runner := delegate.WebhookJobRunner()
+
+	body := []byte(`{"foo":"bar"}`)
+	signed := func(ts time.Time, nonce string, body []byte) http.Header {
+		header := http.Header{}
+		header.Set(webhook.TimestampHeader, strconv.FormatInt(ts.Unix(), 10))
+		header.Set(webhook.NonceHeader, nonce)
+		header.Set(webhook.SignatureHeader, webhook.Sign(secret, ts.Unix(), nonce, body))
+		return header
+	}
+	expectRecorded := func(expected error) {
+		jobORM.On("TryRecordError", mock.Anything, spec.ID, expected.Error()).Once()
+	}
+
+	err = runner.VerifyRequest(ctx, uuid.New(), signed(time.Now(), "a", body), body)
+	require.ErrorIs(t, err, webhook.ErrJobNotExists)
+
+	expectRecorded(webhook.ErrMissingSignature)
+	err = runner.VerifyRequest(ctx, spec.ExternalJobID, http.Header{}, body)
+	require.ErrorIs(t, err, webhook.ErrMissingSignature)
+
+	expectRecorded(webhook.ErrInvalidSignature)
+	err = runner.VerifyRequest(ctx, spec.ExternalJobID, signed(time.Now(), "a", body), []byte(`{"foo":"baz"}`))
+	require.ErrorIs(t, err, webhook.ErrInvalidSignature)
+
+	expectRecorded(webhook.ErrStaleRequest)
+	err = runner.VerifyRequest(ctx, spec.ExternalJobID, signed(time.Now().Add(-2*time.Minute), "a", body), body)
+	require.ErrorIs(t, err, webhook.ErrStaleRequest)
+
+	require.NoError(t, runner.VerifyRequest(ctx, spec.ExternalJobID, signed(time.Now(), "a", body), body))
+
+	expectRecorded(webhook.ErrReplayedRequest)
+	err = runner.VerifyRequest(ctx, spec.ExternalJobID, signed(time.Now(), "a", body), body)
+	require.ErrorIs(t, err, webhook.ErrReplayedRequest)
+
+	// The second request uses up the burst
+	require.NoError(t, runner.VerifyRequest(ctx, spec.ExternalJobID, signed(time.Now(), "b", body), body))
+	expectRecorded(webhook.ErrRateLimited)
+	err = runner.VerifyRequest(ctx, spec.ExternalJobID, signed(time.Now(), "c", body), body)
+	require.ErrorIs(t, err, webhook.ErrRateLimited)
+}
+
+func TestWebhookDelegate_VerifyRequest_NotConfigured(t *testing.T) {
+	ctx := testutils.Context(t)
+	spec := job.Job{
+		ID:            123,
+		Type:          job.Webhook,
+		SchemaVersion: 1,
+		ExternalJobID: uuid.New(),
+		WebhookSpec:   &job.WebhookSpec{},
+		PipelineSpec:  &pipeline.Spec{},
+	}
+	delegate := webhook.NewDelegate(pipelinemocks.NewRunner(t), new(webhookmocks.ExternalInitiatorManager), jobmocks.NewORM(t), logger.TestLogger(t))
+	services, err := delegate.ServicesForSpec(ctx, spec)
+	require.NoError(t, err)
+	require.NoError(t, services[0].Start(ctx))
+	t.Cleanup(func() { require.NoError(t, services[0].Close()) })
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, delegate.WebhookJobRunner().VerifyRequest(ctx, spec.ExternalJobID, http.Header{}, nil))
+	}
+}
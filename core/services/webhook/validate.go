@@ -59,9 +59,40 @@ func ValidatedWebhookSpec(ctx context.Context, tomlString string, externalInitia
 		return jb, err
 	}
 
-	jb.WebhookSpec = &job.WebhookSpec{
-		ExternalInitiatorWebhookSpecs: externalInitiatorWebhookSpecs,
+	var spec job.WebhookSpec
+	if err = tree.Unmarshal(&spec); err != nil {
+		return jb, err
+	}
+	if err = validateRequestVerification(&spec); err != nil {
+		return jb, err
 	}
+	spec.ExternalInitiatorWebhookSpecs = externalInitiatorWebhookSpecs
+	jb.WebhookSpec = &spec
 
 	return jb, nil
 }
+
+// validateRequestVerification checks the signature and rate limit settings of the spec, and
+// sets the defaults of the ones left out.
+func validateRequestVerification(spec *job.WebhookSpec) error {
+	if spec.SignatureSecret == "" {
+		if spec.SignatureTolerance != 0 {
+			return errors.New("signatureTolerance requires signatureSecret to be set")
+		}
+	} else if spec.SignatureTolerance < 0 {
+		return errors.New("signatureTolerance must not be negative")
+	} else if spec.SignatureTolerance == 0 {
+		spec.SignatureTolerance = models.Interval(DefaultSignatureTolerance)
+	}
+
+	if spec.RateLimitRPS < 0 {
+		return errors.New("rateLimitRPS must not be negative")
+	}
+	if spec.RateLimitRPS == 0 && spec.RateLimitBurst != 0 {
+		return errors.New("rateLimitBurst requires rateLimitRPS to be set")
+	}
+	if spec.RateLimitRPS > 0 && spec.RateLimitBurst == 0 {
+		spec.RateLimitBurst = 1
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package webhook_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/pkg/errors"
@@ -144,6 +145,85 @@ func TestValidatedWebJobSpec(t *testing.T) {
 				require.EqualError(t, err, "unable to find external initiator named bar: something exploded; unable to find external initiator named baz: something exploded")
 			},
 		},
+		{
+			name: "with signature and rate limit",
+			toml: `
+            type               = "webhook"
+            schemaVersion      = 1
+            signatureSecret    = "partner-secret"
+            signatureTolerance = "30s"
+            rateLimitRPS       = 0.5
+            observationSource   = """
+                ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+            """
+            `,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.WebhookSpec)
+				assert.Equal(t, "partner-secret", s.WebhookSpec.SignatureSecret)
+				assert.Equal(t, 30*time.Second, s.WebhookSpec.SignatureTolerance.Duration())
+				assert.Equal(t, 0.5, s.WebhookSpec.RateLimitRPS)
+				assert.Equal(t, uint32(1), s.WebhookSpec.RateLimitBurst)
+			},
+		},
+		{
+			name: "with signature secret defaults the tolerance",
+			toml: `
+            type            = "webhook"
+            schemaVersion   = 1
+            signatureSecret = "partner-secret"
+            observationSource   = """
+                ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+            """
+            `,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, webhook.DefaultSignatureTolerance, s.WebhookSpec.SignatureTolerance.Duration())
+				assert.Zero(t, s.WebhookSpec.RateLimitRPS)
+			},
+		},
+		{
+			name: "signature tolerance without secret",
+			toml: `
+            type               = "webhook"
+            schemaVersion      = 1
+            signatureTolerance = "30s"
+            observationSource   = """
+                ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+            """
+            `,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, "signatureTolerance requires signatureSecret to be set")
+			},
+		},
+		{
+			name: "rate limit burst without rate",
+			toml: `
+            type           = "webhook"
+            schemaVersion  = 1
+            rateLimitBurst = 5
+            observationSource   = """
+                ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+            """
+            `,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, "rateLimitBurst requires rateLimitRPS to be set")
+			},
+		},
+		{
+			name: "negative rate limit",
+			toml: `
+            type          = "webhook"
+            schemaVersion = 1
+            rateLimitRPS  = -1.0
+            observationSource   = """
+                ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+            """
+            `,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, "rateLimitRPS must not be negative")
+			},
+		},
 	}
 	for _, tc := range tt {
 		tc := tc
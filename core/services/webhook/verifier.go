@@ -0,0 +1,133 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+const (
+	// SignatureHeader holds the hex encoded HMAC-SHA256 of SignedPayload for the request.
+	SignatureHeader = "X-Chainlink-Signature"
+	// TimestampHeader holds the time the request was signed at, in seconds since the unix epoch.
+	TimestampHeader = "X-Chainlink-Timestamp"
+	// NonceHeader holds a value unique to the request, so that it can't be replayed.
+	NonceHeader = "X-Chainlink-Nonce"
+
+	DefaultSignatureTolerance = 5 * time.Minute
+)
+
+var (
+	ErrMissingSignature = errors.New("webhook request is missing signature headers")
+	ErrInvalidSignature = errors.New("webhook request signature is invalid")
+	ErrStaleRequest     = errors.New("webhook request timestamp is outside of the signature tolerance")
+	ErrReplayedRequest  = errors.New("webhook request nonce was already used")
+	ErrRateLimited      = errors.New("webhook job rate limit exceeded")
+)
+
+// SignedPayload returns the message that the signature of a request with the given timestamp,
+// nonce and body is computed over.
+func SignedPayload(timestamp int64, nonce string, body []byte) []byte {
+	return append([]byte(fmt.Sprintf("%d.%s.", timestamp, nonce)), body...)
+}
+
+// Sign returns the value of the SignatureHeader for a request with the given timestamp, nonce and body.
+func Sign(secret string, timestamp int64, nonce string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(SignedPayload(timestamp, nonce, body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// requestVerifier enforces the signature and rate limit settings of a webhook spec.
+type requestVerifier struct {
+	secret    string
+	tolerance time.Duration
+	limiter   *rate.Limiter
+
+	mu sync.Mutex
+	// nonces holds the nonces of the accepted requests by their timestamp. Nonces older than the
+	// tolerance are pruned, as requests reusing them are rejected as stale anyway.
+	nonces map[string]time.Time
+}
+
+// newRequestVerifier returns nil if the spec has neither a signature secret nor a rate limit.
+func newRequestVerifier(spec job.WebhookSpec) *requestVerifier {
+	if spec.SignatureSecret == "" && spec.RateLimitRPS <= 0 {
+		return nil
+	}
+	v := &requestVerifier{
+		secret:    spec.SignatureSecret,
+		tolerance: spec.SignatureTolerance.Duration(),
+		nonces:    make(map[string]time.Time),
+	}
+	if v.tolerance <= 0 {
+		v.tolerance = DefaultSignatureTolerance
+	}
+	if spec.RateLimitRPS > 0 {
+		burst := int(spec.RateLimitBurst)
+		if burst == 0 {
+			burst = 1
+		}
+		v.limiter = rate.NewLimiter(rate.Limit(spec.RateLimitRPS), burst)
+	}
+	return v
+}
+
+// verify checks the request against the spec. The nonce of the request is only used up once the
+// request is accepted, so a rate limited request can be retried with the same headers.
+func (v *requestVerifier) verify(header http.Header, body []byte, now time.Time) error {
+	if v == nil {
+		return nil
+	}
+
+	var nonce string
+	var timestamp time.Time
+	if v.secret != "" {
+		signature, ts, n := header.Get(SignatureHeader), header.Get(TimestampHeader), header.Get(NonceHeader)
+		if signature == "" || ts == "" || n == "" {
+			return ErrMissingSignature
+		}
+		unix, err := strconv.ParseInt(ts, 10, 64)
+		if err != nil {
+			return ErrInvalidSignature
+		}
+		if !hmac.Equal([]byte(Sign(v.secret, unix, n, body)), []byte(signature)) {
+			return ErrInvalidSignature
+		}
+		timestamp = time.Unix(unix, 0)
+		if timestamp.Before(now.Add(-v.tolerance)) || timestamp.After(now.Add(v.tolerance)) {
+			return ErrStaleRequest
+		}
+		nonce = n
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if nonce != "" {
+		for n, ts := range v.nonces {
+			if ts.Before(now.Add(-v.tolerance)) {
+				delete(v.nonces, n)
+			}
+		}
+		if _, used := v.nonces[nonce]; used {
+			return ErrReplayedRequest
+		}
+	}
+	if v.limiter != nil && !v.limiter.AllowN(now, 1) {
+		return ErrRateLimited
+	}
+	if nonce != "" {
+		v.nonces[nonce] = timestamp
+	}
+	return nil
+}
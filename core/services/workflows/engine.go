@@ -15,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
 	"github.com/smartcontractkit/chainlink-common/pkg/workflows"
+	coreCap "github.com/smartcontractkit/chainlink/v2/core/capabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/transmission"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
@@ -180,6 +181,7 @@ func (e *Engine) initializeCapability(ctx context.Context, step *step) error {
 	if err != nil {
 		return fmt.Errorf("failed to register to workflow (%+v): %w", registrationRequest, err)
 	}
+	e.trackWorkflow(step.ID)
 
 	step.capability = cc
 	return nil
@@ -308,6 +310,7 @@ func (e *Engine) registerTrigger(ctx context.Context, t *triggerCapability) erro
 	if err != nil {
 		return fmt.Errorf("failed to instantiate trigger %s, %s", t.ID, err)
 	}
+	e.trackWorkflow(t.ID)
 
 	go func() {
 		for event := range eventsCh {
@@ -654,12 +657,29 @@ func (e *Engine) deregisterTrigger(ctx context.Context, t *triggerCapability) er
 	// yet, and can safely consider the trigger deregistered with
 	// no further action.
 	if t.trigger != nil {
-		return t.trigger.UnregisterTrigger(ctx, deregRequest)
+		if err := t.trigger.UnregisterTrigger(ctx, deregRequest); err != nil {
+			return err
+		}
+		e.untrackWorkflow(t.ID)
 	}
 
 	return nil
 }
 
+// trackWorkflow records in the registry, if it supports it, that this
+// workflow registered to the capability
+func (e *Engine) trackWorkflow(capabilityID string) {
+	if tracker, ok := e.registry.(coreCap.WorkflowTracker); ok {
+		tracker.RegisterWorkflow(capabilityID, e.workflow.id)
+	}
+}
+
+func (e *Engine) untrackWorkflow(capabilityID string) {
+	if tracker, ok := e.registry.(coreCap.WorkflowTracker); ok {
+		tracker.UnregisterWorkflow(capabilityID, e.workflow.id)
+	}
+}
+
 func (e *Engine) Close() error {
 	return e.StopOnce("Engine", func() error {
 		e.logger.Info("shutting down engine")
@@ -701,6 +721,7 @@ func (e *Engine) Close() error {
 			if innerErr != nil {
 				return fmt.Errorf("failed to unregister from workflow: %+v", reg)
 			}
+			e.untrackWorkflow(s.ID)
 
 			return nil
 		})
@@ -243,6 +243,11 @@ func TestEngineWithHardcodedWorkflow(t *testing.T) {
 			require.NoError(t, err)
 
 			assert.Equal(t, state.Status, store.StatusCompleted)
+
+			// the registry tracks the workflows registered to each capability
+			assert.Equal(t, []string{eng.workflow.id}, reg.Workflows("write_ethereum-testnet-sepolia"))
+			require.NoError(t, eng.Close())
+			assert.Empty(t, reg.Workflows("write_ethereum-testnet-sepolia"))
 		})
 	}
 }
@@ -39,3 +39,14 @@ type WorkflowExecution struct {
 	UpdatedAt  *time.Time
 	FinishedAt *time.Time
 }
+
+// ExecutionFilter narrows down the workflow executions returned by
+// `Store.List`. Zero values are ignored.
+type ExecutionFilter struct {
+	WorkflowID string
+	Status     string
+	// CreatedAfter and CreatedBefore bound the creation time of the
+	// execution; both bounds are inclusive.
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
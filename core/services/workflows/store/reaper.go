@@ -0,0 +1,102 @@
+package store
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Reaper periodically deletes finished workflow executions that are older
+// than the retention threshold.
+type Reaper struct {
+	services.StateMachine
+	lggr      logger.Logger
+	store     Store
+	clock     clockwork.Clock
+	interval  time.Duration
+	threshold time.Duration
+
+	chStop services.StopChan
+	wgDone sync.WaitGroup
+}
+
+// NewReaper returns a Reaper deleting executions which finished more than threshold ago,
+// every interval. A zero interval or threshold disables the reaper.
+func NewReaper(lggr logger.Logger, store Store, clock clockwork.Clock, interval, threshold time.Duration) *Reaper {
+	return &Reaper{
+		lggr:      lggr.Named("WorkflowExecutionReaper"),
+		store:     store,
+		clock:     clock,
+		interval:  interval,
+		threshold: threshold,
+		chStop:    make(chan struct{}),
+	}
+}
+
+func (r *Reaper) Start(context.Context) error {
+	return r.StartOnce("WorkflowExecutionReaper", func() error {
+		if r.interval <= 0 || r.threshold <= 0 {
+			r.lggr.Debug("Workflow execution reaper disabled")
+			return nil
+		}
+		r.wgDone.Add(1)
+		go r.run()
+		return nil
+	})
+}
+
+func (r *Reaper) Close() error {
+	return r.StopOnce("WorkflowExecutionReaper", func() error {
+		close(r.chStop)
+		r.wgDone.Wait()
+		return nil
+	})
+}
+
+func (r *Reaper) Name() string {
+	return r.lggr.Name()
+}
+
+func (r *Reaper) HealthReport() map[string]error {
+	return map[string]error{r.Name(): r.Healthy()}
+}
+
+func (r *Reaper) run() {
+	defer r.wgDone.Done()
+	ctx, cancel := r.chStop.NewCtx()
+	defer cancel()
+
+	ticker := r.clock.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.chStop:
+			return
+		case <-ticker.Chan():
+			r.reap(ctx)
+		}
+	}
+}
+
+func (r *Reaper) reap(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, r.interval)
+	defer cancel()
+
+	deleted, err := r.store.DeleteFinishedBefore(ctx, r.clock.Now().Add(-r.threshold))
+	if err != nil {
+		if ctx.Err() == nil {
+			r.lggr.Errorw("Failed to delete old workflow executions", "err", err)
+			r.SvcErrBuffer.Append(err)
+		}
+		return
+	}
+	if deleted > 0 {
+		r.lggr.Debugw("Deleted old workflow executions", "count", deleted, "threshold", r.threshold)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/proto"
@@ -368,6 +369,85 @@ func (d *DBStore) GetUnfinished(ctx context.Context, offset, limit int) ([]Workf
 	return states, nil
 }
 
+// `List` returns a page of workflow executions matching the given filter,
+// most recent first, along with the total number of matching executions.
+// Steps are not loaded; use `Get` to fetch the steps of a single execution.
+func (d *DBStore) List(ctx context.Context, filter ExecutionFilter, offset, limit int) ([]WorkflowExecution, int, error) {
+	where, args := filter.whereClause()
+
+	var count int
+	err := d.db.GetContext(ctx, &count, `SELECT count(*) FROM workflow_executions`+where, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not count workflow executions: %w", err)
+	}
+
+	sql := fmt.Sprintf(`SELECT * FROM workflow_executions%s
+	ORDER BY created_at DESC, id ASC
+	LIMIT $%d
+	OFFSET $%d`, where, len(args)+1, len(args)+2)
+	rows := []workflowExecutionRow{}
+	err = d.db.SelectContext(ctx, &rows, sql, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not list workflow executions: %w", err)
+	}
+
+	executions := make([]WorkflowExecution, 0, len(rows))
+	for _, r := range rows {
+		var workflowID string
+		if r.WorkflowID != nil {
+			workflowID = *r.WorkflowID
+		}
+		executions = append(executions, WorkflowExecution{
+			ExecutionID: r.ID,
+			WorkflowID:  workflowID,
+			Status:      r.Status,
+			Steps:       map[string]*WorkflowExecutionStep{},
+			CreatedAt:   r.CreatedAt,
+			UpdatedAt:   r.UpdatedAt,
+			FinishedAt:  r.FinishedAt,
+		})
+	}
+	return executions, count, nil
+}
+
+func (f ExecutionFilter) whereClause() (string, []any) {
+	var (
+		conds []string
+		args  []any
+	)
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if f.WorkflowID != "" {
+		add("workflow_id = $%d", f.WorkflowID)
+	}
+	if f.Status != "" {
+		add("status = $%d", f.Status)
+	}
+	if f.CreatedAfter != nil {
+		add("created_at >= $%d", *f.CreatedAfter)
+	}
+	if f.CreatedBefore != nil {
+		add("created_at <= $%d", *f.CreatedBefore)
+	}
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conds, " AND "), args
+}
+
+// `DeleteFinishedBefore` deletes all finished workflow executions, along with
+// their steps, that finished before the given time. It returns the number of
+// deleted executions.
+func (d *DBStore) DeleteFinishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	res, err := d.db.ExecContext(ctx, `DELETE FROM workflow_executions WHERE status != $1 AND finished_at < $2`, StatusStarted, before)
+	if err != nil {
+		return 0, fmt.Errorf("could not delete workflow executions: %w", err)
+	}
+	return res.RowsAffected()
+}
+
 func NewDBStore(ds sqlutil.DataSource, clock clockwork.Clock) *DBStore {
 	return &DBStore{db: ds, clock: clock}
 }
@@ -2,9 +2,11 @@ package store
 
 import (
 	"crypto/rand"
+	"database/sql"
 	"encoding/hex"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/assert"
@@ -213,3 +215,107 @@ func Test_StoreDB_GetUnfinishedSteps(t *testing.T) {
 	states[0].CreatedAt = nil
 	assert.Equal(t, es, states[0])
 }
+
+func Test_StoreDB_List(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	clock := clockwork.NewFakeClock()
+	store := &DBStore{db: db, clock: clock}
+	ctx := tests.Context(t)
+
+	workflowID := randomID()
+	_, err := db.ExecContext(ctx, `INSERT INTO workflow_specs (workflow, workflow_id, workflow_owner, workflow_name, created_at, updated_at)
+	VALUES ('', $1, 'owner', 'name', NOW(), NOW())`, workflowID)
+	require.NoError(t, err)
+
+	start := clock.Now()
+	var ids []string
+	for i := 0; i < 3; i++ {
+		id := randomID()
+		ids = append(ids, id)
+		wid := ""
+		if i > 0 {
+			wid = workflowID
+		}
+		err = store.Add(ctx, &WorkflowExecution{
+			ExecutionID: id,
+			WorkflowID:  wid,
+			Status:      StatusStarted,
+			Steps: map[string]*WorkflowExecutionStep{
+				"step1": {ExecutionID: id, Ref: "step1", Status: StatusStarted},
+			},
+		})
+		require.NoError(t, err)
+		clock.Advance(time.Minute)
+	}
+	require.NoError(t, store.UpdateStatus(ctx, ids[0], StatusCompleted))
+
+	// all, most recent first, without steps
+	got, count, err := store.List(ctx, ExecutionFilter{}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, got, 3)
+	assert.Equal(t, []string{ids[2], ids[1], ids[0]}, []string{got[0].ExecutionID, got[1].ExecutionID, got[2].ExecutionID})
+	assert.Empty(t, got[0].Steps)
+
+	// paginated
+	got, count, err = store.List(ctx, ExecutionFilter{}, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, got, 1)
+	assert.Equal(t, ids[1], got[0].ExecutionID)
+
+	got, count, err = store.List(ctx, ExecutionFilter{WorkflowID: workflowID}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Len(t, got, 2)
+
+	got, count, err = store.List(ctx, ExecutionFilter{Status: StatusCompleted}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, got, 1)
+	assert.Equal(t, ids[0], got[0].ExecutionID)
+
+	after, before := start.Add(30*time.Second), start.Add(90*time.Second)
+	got, count, err = store.List(ctx, ExecutionFilter{CreatedAfter: &after, CreatedBefore: &before}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, got, 1)
+	assert.Equal(t, ids[1], got[0].ExecutionID)
+}
+
+func Test_StoreDB_DeleteFinishedBefore(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	clock := clockwork.NewFakeClock()
+	store := &DBStore{db: db, clock: clock}
+	ctx := tests.Context(t)
+
+	finished, unfinished, recent := randomID(), randomID(), randomID()
+	for _, id := range []string{finished, unfinished, recent} {
+		err := store.Add(ctx, &WorkflowExecution{
+			ExecutionID: id,
+			Status:      StatusStarted,
+			Steps: map[string]*WorkflowExecutionStep{
+				"step1": {ExecutionID: id, Ref: "step1", Status: StatusCompleted},
+			},
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, store.UpdateStatus(ctx, finished, StatusErrored))
+	clock.Advance(time.Hour)
+	require.NoError(t, store.UpdateStatus(ctx, recent, StatusCompleted))
+
+	deleted, err := store.DeleteFinishedBefore(ctx, clock.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	_, err = store.Get(ctx, finished)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	var steps int
+	require.NoError(t, db.GetContext(ctx, &steps, `SELECT count(*) FROM workflow_steps WHERE workflow_execution_id = $1`, finished))
+	assert.Zero(t, steps)
+
+	_, err = store.Get(ctx, unfinished)
+	require.NoError(t, err)
+	_, err = store.Get(ctx, recent)
+	require.NoError(t, err)
+}
@@ -3,7 +3,9 @@ package store
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 )
 
 // `InMemoryStore` is a temporary in-memory
@@ -84,3 +86,67 @@ func (s *InMemoryStore) GetUnfinished(ctx context.Context, offset, limit int) ([
 
 	return states, nil
 }
+
+// List gets the states matching the filter, most recent first.
+// Steps are not included, for parity with the database store.
+func (s *InMemoryStore) List(ctx context.Context, filter ExecutionFilter, offset, limit int) ([]WorkflowExecution, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	states := []WorkflowExecution{}
+	for _, s := range s.idToState {
+		if filter.matches(s) {
+			state := *s
+			state.Steps = map[string]*WorkflowExecutionStep{}
+			states = append(states, state)
+		}
+	}
+	sort.Slice(states, func(i, j int) bool {
+		ci, cj := states[i].CreatedAt, states[j].CreatedAt
+		if ci != nil && cj != nil && !ci.Equal(*cj) {
+			return ci.After(*cj)
+		}
+		return states[i].ExecutionID < states[j].ExecutionID
+	})
+
+	count := len(states)
+	if offset >= count {
+		return []WorkflowExecution{}, count, nil
+	}
+	end := count
+	if limit > 0 && offset+limit < count {
+		end = offset + limit
+	}
+	return states[offset:end], count, nil
+}
+
+// DeleteFinishedBefore deletes the finished states that finished before the given time.
+func (s *InMemoryStore) DeleteFinishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deleted int64
+	for id, state := range s.idToState {
+		if state.Status != StatusStarted && state.FinishedAt != nil && state.FinishedAt.Before(before) {
+			delete(s.idToState, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (f ExecutionFilter) matches(state *WorkflowExecution) bool {
+	if f.WorkflowID != "" && state.WorkflowID != f.WorkflowID {
+		return false
+	}
+	if f.Status != "" && state.Status != f.Status {
+		return false
+	}
+	if f.CreatedAfter != nil && (state.CreatedAt == nil || state.CreatedAt.Before(*f.CreatedAfter)) {
+		return false
+	}
+	if f.CreatedBefore != nil && (state.CreatedAt == nil || state.CreatedAt.After(*f.CreatedBefore)) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,165 @@
+package sessions
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+)
+
+// Permission grants an API token access to a group of API operations.
+type Permission string
+
+const (
+	PermissionJobsRead     Permission = "jobs:read"
+	PermissionJobsWrite    Permission = "jobs:write"
+	PermissionJobsRun      Permission = "jobs:run"
+	PermissionBridgesRead  Permission = "bridges:read"
+	PermissionBridgesWrite Permission = "bridges:write"
+	PermissionKeysRead     Permission = "keys:read"
+	PermissionKeysWrite    Permission = "keys:write"
+	PermissionKeysExport   Permission = "keys:export"
+	PermissionTxsRead      Permission = "txs:read"
+	PermissionTxsSend      Permission = "txs:send"
+	PermissionChainsRead   Permission = "chains:read"
+	PermissionChainsWrite  Permission = "chains:write"
+	PermissionFeedsRead    Permission = "feeds:read"
+	PermissionFeedsWrite   Permission = "feeds:write"
+	PermissionConfigRead   Permission = "config:read"
+	PermissionConfigWrite  Permission = "config:write"
+)
+
+// AllPermissions lists every permission an API token can be granted.
+var AllPermissions = []Permission{
+	PermissionJobsRead,
+	PermissionJobsWrite,
+	PermissionJobsRun,
+	PermissionBridgesRead,
+	PermissionBridgesWrite,
+	PermissionKeysRead,
+	PermissionKeysWrite,
+	PermissionKeysExport,
+	PermissionTxsRead,
+	PermissionTxsSend,
+	PermissionChainsRead,
+	PermissionChainsWrite,
+	PermissionFeedsRead,
+	PermissionFeedsWrite,
+	PermissionConfigRead,
+	PermissionConfigWrite,
+}
+
+// ErrAPITokenNotFound is returned when no API token has the given name.
+var ErrAPITokenNotFound = errors.New("api token not found")
+
+// ParsePermissions parses a list of permission names, rejecting unknown and duplicate permissions.
+func ParsePermissions(names []string) (Permissions, error) {
+	if len(names) == 0 {
+		return nil, errors.New("at least one permission is required")
+	}
+	perms := make(Permissions, 0, len(names))
+	for _, name := range names {
+		p := Permission(strings.TrimSpace(name))
+		if !slices.Contains(AllPermissions, p) {
+			return nil, fmt.Errorf("unknown permission %q", name)
+		}
+		if slices.Contains(perms, p) {
+			return nil, fmt.Errorf("duplicate permission %q", name)
+		}
+		perms = append(perms, p)
+	}
+	return perms, nil
+}
+
+// Permissions is the set of permissions granted to an API token.
+type Permissions []Permission
+
+// Has returns true if the permission is in the set.
+func (ps Permissions) Has(p Permission) bool {
+	return slices.Contains(ps, p)
+}
+
+// Strings returns the names of the permissions.
+func (ps Permissions) Strings() []string {
+	names := make([]string, len(ps))
+	for i, p := range ps {
+		names[i] = string(p)
+	}
+	return names
+}
+
+// Scan implements the sql.Scanner interface.
+func (ps *Permissions) Scan(src interface{}) error {
+	var names pq.StringArray
+	if err := names.Scan(src); err != nil {
+		return err
+	}
+	*ps = make(Permissions, len(names))
+	for i, name := range names {
+		(*ps)[i] = Permission(name)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (ps Permissions) Value() (driver.Value, error) {
+	return pq.StringArray(ps.Strings()).Value()
+}
+
+// APIToken is a named, revocable credential which only grants access to the API operations
+// covered by its permissions. Unlike the API token of a user, it is not tied to a role.
+type APIToken struct {
+	ID           int64
+	Name         string
+	AccessKey    string
+	Salt         string
+	HashedSecret string
+	Permissions  Permissions
+	CreatedBy    string
+	ExpiresAt    null.Time
+	LastUsedAt   null.Time
+	CreatedAt    time.Time
+}
+
+// Expired returns true if the token has an expiry which has passed.
+func (t *APIToken) Expired(now time.Time) bool {
+	return t.ExpiresAt.Valid && !now.Before(t.ExpiresAt.Time)
+}
+
+// User returns the user that requests authenticated by the token act as. It has the lowest role,
+// as the permissions of the token are enforced instead of its role.
+func (t *APIToken) User() User {
+	return User{Email: "api-token:" + t.Name, Role: UserRoleView}
+}
+
+// AuthenticateAPIToken returns true if the given credentials match the API token.
+func AuthenticateAPIToken(token *auth.Token, apiToken *APIToken) (bool, error) {
+	hashedSecret, err := auth.HashedSecret(token, apiToken.Salt)
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare([]byte(hashedSecret), []byte(apiToken.HashedSecret)) == 1, nil
+}
+
+//go:generate mockery --quiet --name APITokenORM --output ./mocks/ --case=underscore
+
+// APITokenORM manages the API tokens of the node. API tokens are stored locally regardless of the
+// AuthenticationProvider.
+type APITokenORM interface {
+	// CreateAPIToken generates the credentials of the token and stores it. The returned secret
+	// can't be recovered later.
+	CreateAPIToken(ctx context.Context, token *APIToken) (*auth.Token, error)
+	ListAPITokens(ctx context.Context) ([]APIToken, error)
+	FindAPIToken(ctx context.Context, accessKey string) (APIToken, error)
+	RevokeAPIToken(ctx context.Context, name string) error
+	MarkAPITokenUsed(ctx context.Context, id int64) error
+}
@@ -0,0 +1,49 @@
+package sessions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+func TestParsePermissions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     []string
+		want      sessions.Permissions
+		wantError string
+	}{
+		{"valid", []string{"jobs:read", " keys:export"}, sessions.Permissions{sessions.PermissionJobsRead, sessions.PermissionKeysExport}, ""},
+		{"empty", nil, nil, "at least one permission is required"},
+		{"unknown", []string{"jobs:read", "users:write"}, nil, `unknown permission "users:write"`},
+		{"duplicate", []string{"jobs:read", "jobs:read"}, nil, `duplicate permission "jobs:read"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			perms, err := sessions.ParsePermissions(test.input)
+			if test.wantError != "" {
+				assert.EqualError(t, err, test.wantError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, perms)
+		})
+	}
+}
+
+func TestAPIToken_Expired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	assert.False(t, (&sessions.APIToken{}).Expired(now))
+	assert.False(t, (&sessions.APIToken{ExpiresAt: null.TimeFrom(now.Add(time.Second))}).Expired(now))
+	assert.True(t, (&sessions.APIToken{ExpiresAt: null.TimeFrom(now)}).Expired(now))
+}
@@ -0,0 +1,82 @@
+package localauth
+
+import (
+	"context"
+
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+type apiTokenORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ sessions.APITokenORM = (*apiTokenORM)(nil)
+
+func NewAPITokenORM(ds sqlutil.DataSource) sessions.APITokenORM {
+	return &apiTokenORM{ds: ds}
+}
+
+// CreateAPIToken generates new credentials for the token and inserts it.
+func (o *apiTokenORM) CreateAPIToken(ctx context.Context, token *sessions.APIToken) (*auth.Token, error) {
+	credentials := auth.NewToken()
+	salt := utils.NewSecret(utils.DefaultSecretSize)
+	hashedSecret, err := auth.HashedSecret(credentials, salt)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "api token")
+	}
+	token.AccessKey = credentials.AccessKey
+	token.Salt = salt
+	token.HashedSecret = hashedSecret
+
+	sql := `INSERT INTO api_tokens (name, access_key, salt, hashed_secret, permissions, created_by, expires_at, created_at)
+VALUES (:name, :access_key, :salt, :hashed_secret, :permissions, :created_by, :expires_at, now()) RETURNING *`
+	query, args, err := o.ds.BindNamed(sql, token)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to bind api token")
+	}
+	if err = o.ds.GetContext(ctx, token, query, args...); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to create api token %q", token.Name)
+	}
+	return credentials, nil
+}
+
+// ListAPITokens returns all the API tokens, ordered by name.
+func (o *apiTokenORM) ListAPITokens(ctx context.Context) (tokens []sessions.APIToken, err error) {
+	err = o.ds.SelectContext(ctx, &tokens, "SELECT * FROM api_tokens ORDER BY name")
+	return
+}
+
+// FindAPIToken returns the API token with the given access key.
+func (o *apiTokenORM) FindAPIToken(ctx context.Context, accessKey string) (token sessions.APIToken, err error) {
+	err = o.ds.GetContext(ctx, &token, "SELECT * FROM api_tokens WHERE access_key = $1", accessKey)
+	return
+}
+
+// RevokeAPIToken deletes the API token with the given name.
+func (o *apiTokenORM) RevokeAPIToken(ctx context.Context, name string) error {
+	res, err := o.ds.ExecContext(ctx, "DELETE FROM api_tokens WHERE name = $1", name)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to revoke api token %q", name)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sessions.ErrAPITokenNotFound
+	}
+	return nil
+}
+
+// MarkAPITokenUsed records that the API token was just used. To avoid a write on every request,
+// the last use is recorded at most once a minute.
+func (o *apiTokenORM) MarkAPITokenUsed(ctx context.Context, id int64) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE api_tokens SET last_used_at = now()
+WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < now() - interval '1 minute')`, id)
+	return err
+}
@@ -0,0 +1,89 @@
+package localauth_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
+)
+
+func TestAPITokenORM(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	orm := localauth.NewAPITokenORM(db)
+
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	token := sessions.APIToken{
+		Name:        "ci",
+		Permissions: sessions.Permissions{sessions.PermissionJobsRead, sessions.PermissionJobsRun},
+		CreatedBy:   "admin@example.com",
+		ExpiresAt:   null.TimeFrom(expiresAt),
+	}
+	credentials, err := orm.CreateAPIToken(ctx, &token)
+	require.NoError(t, err)
+	assert.NotZero(t, token.ID)
+	assert.Equal(t, credentials.AccessKey, token.AccessKey)
+	assert.NotEqual(t, credentials.Secret, token.HashedSecret)
+
+	t.Run("duplicate name", func(t *testing.T) {
+		_, err := orm.CreateAPIToken(ctx, &sessions.APIToken{Name: "ci", Permissions: sessions.Permissions{sessions.PermissionJobsRead}})
+		require.Error(t, err)
+	})
+
+	t.Run("find", func(t *testing.T) {
+		found, err := orm.FindAPIToken(ctx, credentials.AccessKey)
+		require.NoError(t, err)
+		assert.Equal(t, token.ID, found.ID)
+		assert.Equal(t, token.Permissions, found.Permissions)
+		assert.True(t, found.ExpiresAt.Time.Equal(expiresAt))
+		assert.False(t, found.LastUsedAt.Valid)
+
+		ok, err := sessions.AuthenticateAPIToken(credentials, &found)
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		_, err = orm.FindAPIToken(ctx, "unknown")
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("mark used", func(t *testing.T) {
+		require.NoError(t, orm.MarkAPITokenUsed(ctx, token.ID))
+		found, err := orm.FindAPIToken(ctx, credentials.AccessKey)
+		require.NoError(t, err)
+		require.True(t, found.LastUsedAt.Valid)
+
+		require.NoError(t, orm.MarkAPITokenUsed(ctx, token.ID))
+		again, err := orm.FindAPIToken(ctx, credentials.AccessKey)
+		require.NoError(t, err)
+		assert.Equal(t, found.LastUsedAt, again.LastUsedAt)
+	})
+
+	t.Run("list and revoke", func(t *testing.T) {
+		other := sessions.APIToken{Name: "another", Permissions: sessions.Permissions{sessions.PermissionKeysRead}}
+		_, err := orm.CreateAPIToken(ctx, &other)
+		require.NoError(t, err)
+
+		tokens, err := orm.ListAPITokens(ctx)
+		require.NoError(t, err)
+		require.Len(t, tokens, 2)
+		assert.Equal(t, "another", tokens[0].Name)
+		assert.Equal(t, "ci", tokens[1].Name)
+
+		require.NoError(t, orm.RevokeAPIToken(ctx, "another"))
+		require.ErrorIs(t, orm.RevokeAPIToken(ctx, "another"), sessions.ErrAPITokenNotFound)
+
+		tokens, err = orm.ListAPITokens(ctx)
+		require.NoError(t, err)
+		require.Len(t, tokens, 1)
+	})
+}
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX idx_workflow_executions_workflow_id_created_at ON workflow_executions (workflow_id, created_at DESC);
+CREATE INDEX idx_workflow_executions_created_at ON workflow_executions (created_at DESC);
+CREATE INDEX idx_workflow_executions_finished_at ON workflow_executions (finished_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_workflow_executions_finished_at;
+DROP INDEX IF EXISTS idx_workflow_executions_created_at;
+DROP INDEX IF EXISTS idx_workflow_executions_workflow_id_created_at;
+-- +goose StatementEnd
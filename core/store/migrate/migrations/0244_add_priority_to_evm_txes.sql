@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE evm.txes ADD COLUMN priority SMALLINT NOT NULL DEFAULT 0;
+CREATE INDEX idx_evm_txes_unstarted_subject_priority_id ON evm.txes(subject, priority, id) WHERE subject IS NOT NULL AND state = 'unstarted'::eth_txes_state;
+
+-- +goose Down
+DROP INDEX IF EXISTS evm.idx_evm_txes_unstarted_subject_priority_id;
+ALTER TABLE evm.txes DROP COLUMN priority;
@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE evm.log_poller_filters ADD COLUMN start_block BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE evm.log_poller_filter_backfills (
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    filter_name TEXT NOT NULL,
+    from_block BIGINT NOT NULL CHECK (from_block > 0),
+    -- to_block is set by the first poll which includes the filter
+    to_block BIGINT,
+    next_block BIGINT NOT NULL,
+    completed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (evm_chain_id, filter_name)
+);
+
+-- +goose Down
+DROP TABLE evm.log_poller_filter_backfills;
+ALTER TABLE evm.log_poller_filters DROP COLUMN start_block;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- job_spec_versions intentionally has no foreign key to jobs: updating a job deletes and recreates it with the same ID,
+-- and the history must survive that as well as the deletion of the job, so that it can be rolled back.
+CREATE TABLE job_spec_versions (
+    id BIGSERIAL PRIMARY KEY,
+    job_id INTEGER NOT NULL,
+    version INTEGER NOT NULL,
+    toml TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (job_id, version)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE job_spec_versions;
+-- +goose StatementEnd
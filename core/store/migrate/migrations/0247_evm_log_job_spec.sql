@@ -0,0 +1,93 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE evm_log_specs (
+    id SERIAL PRIMARY KEY,
+    contract_address BYTEA NOT NULL CHECK (octet_length(contract_address) = 20),
+    event_abi TEXT NOT NULL,
+    topic2 TEXT NOT NULL DEFAULT '',
+    topic3 TEXT NOT NULL DEFAULT '',
+    topic4 TEXT NOT NULL DEFAULT '',
+    min_confirmations INTEGER,
+    evm_chain_id NUMERIC(78) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+ALTER TABLE jobs
+    ADD COLUMN evm_log_spec_id INT REFERENCES evm_log_specs (id),
+DROP CONSTRAINT chk_specs,
+    ADD CONSTRAINT chk_specs CHECK (
+      num_nonnulls(
+        ocr_oracle_spec_id, ocr2_oracle_spec_id,
+        direct_request_spec_id, flux_monitor_spec_id,
+        keeper_spec_id, cron_spec_id, webhook_spec_id,
+        vrf_spec_id, blockhash_store_spec_id,
+        block_header_feeder_spec_id, bootstrap_spec_id,
+        gateway_spec_id,
+        legacy_gas_station_server_spec_id,
+        legacy_gas_station_sidecar_spec_id,
+        eal_spec_id,
+        workflow_spec_id,
+        standard_capabilities_spec_id,
+        evm_log_spec_id,
+        CASE "type"
+	  WHEN 'stream'
+	  THEN 1
+	  ELSE NULL
+        END -- 'stream' type lacks a spec but should not cause validation to fail
+      ) = 1
+    );
+
+-- evm_log_job_cursors holds the last block an evmlog job has processed all the logs of.
+CREATE TABLE evm_log_job_cursors (
+    job_id INTEGER PRIMARY KEY REFERENCES jobs (id) ON DELETE CASCADE,
+    block_number BIGINT NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- evm_log_job_consumed_logs holds the logs an evmlog job has run its pipeline for in the blocks after its cursor,
+-- so that none is run twice if the node restarts before the cursor is moved past them.
+CREATE TABLE evm_log_job_consumed_logs (
+    job_id INTEGER NOT NULL REFERENCES jobs (id) ON DELETE CASCADE,
+    block_hash BYTEA NOT NULL,
+    block_number BIGINT NOT NULL,
+    log_index BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (job_id, block_hash, log_index)
+);
+CREATE INDEX idx_evm_log_job_consumed_logs_block_number ON evm_log_job_consumed_logs (job_id, block_number);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE evm_log_job_consumed_logs;
+DROP TABLE evm_log_job_cursors;
+
+ALTER TABLE jobs
+DROP CONSTRAINT chk_specs,
+     ADD CONSTRAINT chk_specs CHECK (
+      num_nonnulls(
+        ocr_oracle_spec_id, ocr2_oracle_spec_id,
+        direct_request_spec_id, flux_monitor_spec_id,
+        keeper_spec_id, cron_spec_id, webhook_spec_id,
+        vrf_spec_id, blockhash_store_spec_id,
+        block_header_feeder_spec_id, bootstrap_spec_id,
+        gateway_spec_id,
+        legacy_gas_station_server_spec_id,
+        legacy_gas_station_sidecar_spec_id,
+        eal_spec_id,
+        workflow_spec_id,
+        standard_capabilities_spec_id,
+        CASE "type"
+	  WHEN 'stream'
+	  THEN 1
+	  ELSE NULL
+        END -- 'stream' type lacks a spec but should not cause validation to fail
+      ) = 1
+    );
+
+ALTER TABLE jobs
+DROP COLUMN evm_log_spec_id;
+
+DROP TABLE evm_log_specs;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE webhook_specs
+    ADD COLUMN signature_secret TEXT NOT NULL DEFAULT '',
+    ADD COLUMN signature_tolerance BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN rate_limit_rps DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN rate_limit_burst INTEGER NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE webhook_specs
+    DROP COLUMN signature_secret,
+    DROP COLUMN signature_tolerance,
+    DROP COLUMN rate_limit_rps,
+    DROP COLUMN rate_limit_burst;
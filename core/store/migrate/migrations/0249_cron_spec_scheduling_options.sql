@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cron_specs
+    ADD COLUMN timezone TEXT NOT NULL DEFAULT '',
+    ADD COLUMN jitter BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN catch_up TEXT NOT NULL DEFAULT 'skip',
+    ADD COLUMN max_catch_up_runs INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN max_concurrent_runs INTEGER NOT NULL DEFAULT 0;
+
+-- cron_job_last_runs holds the scheduled time of the last run of a cron job, to catch up on the runs missed while the node was down.
+CREATE TABLE cron_job_last_runs (
+    job_id INTEGER PRIMARY KEY REFERENCES jobs (id) ON DELETE CASCADE,
+    last_run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE cron_job_last_runs;
+
+ALTER TABLE cron_specs
+    DROP COLUMN timezone,
+    DROP COLUMN jitter,
+    DROP COLUMN catch_up,
+    DROP COLUMN max_catch_up_runs,
+    DROP COLUMN max_concurrent_runs;
+-- +goose StatementEnd
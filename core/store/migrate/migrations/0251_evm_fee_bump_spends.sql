@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE evm.fee_bump_spends (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    from_address BYTEA NOT NULL,
+    eth_tx_id BIGINT NOT NULL,
+    job_id INTEGER,
+    amount NUMERIC(78,0) NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE INDEX idx_fee_bump_spends_chain_from_address_created_at ON evm.fee_bump_spends (evm_chain_id, from_address, created_at);
+CREATE INDEX idx_fee_bump_spends_chain_job_id_created_at ON evm.fee_bump_spends (evm_chain_id, job_id, created_at) WHERE job_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE evm.fee_bump_spends;
+-- +goose StatementEnd
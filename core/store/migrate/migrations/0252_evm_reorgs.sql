@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE evm.reorgs (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    depth BIGINT NOT NULL CHECK (depth > 0),
+    common_ancestor_number BIGINT NOT NULL,
+    common_ancestor_hash BYTEA,
+    old_head_number BIGINT NOT NULL,
+    old_head_hash BYTEA NOT NULL,
+    new_head_number BIGINT NOT NULL,
+    new_head_hash BYTEA NOT NULL,
+    detected_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE INDEX idx_reorgs_chain_detected_at ON evm.reorgs (evm_chain_id, detected_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE evm.reorgs;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE llo_transmit_requests (
+    job_id INTEGER NOT NULL,
+    server_url TEXT NOT NULL,
+    config_digest BYTEA NOT NULL CHECK (octet_length(config_digest) = 32),
+    seq_nr NUMERIC(20, 0) NOT NULL,
+    payload BYTEA NOT NULL,
+    report_format INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (job_id, server_url, config_digest, seq_nr),
+    CONSTRAINT llo_transmit_requests_job_id_fkey FOREIGN KEY (job_id) REFERENCES jobs(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE llo_transmit_requests;
+-- +goose StatementEnd
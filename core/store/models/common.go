@@ -298,6 +298,44 @@ func (r *AddressCollection) Scan(value interface{}) error {
 	return nil
 }
 
+// HashCollection is an array of common.Hash
+// serializable to and from a database.
+type HashCollection []common.Hash
+
+// ToStrings returns this hash collection as an array of strings.
+func (r HashCollection) ToStrings() []string {
+	converted := make([]string, len(r))
+	for i, e := range r {
+		converted[i] = e.Hex()
+	}
+	return converted
+}
+
+// Value returns the string value to be written to the database.
+func (r HashCollection) Value() (driver.Value, error) {
+	return strings.Join(r.ToStrings(), ","), nil
+}
+
+// Scan parses the database value as a string.
+func (r *HashCollection) Scan(value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("unable to convert %v of %T to HashCollection", value, value)
+	}
+
+	if len(str) == 0 {
+		return nil
+	}
+
+	arr := strings.Split(str, ",")
+	collection := make(HashCollection, len(arr))
+	for i, h := range arr {
+		collection[i] = common.HexToHash(h)
+	}
+	*r = collection
+	return nil
+}
+
 // Merge returns a new map with all keys merged from left to right
 // On conflicting keys, rightmost inputs will clobber leftmost inputs
 func Merge(inputs ...JSON) (JSON, error) {
@@ -320,6 +320,28 @@ func TestAddressCollection_ToStrings(t *testing.T) {
 	require.Equal(t, hex2, acStrings[1])
 }
 
+func TestHashCollection_Scan_Value(t *testing.T) {
+	t.Parallel()
+
+	hc := models.HashCollection{
+		common.HexToHash(strings.Repeat("AA", 32)),
+		common.HexToHash(strings.Repeat("BB", 32)),
+	}
+
+	val, err := hc.Value()
+	require.NoError(t, err)
+
+	var hcNew models.HashCollection
+	err = hcNew.Scan(val)
+	require.NoError(t, err)
+	require.Equal(t, hc, hcNew)
+
+	var empty models.HashCollection
+	val, err = empty.Value()
+	require.NoError(t, err)
+	require.NoError(t, hcNew.Scan(val))
+}
+
 func TestInterval_IsZero(t *testing.T) {
 	t.Parallel()
 
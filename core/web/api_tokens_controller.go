@@ -0,0 +1,121 @@
+package web
+
+import (
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+var apiTokenNameRegexp = regexp.MustCompile("^[a-zA-Z0-9-_]+$")
+
+// APITokensController manages the scoped API tokens of the node.
+type APITokensController struct {
+	App chainlink.Application
+}
+
+// CreateAPITokenRequest is the request to create a scoped API token. A token
+// without an expiry never expires.
+type CreateAPITokenRequest struct {
+	Name        string     `json:"name"`
+	Permissions []string   `json:"permissions"`
+	ExpiresAt   *time.Time `json:"expiresAt"`
+}
+
+// Index lists all the API tokens.
+func (atc *APITokensController) Index(c *gin.Context) {
+	tokens, err := atc.App.APITokenORM().ListAPITokens(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewAPITokenResources(tokens), "apiTokens")
+}
+
+// Create creates a new API token. The secret of the token is only returned
+// in this response.
+func (atc *APITokensController) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+	var request CreateAPITokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if !apiTokenNameRegexp.MatchString(request.Name) {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("name must be alphanumeric and may contain '_' or '-'"))
+		return
+	}
+	permissions, err := clsessions.ParsePermissions(request.Permissions)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if request.ExpiresAt != nil && !request.ExpiresAt.After(time.Now()) {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("expiry must be in the future"))
+		return
+	}
+
+	user, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+
+	token := clsessions.APIToken{
+		Name:        request.Name,
+		Permissions: permissions,
+		CreatedBy:   user.Email,
+		ExpiresAt:   null.TimeFromPtr(request.ExpiresAt),
+	}
+	credentials, err := atc.App.APITokenORM().CreateAPIToken(ctx, &token)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			jsonAPIError(c, http.StatusBadRequest, errors.Errorf("API token %s already exists", request.Name))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	atc.App.GetAuditLogger().Audit(audit.APITokenCreated, map[string]interface{}{
+		"user":        user.Email,
+		"name":        token.Name,
+		"permissions": token.Permissions.Strings(),
+	})
+
+	jsonAPIResponseWithStatus(c, presenters.NewCreatedAPITokenResource(token, *credentials), "apiToken", http.StatusCreated)
+}
+
+// Revoke deletes an API token by name.
+func (atc *APITokensController) Revoke(c *gin.Context) {
+	name := c.Param("name")
+	user, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+
+	if err := atc.App.APITokenORM().RevokeAPIToken(c.Request.Context(), name); err != nil {
+		if errors.Is(err, clsessions.ErrAPITokenNotFound) {
+			jsonAPIError(c, http.StatusNotFound, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	atc.App.GetAuditLogger().Audit(audit.APITokenDeleted, map[string]interface{}{"user": user.Email, "name": name})
+	jsonAPIResponseWithStatus(c, nil, "apiToken", http.StatusNoContent)
+}
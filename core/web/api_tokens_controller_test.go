@@ -0,0 +1,86 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestAPITokensController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Post("/v2/api_tokens", bytes.NewBufferString(`{"name": "ci", "permissions": ["jobs:read"]}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var created presenters.APITokenResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &created))
+	assert.Equal(t, "ci", created.Name)
+	assert.Equal(t, []string{"jobs:read"}, created.Permissions)
+	require.NotEmpty(t, created.Secret)
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for _, body := range []string{
+			`{"name": "ci", "permissions": ["jobs:read"]}`,
+			`{"name": "bad name", "permissions": ["jobs:read"]}`,
+			`{"name": "other", "permissions": ["users:write"]}`,
+			`{"name": "other", "permissions": []}`,
+			`{"name": "other", "permissions": ["jobs:read"], "expiresAt": "2021-01-01T00:00:00Z"}`,
+		} {
+			resp, cleanup := client.Post("/v2/api_tokens", bytes.NewBufferString(body))
+			t.Cleanup(cleanup)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode, body)
+		}
+	})
+
+	t.Run("index", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/api_tokens")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var tokens []presenters.APITokenResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &tokens))
+		require.Len(t, tokens, 1)
+		assert.Equal(t, "ci", tokens[0].Name)
+		assert.Empty(t, tokens[0].Secret)
+	})
+
+	get := func(path string) int {
+		req, err := http.NewRequestWithContext(testutils.Context(t), "GET", app.Server.URL+path, nil)
+		require.NoError(t, err)
+		req.Header.Set(auth.APIKey, created.AccessKey)
+		req.Header.Set(auth.APISecret, created.Secret)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	t.Run("permissions are enforced", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, get("/v2/jobs"))
+		assert.Equal(t, http.StatusForbidden, get("/v2/bridge_types"))
+		assert.Equal(t, http.StatusForbidden, get("/v2/api_tokens"))
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		resp, cleanup := client.Delete("/v2/api_tokens/ci")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+		resp, cleanup = client.Delete("/v2/api_tokens/ci")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+		assert.Equal(t, http.StatusUnauthorized, get("/v2/jobs"))
+	})
+}
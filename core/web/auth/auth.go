@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
+	"time"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
@@ -33,6 +34,9 @@ const (
 
 	// SessionExternalInitiatorKey is the External Initiator key in the session map
 	SessionExternalInitiatorKey = "external_initiator"
+
+	// SessionAPITokenKey is the API token key in the session map
+	SessionAPITokenKey = "api_token"
 )
 
 // Authenticator defines the interface to authenticate requests against a
@@ -113,6 +117,68 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 
 var _ authMethod = AuthenticateByToken
 
+// AuthenticateByAPIToken returns an authMethod which authenticates the request by a scoped API
+// token, using the same headers as the API token of a user. Authenticate then only lets the
+// request through if the token has the permission the route requires.
+func AuthenticateByAPIToken(tokens clsessions.APITokenORM) authMethod {
+	return func(c *gin.Context, _ Authenticator) error {
+		token := &auth.Token{
+			AccessKey: c.GetHeader(APIKey),
+			Secret:    c.GetHeader(APISecret),
+		}
+		apiToken, err := authenticateAPIToken(c.Request.Context(), tokens, token)
+		if err != nil {
+			return err
+		}
+
+		c.Set(SessionAPITokenKey, apiToken)
+		user := apiToken.User()
+		c.Set(SessionUserKey, &user)
+
+		return nil
+	}
+}
+
+// authenticateAPIToken returns the API token matching the credentials, if it has not expired.
+func authenticateAPIToken(ctx context.Context, tokens clsessions.APITokenORM, token *auth.Token) (*clsessions.APIToken, error) {
+	if token.AccessKey == "" {
+		return nil, auth.ErrorAuthFailed
+	}
+
+	apiToken, err := tokens.FindAPIToken(ctx, token.AccessKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, auth.ErrorAuthFailed
+		}
+		return nil, err
+	}
+
+	ok, err := clsessions.AuthenticateAPIToken(token, &apiToken)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || apiToken.Expired(time.Now()) {
+		return nil, auth.ErrorAuthFailed
+	}
+
+	if err = tokens.MarkAPITokenUsed(ctx, apiToken.ID); err != nil {
+		return nil, errors.Wrap(err, "recording api token use")
+	}
+	return &apiToken, nil
+}
+
+// authorizeAPIToken returns an error if the API token lacks the permission the route requires.
+func authorizeAPIToken(c *gin.Context, apiToken *clsessions.APIToken) error {
+	perm, ok := RequiredPermission(c.Request.Method, c.FullPath())
+	if !ok {
+		return errors.New("route cannot be accessed with an API token")
+	}
+	if perm != "" && !apiToken.Permissions.Has(perm) {
+		return errors.Errorf("API token %q lacks the %s permission", apiToken.Name, perm)
+	}
+	return nil
+}
+
 // AuthenticateExternalInitiator authenticates an external initiator request.
 //
 // Implements authMethod
@@ -170,6 +236,15 @@ func Authenticate(store Authenticator, methods ...authMethod) gin.HandlerFunc {
 			return
 		}
 
+		if apiToken, ok := GetAuthenticatedAPIToken(c); ok {
+			if err = authorizeAPIToken(c, apiToken); err != nil {
+				c.Abort()
+				jsonAPIError(c, http.StatusForbidden, err)
+
+				return
+			}
+		}
+
 		c.Next()
 	}
 }
@@ -197,6 +272,19 @@ func GetAuthenticatedExternalInitiator(c *gin.Context) (*bridges.ExternalInitiat
 	return obj.(*bridges.ExternalInitiator), ok
 }
 
+// GetAuthenticatedAPIToken extracts the API token the request was authenticated by from the
+// context.
+func GetAuthenticatedAPIToken(c *gin.Context) (*clsessions.APIToken, bool) {
+	obj, ok := c.Get(SessionAPITokenKey)
+	if !ok {
+		return nil, false
+	}
+
+	apiToken, ok := obj.(*clsessions.APIToken)
+
+	return apiToken, ok
+}
+
 // RequiresRunRole extracts the user object from the context, and asserts the user's role is at least
 // 'run'
 func RequiresRunRole(handler func(*gin.Context)) func(*gin.Context) {
@@ -207,6 +295,11 @@ func RequiresRunRole(handler func(*gin.Context)) func(*gin.Context) {
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
 			return
 		}
+		if _, ok = GetAuthenticatedAPIToken(c); ok {
+			// The permissions of the API token were already checked by Authenticate
+			handler(c)
+			return
+		}
 		if user.Role == clsessions.UserRoleView {
 			c.Abort()
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
@@ -226,6 +319,11 @@ func RequiresEditRole(handler func(*gin.Context)) func(*gin.Context) {
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
 			return
 		}
+		if _, ok = GetAuthenticatedAPIToken(c); ok {
+			// The permissions of the API token were already checked by Authenticate
+			handler(c)
+			return
+		}
 		if user.Role == clsessions.UserRoleView || user.Role == clsessions.UserRoleRun {
 			c.Abort()
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
@@ -244,6 +342,11 @@ func RequiresAdminRole(handler func(*gin.Context)) func(*gin.Context) {
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
 			return
 		}
+		if _, ok = GetAuthenticatedAPIToken(c); ok {
+			// The permissions of the API token were already checked by Authenticate
+			handler(c)
+			return
+		}
 		if user.Role != clsessions.UserRoleAdmin {
 			c.Abort()
 			addForbiddenErrorHeaders(c, "admin", string(user.Role), user.Email)
@@ -2,22 +2,27 @@ package auth_test
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
 )
@@ -133,6 +138,105 @@ func TestAuthenticateByToken_RejectsBlankAccessKey(t *testing.T) {
 	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
 }
 
+func newAPIToken(t *testing.T, perms ...sessions.Permission) (sessions.APIToken, auth.Token) {
+	credentials := auth.NewToken()
+	hashedSecret, err := auth.HashedSecret(credentials, "salt")
+	require.NoError(t, err)
+	return sessions.APIToken{
+		ID:           1,
+		Name:         "ci",
+		AccessKey:    credentials.AccessKey,
+		Salt:         "salt",
+		HashedSecret: hashedSecret,
+		Permissions:  perms,
+	}, *credentials
+}
+
+func TestAuthenticateByAPIToken(t *testing.T) {
+	t.Parallel()
+
+	expired := null.TimeFrom(time.Now().Add(-time.Minute))
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		permissions []sessions.Permission
+		expiresAt   null.Time
+		badSecret   bool
+		status      int
+	}{
+		{"read permitted", "GET", "/v2/jobs", []sessions.Permission{sessions.PermissionJobsRead}, null.Time{}, false, http.StatusOK},
+		{"write permitted", "POST", "/v2/jobs", []sessions.Permission{sessions.PermissionJobsWrite}, null.Time{}, false, http.StatusOK},
+		{"write not permitted", "POST", "/v2/jobs", []sessions.Permission{sessions.PermissionJobsRead}, null.Time{}, false, http.StatusForbidden},
+		{"run not permitted by write", "POST", "/v2/jobs/:ID/runs", []sessions.Permission{sessions.PermissionJobsWrite}, null.Time{}, false, http.StatusForbidden},
+		{"unmapped route", "GET", "/v2/users", sessions.AllPermissions, null.Time{}, false, http.StatusForbidden},
+		{"ping", "GET", "/v2/ping", nil, null.Time{}, false, http.StatusOK},
+		{"expired", "GET", "/v2/jobs", []sessions.Permission{sessions.PermissionJobsRead}, expired, false, http.StatusUnauthorized},
+		{"bad secret", "GET", "/v2/jobs", []sessions.Permission{sessions.PermissionJobsRead}, null.Time{}, true, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			apiToken, credentials := newAPIToken(t, tt.permissions...)
+			apiToken.ExpiresAt = tt.expiresAt
+			tokens := mocks.NewAPITokenORM(t)
+			tokens.On("FindAPIToken", mock.Anything, credentials.AccessKey).Return(apiToken, nil)
+			if tt.status != http.StatusUnauthorized {
+				tokens.On("MarkAPITokenUsed", mock.Anything, apiToken.ID).Return(nil)
+			}
+
+			var authr webauth.Authenticator
+			router := gin.New()
+			router.Use(webauth.Authenticate(authr, webauth.AuthenticateByAPIToken(tokens)))
+			router.Handle(tt.method, tt.path, func(c *gin.Context) {
+				token, ok := webauth.GetAuthenticatedAPIToken(c)
+				assert.True(t, ok)
+				assert.Equal(t, "ci", token.Name)
+				c.String(http.StatusOK, "")
+			})
+
+			secret := credentials.Secret
+			if tt.badSecret {
+				secret = uuid.New().String()
+			}
+			w := httptest.NewRecorder()
+			req := mustRequest(t, tt.method, tt.path, nil)
+			req.Header.Set(webauth.APIKey, credentials.AccessKey)
+			req.Header.Set(webauth.APISecret, secret)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusText(tt.status), http.StatusText(w.Code))
+		})
+	}
+}
+
+func TestAuthenticateByAPIToken_UnknownAccessKey(t *testing.T) {
+	t.Parallel()
+
+	tokens := mocks.NewAPITokenORM(t)
+	tokens.On("FindAPIToken", mock.Anything, "unknown").Return(sessions.APIToken{}, sql.ErrNoRows)
+
+	called := false
+	var authr webauth.Authenticator
+	router := gin.New()
+	router.Use(webauth.Authenticate(authr, webauth.AuthenticateByAPIToken(tokens)))
+	router.GET("/v2/jobs", func(c *gin.Context) {
+		called = true
+		c.String(http.StatusOK, "")
+	})
+
+	w := httptest.NewRecorder()
+	req := mustRequest(t, "GET", "/v2/jobs", nil)
+	req.Header.Set(webauth.APIKey, "unknown")
+	req.Header.Set(webauth.APISecret, "secret")
+	router.ServeHTTP(w, req)
+
+	assert.False(t, called)
+	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
+}
+
 func TestRequireAuth_NoneRequired(t *testing.T) {
 	called := false
 	var authr webauth.Authenticator
@@ -222,6 +326,9 @@ var routesRolesMap = [...]routeRules{
 	{"POST", "/v2/users", false, false, false},
 	{"PATCH", "/v2/users", false, false, false},
 	{"DELETE", "/v2/users/MOCK", false, false, false},
+	{"GET", "/v2/api_tokens", false, false, false},
+	{"POST", "/v2/api_tokens", false, false, false},
+	{"DELETE", "/v2/api_tokens/MOCK", false, false, false},
 	{"PATCH", "/v2/user/password", true, true, true},
 	{"POST", "/v2/user/token", true, true, true},
 	{"POST", "/v2/user/token/delete", true, true, true},
@@ -244,6 +351,7 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/tx_attempts", true, true, true},
 	{"GET", "/v2/tx_attempts/evm", true, true, true},
 	{"GET", "/v2/transactions/evm", true, true, true},
+	{"GET", "/v2/transactions/evm/fee_bump_budgets", true, true, true},
 	{"GET", "/v2/transactions/evm/MOCK", true, true, true},
 	{"GET", "/v2/transactions", true, true, true},
 	{"GET", "/v2/transactions/MOCK", true, true, true},
@@ -300,6 +408,7 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/jobs/MOCK/runs", true, true, true},
 	{"GET", "/v2/jobs/MOCK/runs/MOCK", true, true, true},
 	{"GET", "/v2/features", true, true, true},
+	{"GET", "/v2/capabilities", true, true, true},
 	{"DELETE", "/v2/pipeline/job_spec_errors/MOCK", false, false, true},
 	{"GET", "/v2/log", true, true, true},
 	{"PATCH", "/v2/log", false, false, false},
@@ -307,6 +416,7 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/chains/solana", true, true, true},
 	{"GET", "/v2/chains/cosmos", true, true, true},
 	{"GET", "/v2/chains/evm/MOCK", true, true, true},
+	{"GET", "/v2/chains/evm/MOCK/reorgs", true, true, true},
 	{"GET", "/v2/chains/cosmos/MOCK", true, true, true},
 	{"GET", "/v2/nodes/", true, true, true},
 	{"GET", "/v2/nodes/evm", true, true, true},
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 
 	"github.com/gin-contrib/sessions"
@@ -17,19 +18,22 @@ type sessionUserKey struct{}
 type GQLSession struct {
 	SessionID string
 	User      *clsessions.User
+	// APIToken is set if the request was authenticated by an API token rather than a session, in
+	// which case its permissions apply instead of the role of the user.
+	APIToken *clsessions.APIToken
 }
 
-// AuthenticateGQL middleware checks the session cookie for a user and sets it
-// on the request context if it exists. It is the responsibility of each resolver
-// to validate whether it requires an authenticated user.
-//
-// We currently only support GQL authentication by session cookie.
-func AuthenticateGQL(authenticator Authenticator, lggr logger.Logger) gin.HandlerFunc {
+// AuthenticateGQL middleware checks the session cookie for a user, or else the
+// API token headers for a scoped API token, and sets it on the request context
+// if it exists. It is the responsibility of each resolver to validate whether
+// it requires an authenticated user.
+func AuthenticateGQL(authenticator Authenticator, apiTokens clsessions.APITokenORM, lggr logger.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		ctx := c.Request.Context()
 		session := sessions.Default(c)
 		sessionID, ok := session.Get(SessionIDKey).(string)
 		if !ok {
+			authenticateGQLByAPIToken(c, apiTokens, lggr)
 			return
 		}
 
@@ -49,6 +53,36 @@ func AuthenticateGQL(authenticator Authenticator, lggr logger.Logger) gin.Handle
 	}
 }
 
+func authenticateGQLByAPIToken(c *gin.Context, apiTokens clsessions.APITokenORM, lggr logger.Logger) {
+	token := &auth.Token{
+		AccessKey: c.GetHeader(APIKey),
+		Secret:    c.GetHeader(APISecret),
+	}
+	if token.AccessKey == "" {
+		return
+	}
+
+	apiToken, err := authenticateAPIToken(c.Request.Context(), apiTokens, token)
+	if err != nil {
+		if !errors.Is(err, auth.ErrorAuthFailed) {
+			lggr.Errorw("Failed to authenticate API token", "err", err)
+		}
+		return
+	}
+
+	c.Request = c.Request.WithContext(WithGQLAuthenticatedAPIToken(c.Request.Context(), apiToken))
+}
+
+// WithGQLAuthenticatedAPIToken sets the API token authenticated session in the context
+func WithGQLAuthenticatedAPIToken(ctx context.Context, apiToken *clsessions.APIToken) context.Context {
+	user := apiToken.User()
+	return context.WithValue(
+		ctx,
+		sessionUserKey{},
+		&GQLSession{User: &user, APIToken: apiToken},
+	)
+}
+
 // WithGQLAuthenticatedSession sets the authenticated session in the context
 //
 // There shouldn't be a need to do this outside of testing
@@ -56,7 +90,7 @@ func WithGQLAuthenticatedSession(ctx context.Context, user clsessions.User, sess
 	return context.WithValue(
 		ctx,
 		sessionUserKey{},
-		&GQLSession{sessionID, &user},
+		&GQLSession{SessionID: sessionID, User: &user},
 	)
 }
 
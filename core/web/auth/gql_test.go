@@ -10,6 +10,9 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	clauth "github.com/smartcontractkit/chainlink/v2/core/auth"
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
@@ -27,7 +30,7 @@ func Test_AuthenticateGQL_Unauthenticated(t *testing.T) {
 
 	r := gin.Default()
 	r.Use(sessions.Sessions(auth.SessionName, sessionStore))
-	r.Use(auth.AuthenticateGQL(sessionORM, logger.TestLogger(t)))
+	r.Use(auth.AuthenticateGQL(sessionORM, mocks.NewAPITokenORM(t), logger.TestLogger(t)))
 
 	r.GET("/", func(c *gin.Context) {
 		session, ok := auth.GetGQLAuthenticatedSession(c)
@@ -51,7 +54,7 @@ func Test_AuthenticateGQL_Authenticated(t *testing.T) {
 
 	r := gin.Default()
 	r.Use(sessions.Sessions(auth.SessionName, sessionStore))
-	r.Use(auth.AuthenticateGQL(sessionORM, logger.TestLogger(t)))
+	r.Use(auth.AuthenticateGQL(sessionORM, mocks.NewAPITokenORM(t), logger.TestLogger(t)))
 
 	r.GET("/", func(c *gin.Context) {
 		session, ok := auth.GetGQLAuthenticatedSession(c.Request.Context())
@@ -71,6 +74,51 @@ func Test_AuthenticateGQL_Authenticated(t *testing.T) {
 	r.ServeHTTP(w, req)
 }
 
+func Test_AuthenticateGQL_APIToken(t *testing.T) {
+	t.Parallel()
+
+	sessionORM := mocks.NewAuthenticationProvider(t)
+	apiTokens := mocks.NewAPITokenORM(t)
+	sessionStore := cookie.NewStore([]byte(cltest.SessionSecret))
+
+	credentials := clauth.NewToken()
+	hashedSecret, err := clauth.HashedSecret(credentials, "salt")
+	require.NoError(t, err)
+	apiToken := clsessions.APIToken{
+		ID:           1,
+		Name:         "ci",
+		AccessKey:    credentials.AccessKey,
+		Salt:         "salt",
+		HashedSecret: hashedSecret,
+		Permissions:  clsessions.Permissions{clsessions.PermissionJobsRead},
+	}
+
+	r := gin.Default()
+	r.Use(sessions.Sessions(auth.SessionName, sessionStore))
+	r.Use(auth.AuthenticateGQL(sessionORM, apiTokens, logger.TestLogger(t)))
+
+	r.GET("/", func(c *gin.Context) {
+		session, ok := auth.GetGQLAuthenticatedSession(c.Request.Context())
+		assert.True(t, ok)
+		if assert.NotNil(t, session) && assert.NotNil(t, session.APIToken) {
+			assert.Equal(t, "ci", session.APIToken.Name)
+			assert.Equal(t, "api-token:ci", session.User.Email)
+		}
+
+		c.String(http.StatusOK, "")
+	})
+
+	apiTokens.On("FindAPIToken", mock.Anything, credentials.AccessKey).Return(apiToken, nil)
+	apiTokens.On("MarkAPITokenUsed", mock.Anything, int64(1)).Return(nil)
+
+	w := httptest.NewRecorder()
+	req := mustRequest(t, "GET", "/", nil)
+	req.Header.Set(auth.APIKey, credentials.AccessKey)
+	req.Header.Set(auth.APISecret, credentials.Secret)
+
+	r.ServeHTTP(w, req)
+}
+
 func Test_GetAndSetGQLAuthenticatedSession(t *testing.T) {
 	t.Parallel()
 
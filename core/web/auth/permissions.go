@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// routePermissions maps the routes API tokens can access, by path prefix, to the permissions
+// required to read (GET) and to write (any other method) them. Routes which are not listed, like
+// user, session and API token management, can't be accessed with API tokens at all.
+var routePermissions = []struct {
+	prefix      string
+	read, write clsessions.Permission
+}{
+	{"/v2/jobs", clsessions.PermissionJobsRead, clsessions.PermissionJobsWrite},
+	{"/v2/pipeline", clsessions.PermissionJobsRead, clsessions.PermissionJobsWrite},
+	{"/v2/workflows", clsessions.PermissionJobsRead, clsessions.PermissionJobsWrite},
+	{"/v2/capabilities", clsessions.PermissionJobsRead, clsessions.PermissionJobsWrite},
+	{"/v2/bridge_types", clsessions.PermissionBridgesRead, clsessions.PermissionBridgesWrite},
+	{"/v2/external_initiators", clsessions.PermissionBridgesRead, clsessions.PermissionBridgesWrite},
+	{"/v2/keys", clsessions.PermissionKeysRead, clsessions.PermissionKeysWrite},
+	{"/v2/transactions", clsessions.PermissionTxsRead, clsessions.PermissionTxsSend},
+	{"/v2/tx_attempts", clsessions.PermissionTxsRead, clsessions.PermissionTxsSend},
+	{"/v2/transfers", clsessions.PermissionTxsRead, clsessions.PermissionTxsSend},
+	{"/v2/chains", clsessions.PermissionChainsRead, clsessions.PermissionChainsWrite},
+	{"/v2/nodes", clsessions.PermissionChainsRead, clsessions.PermissionChainsWrite},
+	{"/v2/replay_from_block", clsessions.PermissionChainsRead, clsessions.PermissionChainsWrite},
+	{"/v2/find_lca", clsessions.PermissionChainsRead, clsessions.PermissionChainsWrite},
+	{"/v2/config", clsessions.PermissionConfigRead, clsessions.PermissionConfigWrite},
+	{"/v2/log", clsessions.PermissionConfigRead, clsessions.PermissionConfigWrite},
+	{"/v2/features", clsessions.PermissionConfigRead, clsessions.PermissionConfigWrite},
+	{"/v2/build_info", clsessions.PermissionConfigRead, clsessions.PermissionConfigWrite},
+}
+
+// RequiredPermission returns the permission an API token needs to access the route with the given
+// method and path pattern, as returned by gin.Context.FullPath. An empty permission means any API
+// token can access the route, and false means no API token can.
+func RequiredPermission(method, path string) (clsessions.Permission, bool) {
+	switch {
+	case path == "/v2/ping":
+		return "", true
+	case method == http.MethodPost && path == "/v2/jobs/:ID/runs":
+		return clsessions.PermissionJobsRun, true
+	case strings.HasPrefix(path, "/v2/keys/") && strings.Contains(path, "/export/"):
+		return clsessions.PermissionKeysExport, true
+	}
+	for _, rp := range routePermissions {
+		if path != rp.prefix && !strings.HasPrefix(path, rp.prefix+"/") {
+			continue
+		}
+		if method == http.MethodGet {
+			return rp.read, true
+		}
+		return rp.write, true
+	}
+	return "", false
+}
@@ -0,0 +1,45 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+)
+
+func TestRequiredPermission(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		method string
+		path   string
+		perm   sessions.Permission
+		ok     bool
+	}{
+		{"GET", "/v2/ping", "", true},
+		{"GET", "/v2/jobs", sessions.PermissionJobsRead, true},
+		{"GET", "/v2/jobs/:ID", sessions.PermissionJobsRead, true},
+		{"POST", "/v2/jobs", sessions.PermissionJobsWrite, true},
+		{"DELETE", "/v2/jobs/:ID", sessions.PermissionJobsWrite, true},
+		{"POST", "/v2/jobs/:ID/runs", sessions.PermissionJobsRun, true},
+		{"GET", "/v2/pipeline/runs", sessions.PermissionJobsRead, true},
+		{"PATCH", "/v2/bridge_types/:BridgeName", sessions.PermissionBridgesWrite, true},
+		{"GET", "/v2/keys/eth", sessions.PermissionKeysRead, true},
+		{"POST", "/v2/keys/eth", sessions.PermissionKeysWrite, true},
+		{"POST", "/v2/keys/eth/export/:address", sessions.PermissionKeysExport, true},
+		{"POST", "/v2/transfers", sessions.PermissionTxsSend, true},
+		{"GET", "/v2/chains/evm", sessions.PermissionChainsRead, true},
+		{"PATCH", "/v2/log", sessions.PermissionConfigWrite, true},
+		{"GET", "/v2/jobsfoo", "", false},
+		{"GET", "/v2/users", "", false},
+		{"POST", "/v2/api_tokens", "", false},
+		{"POST", "/v2/user/token", "", false},
+	}
+	for _, tt := range tests {
+		perm, ok := webauth.RequiredPermission(tt.method, tt.path)
+		assert.Equal(t, tt.ok, ok, "%s %s", tt.method, tt.path)
+		assert.Equal(t, tt.perm, perm, "%s %s", tt.method, tt.path)
+	}
+}
@@ -0,0 +1,35 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// CapabilitiesController displays the capabilities of the capabilities registry.
+type CapabilitiesController struct {
+	App chainlink.Application
+}
+
+// Index lists every registered capability, sorted by ID.
+// Example:
+//
+//	"GET <application>/capabilities"
+func (cc *CapabilitiesController) Index(c *gin.Context) {
+	described, err := capabilities.Describe(c.Request.Context(), cc.App.GetCapabilitiesRegistry())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := []presenters.CapabilityResource{}
+	for _, rc := range described {
+		resources = append(resources, presenters.NewCapabilityResource(rc))
+	}
+
+	jsonAPIResponse(c, resources, "capabilities")
+}
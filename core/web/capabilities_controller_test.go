@@ -0,0 +1,47 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities/triggers"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestCapabilitiesController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	trigger := triggers.NewOnDemand(logger.TestLogger(t))
+	info, err := trigger.Info(ctx)
+	require.NoError(t, err)
+	registry := app.GetCapabilitiesRegistry()
+	require.NoError(t, registry.Add(ctx, trigger))
+	registry.(capabilities.WorkflowTracker).RegisterWorkflow(info.ID, "workflow-1")
+
+	client := app.NewHTTPClient(nil)
+	resp, cleanup := client.Get("/v2/capabilities")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var resources []presenters.CapabilityResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resources))
+	require.Len(t, resources, 1)
+	assert.Equal(t, info.ID, resources[0].ID)
+	assert.Equal(t, "trigger", resources[0].CapabilityType)
+	assert.Equal(t, info.Version, resources[0].Version)
+	assert.True(t, resources[0].Local)
+	assert.Nil(t, resources[0].DON)
+	assert.Equal(t, []string{"workflow-1"}, resources[0].Workflows)
+}
@@ -0,0 +1,61 @@
+package web
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// EVMFeeBumpBudgetsController displays the remaining fee bump budgets of the EVM keys.
+type EVMFeeBumpBudgetsController struct {
+	App chainlink.Application
+}
+
+// Index lists the fee bump budget of every enabled key, on the chains which
+// have fee bump budgets enabled. The chains can be narrowed down with the
+// evmChainID query param.
+// Example:
+//
+//	"<application>/transactions/evm/fee_bump_budgets"
+func (fc *EVMFeeBumpBudgetsController) Index(c *gin.Context) {
+	ctx := c.Request.Context()
+	legacyChains := fc.App.GetRelayers().LegacyEVMChains()
+
+	chains := legacyChains.Slice()
+	if cid := c.Query("evmChainID"); cid != "" {
+		chain, err := getChain(legacyChains, cid)
+		if err != nil {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+		chains = []legacyevm.Chain{chain}
+	}
+
+	resources := []presenters.EVMFeeBumpBudgetResource{}
+	for _, chain := range chains {
+		cfg := chain.Config().EVM().Transactions().FeeBumpBudget()
+		if !cfg.Enabled() {
+			continue
+		}
+		addresses, err := fc.App.GetKeyStore().Eth().EnabledAddressesForChain(ctx, chain.ID())
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		spends, err := fc.App.TxmStorageService().FeeBumpSpendsByAddresses(ctx, addresses, time.Now().Add(-cfg.Window()), chain.ID())
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		for _, address := range addresses {
+			resources = append(resources, presenters.NewEVMFeeBumpBudgetResource(chain.ID(), address, cfg.Window(), cfg.MaxPerKey(), spends[address]))
+		}
+	}
+
+	jsonAPIResponse(c, resources, "evmFeeBumpBudgets")
+}
@@ -0,0 +1,64 @@
+package web_test
+
+import (
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestEVMFeeBumpBudgetsController_Index(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.FeeBumpBudget.Enabled = ptr(true)
+		c.EVM[0].Transactions.FeeBumpBudget.MaxPerKey = assets.NewWeiI(1000)
+	})
+	app := cltest.NewApplicationWithConfigAndKey(t, cfg)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	from := app.Keys[0].Address
+	require.NoError(t, app.TxmStorageService().InsertFeeBumpSpend(ctx, from, 1, nil, big.NewInt(400), testutils.FixtureChainID))
+
+	client := app.NewHTTPClient(nil)
+
+	t.Run("lists the budgets of the keys", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/transactions/evm/fee_bump_budgets")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var budgets []presenters.EVMFeeBumpBudgetResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &budgets))
+		require.Len(t, budgets, 1)
+		assert.Equal(t, from.Hex(), budgets[0].Address)
+		assert.Equal(t, testutils.FixtureChainID.String(), budgets[0].EVMChainID.String())
+		assert.Equal(t, "24h0m0s", budgets[0].Window)
+		assert.Equal(t, big.NewInt(1000), budgets[0].Budget.ToInt())
+		assert.Equal(t, big.NewInt(400), budgets[0].Spent.ToInt())
+		assert.Equal(t, big.NewInt(600), budgets[0].Remaining.ToInt())
+	})
+
+	t.Run("filters by chain", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/transactions/evm/fee_bump_budgets?evmChainID=" + testutils.FixtureChainID.String())
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var budgets []presenters.EVMFeeBumpBudgetResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &budgets))
+		require.Len(t, budgets, 1)
+
+		resp, cleanup = client.Get("/v2/transactions/evm/fee_bump_budgets?evmChainID=424242")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+	})
+}
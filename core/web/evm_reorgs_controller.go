@@ -0,0 +1,43 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// EVMReorgsController displays the reorgs detected by the head trackers of the EVM chains.
+type EVMReorgsController struct {
+	App chainlink.Application
+}
+
+// Index lists the reorgs of an EVM chain, the most recent first.
+// Example:
+//
+//	"<application>/chains/evm/:ID/reorgs"
+func (rc *EVMReorgsController) Index(c *gin.Context, size, page, offset int) {
+	chain, err := getChain(rc.App.GetRelayers().LegacyEVMChains(), c.Param("ID"))
+	if err != nil {
+		if errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusNotFound, err)
+			return
+		}
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	orm := headtracker.NewORM(*chain.ID(), rc.App.GetDB())
+	reorgs, count, err := orm.Reorgs(c.Request.Context(), offset, size)
+
+	resources := []presenters.EVMReorgResource{}
+	for _, reorg := range reorgs {
+		resources = append(resources, presenters.NewEVMReorgResource(chain.ID(), reorg))
+	}
+
+	paginatedResponse(c, "reorgs", size, page, resources, count, err)
+}
@@ -0,0 +1,61 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestEVMReorgsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	orm := headtracker.NewORM(*testutils.FixtureChainID, app.GetDB())
+	reorg := httypes.Reorg{Depth: 2, CommonAncestorNumber: 8, CommonAncestorHash: testutils.NewHash(), OldHeadNumber: 10, OldHeadHash: testutils.NewHash(),
+		NewHeadNumber: 11, NewHeadHash: testutils.NewHash(), DetectedAt: time.Now()}
+	require.NoError(t, orm.InsertReorg(ctx, reorg))
+
+	client := app.NewHTTPClient(nil)
+
+	t.Run("lists the reorgs of the chain", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/chains/evm/" + testutils.FixtureChainID.String() + "/reorgs")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		body := cltest.ParseResponseBody(t, resp)
+		metaCount, err := cltest.ParseJSONAPIResponseMetaCount(body)
+		require.NoError(t, err)
+		require.Equal(t, 1, metaCount)
+
+		var links jsonapi.Links
+		var reorgs []presenters.EVMReorgResource
+		require.NoError(t, web.ParsePaginatedResponse(body, &reorgs, &links))
+		require.Len(t, reorgs, 1)
+		assert.Equal(t, int64(2), reorgs[0].Depth)
+		assert.Equal(t, int64(9), reorgs[0].FromBlock)
+		assert.Equal(t, int64(10), reorgs[0].ToBlock)
+		assert.Equal(t, reorg.CommonAncestorHash, *reorgs[0].CommonAncestorHash)
+		assert.Equal(t, reorg.OldHeadHash, reorgs[0].OldHeadHash)
+		assert.Equal(t, reorg.NewHeadHash, reorgs[0].NewHeadHash)
+	})
+
+	t.Run("unknown chain", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/chains/evm/424242/reorgs")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
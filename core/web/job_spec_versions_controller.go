@@ -0,0 +1,207 @@
+package web
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// JobSpecVersionsController exposes the history of the specs a job was created or updated with
+// through the jobs API, and allows rolling a job back to one of them.
+type JobSpecVersionsController struct {
+	App chainlink.Application
+}
+
+// Index lists the spec versions of a job, most recent first.
+// Example:
+// "GET <application>/jobs/:ID/versions"
+func (svc *JobSpecVersionsController) Index(c *gin.Context) {
+	jobID, err := parseJobID(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	versions, err := svc.App.JobORM().FindSpecVersions(c.Request.Context(), jobID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobSpecVersionResources(versions), "jobSpecVersions")
+}
+
+// Diff returns the changes between two spec versions of a job. By default, the latest
+// version is compared to the one before it.
+// Example:
+// "GET <application>/jobs/:ID/diff?from=1&to=3"
+func (svc *JobSpecVersionsController) Diff(c *gin.Context) {
+	ctx := c.Request.Context()
+	jobID, err := parseJobID(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	fromVersion, err := parseVersionQuery(c, "from")
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	toVersion, err := parseVersionQuery(c, "to")
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if toVersion == 0 {
+		versions, err2 := svc.App.JobORM().FindSpecVersions(ctx, jobID)
+		if err2 != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err2)
+			return
+		}
+		if len(versions) == 0 {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job has no spec versions"))
+			return
+		}
+		toVersion = versions[0].Version
+	}
+	if fromVersion == 0 {
+		fromVersion = toVersion - 1
+	}
+
+	from, ok := svc.findSpecVersion(c, jobID, fromVersion)
+	if !ok {
+		return
+	}
+	to, ok := svc.findSpecVersion(c, jobID, toVersion)
+	if !ok {
+		return
+	}
+
+	changes, err := job.DiffSpecVersions(from, to)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobSpecDiffResource(jobID, fromVersion, toVersion, changes), "jobSpecDiffs")
+}
+
+// RollbackJobRequest represents a request to recreate a job from one of its spec versions.
+type RollbackJobRequest struct {
+	Version int32 `json:"version"`
+}
+
+// Rollback recreates a job from one of its prior spec versions, keeping its ID. The
+// existing job, if any, is stopped and deleted first. The rolled back spec is recorded as
+// a new version, so that history is never rewritten.
+// Example:
+// "POST <application>/jobs/:ID/rollback"
+func (svc *JobSpecVersionsController) Rollback(c *gin.Context) {
+	jobID, err := parseJobID(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	request := RollbackJobRequest{}
+	if err = c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	version, ok := svc.findSpecVersion(c, jobID, request.Version)
+	if !ok {
+		return
+	}
+
+	jc := JobsController{App: svc.App}
+	jb, status, err := jc.validateJobSpec(c.Request.Context(), version.TOML)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+	jb.ID = jobID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	existing, err := svc.App.JobORM().FindJob(ctx, jobID)
+	if err == nil || errors.Is(err, sql.ErrNoRows) {
+		if restoreErr := restoreSignatureSecret(&jb, existing); restoreErr != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, restoreErr)
+			return
+		}
+	}
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		// The job was deleted since, recreate it.
+		status, err = jc.addJob(ctx, &jb)
+	case err != nil:
+		status = http.StatusInternalServerError
+	default:
+		status, err = jc.replaceJob(ctx, &jb)
+	}
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	svc.App.GetAuditLogger().Audit(audit.JobRolledBack, map[string]interface{}{"id": jobID, "version": version.Version})
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// restoreSignatureSecret puts the signature secret of the existing webhook job back into jb, as it's redacted from the
+// spec versions.
+func restoreSignatureSecret(jb *job.Job, existing job.Job) error {
+	if jb.WebhookSpec == nil || jb.WebhookSpec.SignatureSecret != job.RedactedSignatureSecret {
+		return nil
+	}
+	if existing.WebhookSpec == nil || existing.WebhookSpec.SignatureSecret == "" {
+		return errors.New("the signature secret of this version was redacted and the job has no secret to restore it from")
+	}
+	jb.WebhookSpec.SignatureSecret = existing.WebhookSpec.SignatureSecret
+	return nil
+}
+
+func (svc *JobSpecVersionsController) findSpecVersion(c *gin.Context, jobID int32, version int32) (job.SpecVersion, bool) {
+	specVersion, err := svc.App.JobORM().FindSpecVersion(c.Request.Context(), jobID, version)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("job spec version %d not found", version))
+		return specVersion, false
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return specVersion, false
+	}
+	return specVersion, true
+}
+
+func parseJobID(c *gin.Context) (int32, error) {
+	jb := job.Job{}
+	if err := jb.SetID(c.Param("ID")); err != nil {
+		return 0, err
+	}
+	return jb.ID, nil
+}
+
+func parseVersionQuery(c *gin.Context, param string) (int32, error) {
+	v := c.Query(param)
+	if v == "" {
+		return 0, nil
+	}
+	version, err := strconv.ParseInt(v, 10, 32)
+	if err != nil || version < 1 {
+		return 0, errors.Errorf("invalid %s version %q", param, v)
+	}
+	return int32(version), nil
+}
@@ -0,0 +1,154 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestJobSpecVersionsController_HistoryDiffAndRollback(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	_, bridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	_, bridge2 := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+
+	client := app.NewHTTPClient(nil)
+
+	externalJobID := uuid.New()
+	v1 := testspecs.GetWebhookSpecNoBody(externalJobID, bridge.Name.String(), bridge2.Name.String())
+	body, _ := json.Marshal(web.CreateJobRequest{TOML: v1})
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	resource := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	jobID := mustInt32FromString(t, resource.ID)
+
+	v2 := testspecs.GetWebhookSpecNoBody(externalJobID, bridge2.Name.String(), bridge.Name.String())
+	body, _ = json.Marshal(web.UpdateJobRequest{TOML: v2})
+	response, cleanup = client.Put(fmt.Sprintf("/v2/jobs/%d", jobID), bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	t.Run("history", func(t *testing.T) {
+		response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/versions", jobID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var versions []presenters.JobSpecVersionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &versions))
+		require.Len(t, versions, 2)
+		assert.Equal(t, int32(2), versions[0].Version)
+		assert.Equal(t, v2, versions[0].TOML)
+		assert.Equal(t, int32(1), versions[1].Version)
+		assert.Equal(t, v1, versions[1].TOML)
+	})
+
+	t.Run("diff", func(t *testing.T) {
+		response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/diff", jobID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var diff presenters.JobSpecDiffResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &diff))
+		assert.Equal(t, int32(1), diff.FromVersion)
+		assert.Equal(t, int32(2), diff.ToVersion)
+		require.Len(t, diff.Changes, 1)
+		assert.Equal(t, "observationSource", diff.Changes[0].Key)
+		assert.Equal(t, job.SpecChangeModified, diff.Changes[0].Type)
+	})
+
+	t.Run("diff with unknown version", func(t *testing.T) {
+		response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/diff?from=1&to=7", jobID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		body, _ := json.Marshal(web.RollbackJobRequest{Version: 1})
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/rollback", jobID), bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		jb, err := app.JobORM().FindJob(ctx, jobID)
+		require.NoError(t, err)
+		assert.Contains(t, jb.PipelineSpec.DotDagSource, fmt.Sprintf(`fetch          [type=bridge name="%s"]`, bridge.Name.String()))
+
+		versions, err := app.JobORM().FindSpecVersions(ctx, jobID)
+		require.NoError(t, err)
+		require.Len(t, versions, 3)
+		assert.Equal(t, v1, versions[0].TOML)
+	})
+
+	t.Run("rollback to unknown version", func(t *testing.T) {
+		body, _ := json.Marshal(web.RollbackJobRequest{Version: 9})
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/rollback", jobID), bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+}
+
+func TestJobSpecVersionsController_RedactsSignatureSecret(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	_, bridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	_, bridge2 := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+
+	client := app.NewHTTPClient(nil)
+
+	const secret = "partner-secret"
+	spec := testspecs.GetWebhookSpecNoBody(uuid.New(), bridge.Name.String(), bridge2.Name.String()) +
+		fmt.Sprintf("signatureSecret = %q\n", secret)
+	body, _ := json.Marshal(web.CreateJobRequest{TOML: spec})
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	resource := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	jobID := mustInt32FromString(t, resource.ID)
+
+	response, cleanup = client.Get(fmt.Sprintf("/v2/jobs/%d/versions", jobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	responseBody := cltest.ParseResponseBody(t, response)
+	assert.NotContains(t, string(responseBody), secret)
+
+	var versions []presenters.JobSpecVersionResource
+	require.NoError(t, web.ParseJSONAPIResponse(responseBody, &versions))
+	require.Len(t, versions, 1)
+	assert.Contains(t, versions[0].TOML, job.RedactedSignatureSecret)
+
+	t.Run("rollback keeps the secret of the job", func(t *testing.T) {
+		body, _ := json.Marshal(web.RollbackJobRequest{Version: 1})
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/rollback", jobID), bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		jb, err := app.JobORM().FindJob(ctx, jobID)
+		require.NoError(t, err)
+		require.NotNil(t, jb.WebhookSpec)
+		assert.Equal(t, secret, jb.WebhookSpec.SignatureSecret)
+
+		versions, err := app.JobORM().FindSpecVersions(ctx, jobID)
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		assert.NotContains(t, versions[0].TOML, secret)
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
 	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/evmlog"
 	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -114,13 +115,8 @@ func (jc *JobsController) Create(c *gin.Context) {
 
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
-	err = jc.App.AddJobV2(ctx, &jb)
-	if err != nil {
-		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) || errors.Is(errors.Cause(err), job.ErrNoSuchSendingKey) {
-			jsonAPIError(c, http.StatusBadRequest, err)
-			return
-		}
-		jsonAPIError(c, http.StatusInternalServerError, err)
+	if status, err = jc.addJob(ctx, &jb); err != nil {
+		jsonAPIError(c, status, err)
 		return
 	}
 
@@ -134,6 +130,38 @@ func (jc *JobsController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
+// SimulateJobRequest represents a request to execute the pipeline of a job spec without creating the job.
+type SimulateJobRequest struct {
+	TOML string                 `json:"toml"`
+	Vars map[string]interface{} `json:"vars"`
+}
+
+// Simulate validates a job spec and executes its pipeline in-memory with the supplied vars.
+// Side-effecting tasks are stubbed out and neither the job nor the run is saved.
+// Example:
+// "POST <application>/jobs/simulate"
+func (jc *JobsController) Simulate(c *gin.Context) {
+	request := SimulateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(c.Request.Context(), request.TOML)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	run, _, err := jc.App.SimulateJobV2(c.Request.Context(), jb, request.Vars)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineRunResource(*run, jc.App.GetLogger()), "pipelineRun")
+}
+
 // Delete hard deletes a job spec.
 // Example:
 // "DELETE <application>/specs/:ID"
@@ -190,29 +218,38 @@ func (jc *JobsController) Update(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
 
+	if status, err = jc.replaceJob(ctx, &jb); err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// replaceJob stops and deletes the existing job with the same ID as jb, then saves and starts jb in its place.
+func (jc *JobsController) replaceJob(ctx context.Context, jb *job.Job) (statusCode int, err error) {
 	// If the provided job id is not matching any job, delete will fail with 404 leaving state unchanged.
 	err = jc.App.DeleteJob(ctx, jb.ID)
 	// Error can be either come from ORM or from the activeJobs map.
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) || strings.Contains(err.Error(), "job not found") {
-			jsonAPIError(c, http.StatusNotFound, errors.Wrap(err, "failed to update job"))
-			return
+			return http.StatusNotFound, errors.Wrap(err, "failed to update job")
 		}
-		jsonAPIError(c, http.StatusInternalServerError, err)
-		return
+		return http.StatusInternalServerError, err
 	}
+	return jc.addJob(ctx, jb)
+}
 
-	err = jc.App.AddJobV2(ctx, &jb)
+// addJob saves and starts jb.
+func (jc *JobsController) addJob(ctx context.Context, jb *job.Job) (statusCode int, err error) {
+	err = jc.App.AddJobV2(ctx, jb)
 	if err != nil {
 		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) || errors.Is(errors.Cause(err), job.ErrNoSuchSendingKey) {
-			jsonAPIError(c, http.StatusBadRequest, err)
-			return
+			return http.StatusBadRequest, err
 		}
-		jsonAPIError(c, http.StatusInternalServerError, err)
-		return
+		return http.StatusInternalServerError, err
 	}
-
-	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+	return 0, nil
 }
 
 func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string) (jb job.Job, statusCode int, err error) {
@@ -234,6 +271,8 @@ func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string
 		}
 	case job.DirectRequest:
 		jb, err = directrequest.ValidatedDirectRequestSpec(tomlString)
+	case job.EVMLog:
+		jb, err = evmlog.ValidatedEVMLogSpec(tomlString)
 	case job.FluxMonitor:
 		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config.JobPipeline(), tomlString)
 	case job.Keeper:
@@ -266,5 +305,6 @@ func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string
 	if err != nil {
 		return jb, http.StatusBadRequest, err
 	}
+	jb.SpecTOML = tomlString
 	return jb, 0, nil
 }
@@ -527,6 +527,59 @@ func TestJobsController_FailToCreate_EmptyJsonAttribute(t *testing.T) {
 	require.Contains(t, string(b), "syntax is not supported. Please use \\\"{}\\\" instead")
 }
 
+func TestJobsController_Simulate(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(nil)
+
+	tomlStr := fmt.Sprintf(`
+type = "webhook"
+schemaVersion = 1
+externalJobID = "%s"
+observationSource = """
+    ds_multiply [type=multiply input="$(price)" times=3];
+"""
+`, uuid.New())
+
+	t.Run("executes the pipeline with the supplied vars", func(t *testing.T) {
+		body, err := json.Marshal(web.SimulateJobRequest{
+			TOML: tomlStr,
+			Vars: map[string]interface{}{"price": "100.5"},
+		})
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+		defer cleanup()
+		require.Equal(t, http.StatusOK, response.StatusCode)
+
+		run := presenters.PipelineRunResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &run))
+		require.Len(t, run.TaskRuns, 1)
+		assert.Equal(t, "ds_multiply", run.TaskRuns[0].DotID)
+		assert.Nil(t, run.TaskRuns[0].Error)
+		require.NotNil(t, run.TaskRuns[0].Output)
+		assert.Equal(t, `"301.5"`, *run.TaskRuns[0].Output)
+		assert.True(t, run.TaskRuns[0].FinishedAt.Valid)
+
+		// nothing is persisted
+		_, count, err := app.JobORM().FindJobs(ctx, 0, 10)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+		var runs int
+		require.NoError(t, app.GetDB().GetContext(ctx, &runs, `SELECT count(*) FROM pipeline_runs`))
+		assert.Zero(t, runs)
+	})
+
+	t.Run("rejects an invalid spec", func(t *testing.T) {
+		body, err := json.Marshal(web.SimulateJobRequest{TOML: `type = "webhook"`})
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+		defer cleanup()
+		assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+	})
+}
+
 func TestJobsController_Index_HappyPath(t *testing.T) {
 	_, client, ocrJobSpecFromFile, _, ereJobSpecFromFile, _ := setupJobSpecsControllerTestsWithJobs(t)
 
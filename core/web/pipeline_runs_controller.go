@@ -120,6 +120,21 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 			return
 		}
 		if canRun {
+			// Signature and rate limit settings of the job only apply to external initiators, so that
+			// node operators can still run the job by hand.
+			if !isUser {
+				if err3 := prc.App.VerifyWebhookRequest(ctx, jobUUID, c.Request.Header, bodyBytes); err3 != nil {
+					switch {
+					case errors.Is(err3, webhook.ErrJobNotExists):
+						jsonAPIError(c, http.StatusNotFound, err3)
+					case errors.Is(err3, webhook.ErrRateLimited):
+						jsonAPIError(c, http.StatusTooManyRequests, err3)
+					default:
+						jsonAPIError(c, http.StatusUnauthorized, err3)
+					}
+					return
+				}
+			}
 			jobRunID, err3 := prc.App.RunWebhookJobV2(ctx, jobUUID, string(bodyBytes), jsonserializable.JSONSerializable{})
 			if errors.Is(err3, webhook.ErrJobNotExists) {
 				jsonAPIError(c, http.StatusNotFound, err3)
@@ -0,0 +1,58 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// APITokenResource represents a scoped API token JSONAPI resource. The
+// secret is only present when the token was just created.
+type APITokenResource struct {
+	JAID
+	Name        string     `json:"name"`
+	AccessKey   string     `json:"accessKey"`
+	Secret      string     `json:"secret,omitempty"`
+	Permissions []string   `json:"permissions"`
+	CreatedBy   string     `json:"createdBy"`
+	ExpiresAt   *time.Time `json:"expiresAt"`
+	LastUsedAt  *time.Time `json:"lastUsedAt"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r APITokenResource) GetName() string {
+	return "apiTokens"
+}
+
+// NewAPITokenResource constructs a new APITokenResource.
+func NewAPITokenResource(t sessions.APIToken) *APITokenResource {
+	return &APITokenResource{
+		JAID:        NewJAID(t.Name),
+		Name:        t.Name,
+		AccessKey:   t.AccessKey,
+		Permissions: t.Permissions.Strings(),
+		CreatedBy:   t.CreatedBy,
+		ExpiresAt:   t.ExpiresAt.Ptr(),
+		LastUsedAt:  t.LastUsedAt.Ptr(),
+		CreatedAt:   t.CreatedAt,
+	}
+}
+
+// NewCreatedAPITokenResource constructs a new APITokenResource including the
+// secret of the newly created token.
+func NewCreatedAPITokenResource(t sessions.APIToken, credentials auth.Token) *APITokenResource {
+	r := NewAPITokenResource(t)
+	r.Secret = credentials.Secret
+	return r
+}
+
+// NewAPITokenResources constructs a list of APITokenResources.
+func NewAPITokenResources(tokens []sessions.APIToken) []APITokenResource {
+	rs := []APITokenResource{}
+	for _, t := range tokens {
+		rs = append(rs, *NewAPITokenResource(t))
+	}
+	return rs
+}
@@ -0,0 +1,51 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities"
+)
+
+// CapabilityDONResource is the DON providing a remote capability.
+type CapabilityDONResource struct {
+	ID      string   `json:"id"`
+	Members []string `json:"members"`
+	F       uint8    `json:"f"`
+}
+
+// CapabilityResource is a capability of the capabilities registry.
+type CapabilityResource struct {
+	JAID
+	CapabilityType string                 `json:"capabilityType"`
+	Description    string                 `json:"description"`
+	Version        string                 `json:"version"`
+	Local          bool                   `json:"local"`
+	DON            *CapabilityDONResource `json:"don"`
+	Workflows      []string               `json:"workflows"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r CapabilityResource) GetName() string {
+	return "capabilities"
+}
+
+// NewCapabilityResource returns a new CapabilityResource. The DON is null for
+// local capabilities.
+func NewCapabilityResource(c capabilities.RegisteredCapability) CapabilityResource {
+	r := CapabilityResource{
+		JAID:           NewJAID(c.ID),
+		CapabilityType: c.CapabilityType.String(),
+		Description:    c.Description,
+		Version:        c.Version,
+		Local:          c.Local,
+		Workflows:      c.Workflows,
+	}
+	if r.Workflows == nil {
+		r.Workflows = []string{}
+	}
+	if c.DON != nil {
+		r.DON = &CapabilityDONResource{ID: c.DON.ID, F: c.DON.F}
+		for _, member := range c.DON.Members {
+			r.DON.Members = append(r.DON.Members, member.String())
+		}
+	}
+	return r
+}
@@ -0,0 +1,53 @@
+package presenters
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// EVMFeeBumpBudgetResource represents the fee bump budget of an EVM key over
+// the rolling window. Budget and Remaining are nil when keys have no budget.
+type EVMFeeBumpBudgetResource struct {
+	JAID
+	EVMChainID ubig.Big    `json:"evmChainID"`
+	Address    string      `json:"address"`
+	Window     string      `json:"window"`
+	Budget     *assets.Eth `json:"budget"`
+	Spent      *assets.Eth `json:"spent"`
+	Remaining  *assets.Eth `json:"remaining"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r EVMFeeBumpBudgetResource) GetName() string {
+	return "evmFeeBumpBudgets"
+}
+
+// NewEVMFeeBumpBudgetResource constructs a new EVMFeeBumpBudgetResource. A
+// key never has less than zero remaining, as bumps exceeding the budget are
+// not spent.
+func NewEVMFeeBumpBudgetResource(chainID *big.Int, address common.Address, window time.Duration, budget *assets.Wei, spent *big.Int) EVMFeeBumpBudgetResource {
+	if spent == nil {
+		spent = big.NewInt(0)
+	}
+	r := EVMFeeBumpBudgetResource{
+		JAID:       NewPrefixedJAID(address.Hex(), chainID.String()),
+		EVMChainID: *ubig.New(chainID),
+		Address:    address.Hex(),
+		Window:     window.String(),
+		Spent:      (*assets.Eth)(new(big.Int).Set(spent)),
+	}
+	if budget != nil && !budget.IsZero() {
+		remaining := new(big.Int).Sub(budget.ToInt(), spent)
+		if remaining.Sign() < 0 {
+			remaining.SetInt64(0)
+		}
+		r.Budget = (*assets.Eth)(new(big.Int).Set(budget.ToInt()))
+		r.Remaining = (*assets.Eth)(remaining)
+	}
+	return r
+}
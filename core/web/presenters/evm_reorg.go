@@ -0,0 +1,57 @@
+package presenters
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// EVMReorgResource is a reorg detected by the head tracker of an EVM chain.
+type EVMReorgResource struct {
+	JAID
+	EVMChainID           ubig.Big     `json:"evmChainID"`
+	Depth                int64        `json:"depth"`
+	FromBlock            int64        `json:"fromBlock"`
+	ToBlock              int64        `json:"toBlock"`
+	CommonAncestorNumber int64        `json:"commonAncestorNumber"`
+	CommonAncestorHash   *common.Hash `json:"commonAncestorHash"`
+	OldHeadNumber        int64        `json:"oldHeadNumber"`
+	OldHeadHash          common.Hash  `json:"oldHeadHash"`
+	NewHeadNumber        int64        `json:"newHeadNumber"`
+	NewHeadHash          common.Hash  `json:"newHeadHash"`
+	DetectedAt           time.Time    `json:"detectedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r EVMReorgResource) GetName() string {
+	return "evmReorgs"
+}
+
+// NewEVMReorgResource returns a new EVMReorgResource for the reorg. The
+// CommonAncestorHash is null if the reorg went deeper than the known chain.
+func NewEVMReorgResource(chainID *big.Int, reorg httypes.Reorg) EVMReorgResource {
+	from, to := reorg.AffectedRange()
+	r := EVMReorgResource{
+		JAID:                 NewPrefixedJAID(fmt.Sprintf("%s-%d", reorg.NewHeadHash, reorg.DetectedAt.UnixNano()), chainID.String()),
+		EVMChainID:           *ubig.New(chainID),
+		Depth:                reorg.Depth,
+		FromBlock:            from,
+		ToBlock:              to,
+		CommonAncestorNumber: reorg.CommonAncestorNumber,
+		OldHeadNumber:        reorg.OldHeadNumber,
+		OldHeadHash:          reorg.OldHeadHash,
+		NewHeadNumber:        reorg.NewHeadNumber,
+		NewHeadHash:          reorg.NewHeadHash,
+		DetectedAt:           reorg.DetectedAt,
+	}
+	if reorg.CommonAncestorHash != (common.Hash{}) {
+		hash := reorg.CommonAncestorHash
+		r.CommonAncestorHash = &hash
+	}
+	return r
+}
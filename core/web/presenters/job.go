@@ -28,6 +28,7 @@ func (t JobSpecType) String() string {
 
 const (
 	DirectRequestJobSpec        JobSpecType = "directrequest"
+	EVMLogJobSpec               JobSpecType = "evmlog"
 	FluxMonitorJobSpec          JobSpecType = "fluxmonitor"
 	OffChainReportingJobSpec    JobSpecType = "offchainreporting"
 	KeeperJobSpec               JobSpecType = "keeper"
@@ -71,6 +72,34 @@ func NewDirectRequestSpec(spec *job.DirectRequestSpec) *DirectRequestSpec {
 	}
 }
 
+// EVMLogSpec defines the spec details of an EVMLog Job
+type EVMLogSpec struct {
+	ContractAddress  types.EIP55Address `json:"contractAddress"`
+	EventABI         string             `json:"eventABI"`
+	Topic2           []string           `json:"topic2"`
+	Topic3           []string           `json:"topic3"`
+	Topic4           []string           `json:"topic4"`
+	MinConfirmations clnull.Uint32      `json:"minConfirmations"`
+	EVMChainID       *big.Big           `json:"evmChainID"`
+	CreatedAt        time.Time          `json:"createdAt"`
+	UpdatedAt        time.Time          `json:"updatedAt"`
+}
+
+// NewEVMLogSpec initializes a new EVMLogSpec from a job.EVMLogSpec
+func NewEVMLogSpec(spec *job.EVMLogSpec) *EVMLogSpec {
+	return &EVMLogSpec{
+		ContractAddress:  spec.ContractAddress,
+		EventABI:         spec.EventABI,
+		Topic2:           spec.Topic2.ToStrings(),
+		Topic3:           spec.Topic3.ToStrings(),
+		Topic4:           spec.Topic4.ToStrings(),
+		MinConfirmations: spec.MinConfirmations,
+		EVMChainID:       spec.EVMChainID,
+		CreatedAt:        spec.CreatedAt,
+		UpdatedAt:        spec.UpdatedAt,
+	}
+}
+
 // FluxMonitorSpec defines the spec details of a FluxMonitor Job
 type FluxMonitorSpec struct {
 	ContractAddress     types.EIP55Address `json:"contractAddress"`
@@ -238,31 +267,50 @@ func NewKeeperSpec(spec *job.KeeperSpec) *KeeperSpec {
 
 // WebhookSpec defines the spec details of a Webhook Job
 type WebhookSpec struct {
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	SignatureRequired  bool            `json:"signatureRequired"`
+	SignatureTolerance models.Interval `json:"signatureTolerance"`
+	RateLimitRPS       float64         `json:"rateLimitRPS"`
+	RateLimitBurst     uint32          `json:"rateLimitBurst"`
+	CreatedAt          time.Time       `json:"createdAt"`
+	UpdatedAt          time.Time       `json:"updatedAt"`
 }
 
-// NewWebhookSpec generates a new WebhookSpec from a job.WebhookSpec
+// NewWebhookSpec generates a new WebhookSpec from a job.WebhookSpec. The signature secret is
+// never exposed.
 func NewWebhookSpec(spec *job.WebhookSpec) *WebhookSpec {
 	return &WebhookSpec{
-		CreatedAt: spec.CreatedAt,
-		UpdatedAt: spec.UpdatedAt,
+		SignatureRequired:  spec.SignatureSecret != "",
+		SignatureTolerance: spec.SignatureTolerance,
+		RateLimitRPS:       spec.RateLimitRPS,
+		RateLimitBurst:     spec.RateLimitBurst,
+		CreatedAt:          spec.CreatedAt,
+		UpdatedAt:          spec.UpdatedAt,
 	}
 }
 
 // CronSpec defines the spec details of a Cron Job
 type CronSpec struct {
-	CronSchedule string    `json:"schedule" tom:"schedule"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	CronSchedule      string                `json:"schedule" tom:"schedule"`
+	Timezone          string                `json:"timezone"`
+	Jitter            models.Interval       `json:"jitter"`
+	CatchUp           job.CronCatchUpPolicy `json:"catchUp"`
+	MaxCatchUpRuns    uint32                `json:"maxCatchUpRuns"`
+	MaxConcurrentRuns uint32                `json:"maxConcurrentRuns"`
+	CreatedAt         time.Time             `json:"createdAt"`
+	UpdatedAt         time.Time             `json:"updatedAt"`
 }
 
 // NewCronSpec generates a new CronSpec from a job.CronSpec
 func NewCronSpec(spec *job.CronSpec) *CronSpec {
 	return &CronSpec{
-		CronSchedule: spec.CronSchedule,
-		CreatedAt:    spec.CreatedAt,
-		UpdatedAt:    spec.UpdatedAt,
+		CronSchedule:      spec.CronSchedule,
+		Timezone:          spec.Timezone,
+		Jitter:            spec.Jitter,
+		CatchUp:           spec.CatchUp,
+		MaxCatchUpRuns:    spec.MaxCatchUpRuns,
+		MaxConcurrentRuns: spec.MaxConcurrentRuns,
+		CreatedAt:         spec.CreatedAt,
+		UpdatedAt:         spec.UpdatedAt,
 	}
 }
 
@@ -499,6 +547,7 @@ type JobResource struct {
 	DirectRequestSpec        *DirectRequestSpec        `json:"directRequestSpec"`
 	FluxMonitorSpec          *FluxMonitorSpec          `json:"fluxMonitorSpec"`
 	CronSpec                 *CronSpec                 `json:"cronSpec"`
+	EVMLogSpec               *EVMLogSpec               `json:"evmLogSpec"`
 	OffChainReportingSpec    *OffChainReportingSpec    `json:"offChainReportingOracleSpec"`
 	OffChainReporting2Spec   *OffChainReporting2Spec   `json:"offChainReporting2OracleSpec"`
 	KeeperSpec               *KeeperSpec               `json:"keeperSpec"`
@@ -532,6 +581,8 @@ func NewJobResource(j job.Job) *JobResource {
 	switch j.Type {
 	case job.DirectRequest:
 		resource.DirectRequestSpec = NewDirectRequestSpec(j.DirectRequestSpec)
+	case job.EVMLog:
+		resource.EVMLogSpec = NewEVMLogSpec(j.EVMLogSpec)
 	case job.FluxMonitor:
 		resource.FluxMonitorSpec = NewFluxMonitorSpec(j.FluxMonitorSpec)
 	case job.Cron:
@@ -0,0 +1,67 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+// JobSpecVersionResource represents a version of a job spec JSONAPI resource.
+type JobSpecVersionResource struct {
+	JAID
+	JobID     int32     `json:"jobID"`
+	Version   int32     `json:"version"`
+	TOML      string    `json:"toml"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSpecVersionResource) GetName() string {
+	return "jobSpecVersions"
+}
+
+// NewJobSpecVersionResource constructs a new JobSpecVersionResource.
+func NewJobSpecVersionResource(sv job.SpecVersion) JobSpecVersionResource {
+	return JobSpecVersionResource{
+		JAID:      NewJAIDInt64(sv.ID),
+		JobID:     sv.JobID,
+		Version:   sv.Version,
+		TOML:      sv.TOML,
+		CreatedAt: sv.CreatedAt,
+	}
+}
+
+// NewJobSpecVersionResources constructs a slice of JobSpecVersionResource.
+func NewJobSpecVersionResources(svs []job.SpecVersion) []JobSpecVersionResource {
+	rs := []JobSpecVersionResource{}
+	for _, sv := range svs {
+		rs = append(rs, NewJobSpecVersionResource(sv))
+	}
+	return rs
+}
+
+// JobSpecDiffResource represents the differences between two versions of a job spec.
+type JobSpecDiffResource struct {
+	JAID
+	JobID       int32            `json:"jobID"`
+	FromVersion int32            `json:"fromVersion"`
+	ToVersion   int32            `json:"toVersion"`
+	Changes     []job.SpecChange `json:"changes"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSpecDiffResource) GetName() string {
+	return "jobSpecDiffs"
+}
+
+// NewJobSpecDiffResource constructs a new JobSpecDiffResource.
+func NewJobSpecDiffResource(jobID int32, fromVersion, toVersion int32, changes []job.SpecChange) JobSpecDiffResource {
+	return JobSpecDiffResource{
+		JAID:        NewJAID(fmt.Sprintf("%d-%d-%d", jobID, fromVersion, toVersion)),
+		JobID:       jobID,
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Changes:     changes,
+	}
+}
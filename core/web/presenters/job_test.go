@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/google/uuid"
 
 	"github.com/lib/pq"
@@ -123,6 +124,78 @@ func TestJob(t *testing.T) {
 						"keeperSpec": null,
                         "cronSpec": null,
                         "vrfSpec": null,
+						"webhookSpec": null,
+						"workflowSpec": null,
+						"blockhashStoreSpec": null,
+						"blockHeaderFeederSpec": null,
+						"bootstrapSpec": null,
+						"gatewaySpec": null,
+						"evmLogSpec": null,
+						"standardCapabilitiesSpec": null,
+						"errors": []
+					}
+				}
+			}`, contractAddress),
+		},
+		{
+			name: "evmlog spec",
+			job: job.Job{
+				ID: 1,
+				EVMLogSpec: &job.EVMLogSpec{
+					ContractAddress:  contractAddress,
+					EventABI:         "Transfer(address indexed from, address indexed to, uint256 value)",
+					Topic2:           models.HashCollection{common.BytesToHash(fromAddress.Bytes())},
+					MinConfirmations: clnull.Uint32From(3),
+					EVMChainID:       evmChainID,
+					CreatedAt:        timestamp,
+					UpdatedAt:        timestamp,
+				},
+				ExternalJobID: uuid.MustParse("0EEC7E1D-D0D2-476C-A1A8-72DFB6633F46"),
+				PipelineSpec: &pipeline.Spec{
+					ID:           1,
+					DotDagSource: `ds1 [type=memo value="$(jobRun.logData.value)"]`,
+				},
+				Type:            job.EVMLog,
+				SchemaVersion:   1,
+				Name:            null.StringFrom("test"),
+				MaxTaskDuration: models.Interval(1 * time.Minute),
+			},
+			want: fmt.Sprintf(`
+			{
+				"data":{
+					"type":"jobs",
+					"id":"1",
+					"attributes":{
+						"name": "test",
+						"schemaVersion": 1,
+						"type": "evmlog",
+						"maxTaskDuration": "1m0s",
+						"externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"pipelineSpec": {
+							"id": 1,
+							"dotDagSource": "ds1 [type=memo value=\"$(jobRun.logData.value)\"]",
+							"jobID": 0
+						},
+						"evmLogSpec": {
+							"contractAddress": "%s",
+							"eventABI": "Transfer(address indexed from, address indexed to, uint256 value)",
+							"topic2": ["0x000000000000000000000000a8037a20989afcbc51798de9762b351d63ff462e"],
+							"topic3": [],
+							"topic4": [],
+							"minConfirmations": 3,
+							"evmChainID": "42",
+							"createdAt":"2000-01-01T00:00:00Z",
+							"updatedAt":"2000-01-01T00:00:00Z"
+						},
+						"directRequestSpec": null,
+						"offChainReportingOracleSpec": null,
+						"offChainReporting2OracleSpec": null,
+						"fluxMonitorSpec": null,
+						"gasLimit": null,
+						"forwardingAllowed": false,
+						"keeperSpec": null,
+						"cronSpec": null,
+						"vrfSpec": null,
 						"webhookSpec": null,
 						"workflowSpec": null,
 						"blockhashStoreSpec": null,
@@ -207,6 +280,7 @@ func TestJob(t *testing.T) {
 						"blockHeaderFeederSpec": null,
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
+						"evmLogSpec": null,
 						"standardCapabilitiesSpec": null,
 						"errors": []
 					}
@@ -295,6 +369,7 @@ func TestJob(t *testing.T) {
 						"blockHeaderFeederSpec": null,
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
+						"evmLogSpec": null,
 						"standardCapabilitiesSpec": null,
 						"errors": []
 					}
@@ -360,6 +435,7 @@ func TestJob(t *testing.T) {
 						"blockHeaderFeederSpec": null,
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
+						"evmLogSpec": null,
 						"standardCapabilitiesSpec": null,
 						"errors": []
 					}
@@ -371,9 +447,12 @@ func TestJob(t *testing.T) {
 			job: job.Job{
 				ID: 1,
 				CronSpec: &job.CronSpec{
-					CronSchedule: cronSchedule,
-					CreatedAt:    timestamp,
-					UpdatedAt:    timestamp,
+					CronSchedule:      cronSchedule,
+					Jitter:            models.Interval(10 * time.Second),
+					CatchUp:           job.CronCatchUpOnce,
+					MaxConcurrentRuns: 2,
+					CreatedAt:         timestamp,
+					UpdatedAt:         timestamp,
 				},
 				ExternalJobID: uuid.MustParse("0EEC7E1D-D0D2-476C-A1A8-72DFB6633F46"),
 				PipelineSpec: &pipeline.Spec{
@@ -403,6 +482,11 @@ func TestJob(t *testing.T) {
                         },
                         "cronSpec": {
                             "schedule": "%s",
+                            "timezone": "",
+                            "jitter": "10s",
+                            "catchUp": "once",
+                            "maxCatchUpRuns": 0,
+                            "maxConcurrentRuns": 2,
                             "createdAt":"2000-01-01T00:00:00Z",
                             "updatedAt":"2000-01-01T00:00:00Z"
                         },
@@ -420,6 +504,7 @@ func TestJob(t *testing.T) {
 						"blockHeaderFeederSpec": null,
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
+						"evmLogSpec": null,
 						"standardCapabilitiesSpec": null,
                         "errors": []
                     }
@@ -431,8 +516,12 @@ func TestJob(t *testing.T) {
 			job: job.Job{
 				ID: 1,
 				WebhookSpec: &job.WebhookSpec{
-					CreatedAt: timestamp,
-					UpdatedAt: timestamp,
+					SignatureSecret:    "partner-secret",
+					SignatureTolerance: models.Interval(5 * time.Minute),
+					RateLimitRPS:       0.5,
+					RateLimitBurst:     2,
+					CreatedAt:          timestamp,
+					UpdatedAt:          timestamp,
 				},
 				ExternalJobID: uuid.MustParse("0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"),
 				PipelineSpec: &pipeline.Spec{
@@ -461,6 +550,10 @@ func TestJob(t *testing.T) {
 							"jobID": 0
 						},
 						"webhookSpec": {
+							"signatureRequired": true,
+							"signatureTolerance": "5m0s",
+							"rateLimitRPS": 0.5,
+							"rateLimitBurst": 2,
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z"
 						},
@@ -478,6 +571,7 @@ func TestJob(t *testing.T) {
 						"blockHeaderFeederSpec": null,
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
+						"evmLogSpec": null,
 						"standardCapabilitiesSpec": null,
 						"errors": []
 					}
@@ -564,6 +658,7 @@ func TestJob(t *testing.T) {
 							"dotDagSource": ""
 						},
 						"gatewaySpec": null,
+						"evmLogSpec": null,
 						"standardCapabilitiesSpec": null,	
 						"errors": []
 					}
@@ -646,6 +741,7 @@ func TestJob(t *testing.T) {
 							"dotDagSource": ""
 						},
 						"gatewaySpec": null,
+						"evmLogSpec": null,
 						"standardCapabilitiesSpec": null,
 						"errors": []
 					}
@@ -728,6 +824,7 @@ func TestJob(t *testing.T) {
 							"dotDagSource": ""
 						},
 						"gatewaySpec": null,
+						"evmLogSpec": null,
 						"standardCapabilitiesSpec": null,
 						"errors": []
 					}
@@ -794,6 +891,7 @@ func TestJob(t *testing.T) {
 							"dotDagSource": ""
 						},
 						"gatewaySpec": null,
+						"evmLogSpec": null,
 						"standardCapabilitiesSpec": null,
 						"errors": []
 					}
@@ -852,6 +950,7 @@ func TestJob(t *testing.T) {
 							"createdAt":"0001-01-01T00:00:00Z",
 							"updatedAt":"0001-01-01T00:00:00Z"
 						},
+						"evmLogSpec": null,
 						"standardCapabilitiesSpec": null,
 						"pipelineSpec": {
 							"id": 1,
@@ -916,6 +1015,7 @@ func TestJob(t *testing.T) {
 						"blockHeaderFeederSpec": null,
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
+						"evmLogSpec": null,
 						"standardCapabilitiesSpec": null,
 						"pipelineSpec": {
 							"id": 1,
@@ -971,6 +1071,7 @@ func TestJob(t *testing.T) {
 						"blockHeaderFeederSpec": null,
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
+						"evmLogSpec": null,
 						"standardCapabilitiesSpec": {
 							"command":"testcommand",
 							"config":"testconfig",
@@ -1055,6 +1156,7 @@ func TestJob(t *testing.T) {
 						"blockHeaderFeederSpec": null,
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
+						"evmLogSpec": null,
 						"standardCapabilitiesSpec": null,
 						"errors": [{
 							"id": 200,
@@ -0,0 +1,93 @@
+package presenters
+
+import (
+	"sort"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// WorkflowExecutionResource represents a workflow execution JSONAPI resource.
+type WorkflowExecutionResource struct {
+	JAID
+	WorkflowID string                          `json:"workflowID"`
+	Status     string                          `json:"status"`
+	Steps      []WorkflowExecutionStepResource `json:"steps"`
+	CreatedAt  *time.Time                      `json:"createdAt"`
+	UpdatedAt  *time.Time                      `json:"updatedAt"`
+	FinishedAt *time.Time                      `json:"finishedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WorkflowExecutionResource) GetName() string {
+	return "workflowExecutions"
+}
+
+// NewWorkflowExecutionResource constructs a new WorkflowExecutionResource.
+// Steps are sorted by ref.
+func NewWorkflowExecutionResource(ex store.WorkflowExecution) WorkflowExecutionResource {
+	steps := []WorkflowExecutionStepResource{}
+	for _, s := range ex.Steps {
+		steps = append(steps, NewWorkflowExecutionStepResource(*s))
+	}
+	sort.Slice(steps, func(i, j int) bool { return steps[i].Ref < steps[j].Ref })
+
+	return WorkflowExecutionResource{
+		JAID:       NewJAID(ex.ExecutionID),
+		WorkflowID: ex.WorkflowID,
+		Status:     ex.Status,
+		Steps:      steps,
+		CreatedAt:  ex.CreatedAt,
+		UpdatedAt:  ex.UpdatedAt,
+		FinishedAt: ex.FinishedAt,
+	}
+}
+
+// NewWorkflowExecutionResources constructs a slice of WorkflowExecutionResource.
+func NewWorkflowExecutionResources(exs []store.WorkflowExecution) []WorkflowExecutionResource {
+	rs := []WorkflowExecutionResource{}
+	for _, ex := range exs {
+		rs = append(rs, NewWorkflowExecutionResource(ex))
+	}
+	return rs
+}
+
+// WorkflowExecutionStepResource represents a single step of a workflow execution.
+type WorkflowExecutionStepResource struct {
+	Ref       string     `json:"ref"`
+	Status    string     `json:"status"`
+	Inputs    any        `json:"inputs"`
+	Outputs   any        `json:"outputs"`
+	Error     *string    `json:"error"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+// NewWorkflowExecutionStepResource constructs a new WorkflowExecutionStepResource,
+// unwrapping the step's inputs and outputs into plain JSON-serializable values.
+func NewWorkflowExecutionStepResource(s store.WorkflowExecutionStep) WorkflowExecutionStepResource {
+	r := WorkflowExecutionStepResource{
+		Ref:       s.Ref,
+		Status:    s.Status,
+		UpdatedAt: s.UpdatedAt,
+	}
+	if s.Inputs != nil {
+		r.Inputs = unwrapValue(s.Inputs)
+	}
+	if s.Outputs.Value != nil {
+		r.Outputs = unwrapValue(s.Outputs.Value)
+	}
+	if s.Outputs.Err != nil {
+		errMsg := s.Outputs.Err.Error()
+		r.Error = &errMsg
+	}
+	return r
+}
+
+func unwrapValue(v values.Value) any {
+	unwrapped, err := v.Unwrap()
+	if err != nil {
+		return nil
+	}
+	return unwrapped
+}
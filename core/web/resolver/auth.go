@@ -9,19 +9,28 @@ import (
 )
 
 // Authenticates the user from the session cookie, presence of user inherently provides 'view' access.
-func authenticateUser(ctx context.Context) error {
-	if _, ok := auth.GetGQLAuthenticatedSession(ctx); !ok {
+// Requests authenticated by an API token require the given permission instead.
+func authenticateUser(ctx context.Context, perm sessions.Permission) error {
+	session, ok := auth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
 		return unauthorizedError{}
 	}
+	if session.APIToken != nil {
+		return authorizeAPIToken(session.APIToken, perm)
+	}
 	return nil
 }
 
 // Authenticates the user from the session cookie and asserts at least 'run' role.
-func authenticateUserCanRun(ctx context.Context) error {
+// Requests authenticated by an API token require the given permission instead.
+func authenticateUserCanRun(ctx context.Context, perm sessions.Permission) error {
 	session, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
 		return unauthorizedError{}
 	}
+	if session.APIToken != nil {
+		return authorizeAPIToken(session.APIToken, perm)
+	}
 	if session.User.Role == sessions.UserRoleView {
 		return RoleNotPermittedErr{session.User.Role}
 	}
@@ -29,11 +38,15 @@ func authenticateUserCanRun(ctx context.Context) error {
 }
 
 // Authenticates the user from the session cookie and asserts at least 'edit' role.
-func authenticateUserCanEdit(ctx context.Context) error {
+// Requests authenticated by an API token require the given permission instead.
+func authenticateUserCanEdit(ctx context.Context, perm sessions.Permission) error {
 	session, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
 		return unauthorizedError{}
 	}
+	if session.APIToken != nil {
+		return authorizeAPIToken(session.APIToken, perm)
+	}
 	switch session.User.Role {
 	case sessions.UserRoleView, sessions.UserRoleRun:
 		return RoleNotPermittedErr{session.User.Role}
@@ -43,17 +56,41 @@ func authenticateUserCanEdit(ctx context.Context) error {
 }
 
 // Authenticates the user from the session cookie and asserts has 'admin' role
-func authenticateUserIsAdmin(ctx context.Context) error {
+// Requests authenticated by an API token require the given permission instead.
+func authenticateUserIsAdmin(ctx context.Context, perm sessions.Permission) error {
 	session, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
 		return unauthorizedError{}
 	}
+	if session.APIToken != nil {
+		return authorizeAPIToken(session.APIToken, perm)
+	}
 	if session.User.Role != sessions.UserRoleAdmin {
 		return RoleNotPermittedErr{session.User.Role}
 	}
 	return nil
 }
 
+// Authenticates the user from the session cookie, rejecting requests authenticated by an API token.
+// This is used for managing the credentials of the user.
+func authenticateSessionUser(ctx context.Context) error {
+	session, ok := auth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return unauthorizedError{}
+	}
+	if session.APIToken != nil {
+		return PermissionNotGrantedErr{}
+	}
+	return nil
+}
+
+func authorizeAPIToken(apiToken *sessions.APIToken, perm sessions.Permission) error {
+	if !apiToken.Permissions.Has(perm) {
+		return PermissionNotGrantedErr{perm}
+	}
+	return nil
+}
+
 type unauthorizedError struct{}
 
 func (e unauthorizedError) Error() string {
@@ -73,3 +110,16 @@ type RoleNotPermittedErr struct {
 func (e RoleNotPermittedErr) Error() string {
 	return fmt.Sprintf("Not permitted with current role: %s", e.Role)
 }
+
+// PermissionNotGrantedErr is returned when the API token a request was authenticated by lacks
+// the permission required, or when the operation can't be performed with an API token at all.
+type PermissionNotGrantedErr struct {
+	Permission sessions.Permission
+}
+
+func (e PermissionNotGrantedErr) Error() string {
+	if e.Permission == "" {
+		return "Not permitted with an API token"
+	}
+	return fmt.Sprintf("Not permitted without the %s permission", e.Permission)
+}
@@ -0,0 +1,48 @@
+package resolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
+)
+
+func TestAuthenticate_APIToken(t *testing.T) {
+	t.Parallel()
+
+	apiToken := &clsessions.APIToken{
+		Name:        "ci",
+		Permissions: clsessions.Permissions{clsessions.PermissionJobsRead, clsessions.PermissionJobsRun},
+	}
+	ctx := auth.WithGQLAuthenticatedAPIToken(testutils.Context(t), apiToken)
+
+	// The permissions of the token apply instead of the role of its user.
+	require.NoError(t, authenticateUser(ctx, clsessions.PermissionJobsRead))
+	require.NoError(t, authenticateUserCanRun(ctx, clsessions.PermissionJobsRun))
+
+	err := authenticateUserCanEdit(ctx, clsessions.PermissionJobsWrite)
+	assert.Equal(t, PermissionNotGrantedErr{clsessions.PermissionJobsWrite}, err)
+	assert.EqualError(t, err, "Not permitted without the jobs:write permission")
+
+	err = authenticateUserIsAdmin(ctx, clsessions.PermissionKeysExport)
+	assert.Equal(t, PermissionNotGrantedErr{clsessions.PermissionKeysExport}, err)
+
+	err = authenticateSessionUser(ctx)
+	assert.EqualError(t, err, "Not permitted with an API token")
+}
+
+func TestAuthenticate_SessionUser(t *testing.T) {
+	t.Parallel()
+
+	user := clsessions.User{Email: "view@chain.link", Role: clsessions.UserRoleView}
+	ctx := auth.WithGQLAuthenticatedSession(testutils.Context(t), user, "sessionID")
+
+	require.NoError(t, authenticateUser(ctx, clsessions.PermissionJobsRead))
+	require.NoError(t, authenticateSessionUser(ctx))
+	assert.Equal(t, RoleNotPermittedErr{clsessions.UserRoleView}, authenticateUserCanRun(ctx, clsessions.PermissionJobsRun))
+	assert.Equal(t, RoleNotPermittedErr{clsessions.UserRoleView}, authenticateUserIsAdmin(ctx, clsessions.PermissionKeysWrite))
+}
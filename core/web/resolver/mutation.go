@@ -22,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
 	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/evmlog"
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
@@ -40,6 +41,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
@@ -60,7 +62,7 @@ type createBridgeInput struct {
 
 // CreateBridge creates a new bridge.
 func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBridgeInput }) (*CreateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionBridgesWrite); err != nil {
 		return nil, err
 	}
 
@@ -110,7 +112,7 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 }
 
 func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionKeysWrite); err != nil {
 		return nil, err
 	}
 
@@ -134,7 +136,7 @@ func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolv
 func (r *Resolver) DeleteCSAKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteCSAKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.PermissionKeysWrite); err != nil {
 		return nil, err
 	}
 
@@ -177,7 +179,7 @@ type createFeedsManagerChainConfigInput struct {
 func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struct {
 	Input *createFeedsManagerChainConfigInput
 }) (*CreateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionFeedsWrite); err != nil {
 		return nil, err
 	}
 
@@ -262,7 +264,7 @@ func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struc
 func (r *Resolver) DeleteFeedsManagerChainConfig(ctx context.Context, args struct {
 	ID string
 }) (*DeleteFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionFeedsWrite); err != nil {
 		return nil, err
 	}
 
@@ -318,7 +320,7 @@ func (r *Resolver) UpdateFeedsManagerChainConfig(ctx context.Context, args struc
 	ID    string
 	Input *updateFeedsManagerChainConfigInput
 }) (*UpdateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionFeedsWrite); err != nil {
 		return nil, err
 	}
 
@@ -402,7 +404,7 @@ type createFeedsManagerInput struct {
 func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 	Input *createFeedsManagerInput
 }) (*CreateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionFeedsWrite); err != nil {
 		return nil, err
 	}
 
@@ -455,7 +457,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input updateBridgeInput
 }) (*UpdateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionBridgesWrite); err != nil {
 		return nil, err
 	}
 
@@ -523,7 +525,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input *updateFeedsManagerInput
 }) (*UpdateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionFeedsWrite); err != nil {
 		return nil, err
 	}
 
@@ -568,7 +570,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionKeysWrite); err != nil {
 		return nil, err
 	}
 
@@ -588,7 +590,7 @@ func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundleP
 func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 	ID string
 }) (*DeleteOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.PermissionKeysWrite); err != nil {
 		return nil, err
 	}
 
@@ -607,7 +609,7 @@ func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionBridgesWrite); err != nil {
 		return nil, err
 	}
 
@@ -643,7 +645,7 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionKeysWrite); err != nil {
 		return nil, err
 	}
 
@@ -667,7 +669,7 @@ func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolv
 func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteP2PKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.PermissionKeysWrite); err != nil {
 		return nil, err
 	}
 
@@ -693,7 +695,7 @@ func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionKeysWrite); err != nil {
 		return nil, err
 	}
 
@@ -715,7 +717,7 @@ func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolv
 func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteVRFKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.PermissionKeysWrite); err != nil {
 		return nil, err
 	}
 
@@ -740,7 +742,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 	ID    graphql.ID
 	Force *bool
 }) (*ApproveJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionFeedsWrite); err != nil {
 		return nil, err
 	}
 
@@ -779,7 +781,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*CancelJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionFeedsWrite); err != nil {
 		return nil, err
 	}
 
@@ -814,7 +816,7 @@ func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RejectJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionFeedsWrite); err != nil {
 		return nil, err
 	}
 
@@ -850,7 +852,7 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 	ID    graphql.ID
 	Input *struct{ Definition string }
 }) (*UpdateJobProposalSpecDefinitionPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionFeedsWrite); err != nil {
 		return nil, err
 	}
 
@@ -886,7 +888,7 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 	Input UpdatePasswordInput
 }) (*UpdatePasswordPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateSessionUser(ctx); err != nil {
 		return nil, err
 	}
 
@@ -924,7 +926,7 @@ func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 func (r *Resolver) SetSQLLogging(ctx context.Context, args struct {
 	Input struct{ Enabled bool }
 }) (*SetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.PermissionConfigWrite); err != nil {
 		return nil, err
 	}
 
@@ -942,7 +944,7 @@ func (r *Resolver) SetSQLLogging(ctx context.Context, args struct {
 func (r *Resolver) CreateAPIToken(ctx context.Context, args struct {
 	Input struct{ Password string }
 }) (*CreateAPITokenPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateSessionUser(ctx); err != nil {
 		return nil, err
 	}
 
@@ -976,7 +978,7 @@ func (r *Resolver) CreateAPIToken(ctx context.Context, args struct {
 func (r *Resolver) DeleteAPIToken(ctx context.Context, args struct {
 	Input struct{ Password string }
 }) (*DeleteAPITokenPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateSessionUser(ctx); err != nil {
 		return nil, err
 	}
 
@@ -1015,7 +1017,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		TOML string
 	}
 }) (*CreateJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionJobsWrite); err != nil {
 		return nil, err
 	}
 
@@ -1041,6 +1043,8 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		}
 	case job.DirectRequest:
 		jb, err = directrequest.ValidatedDirectRequestSpec(args.Input.TOML)
+	case job.EVMLog:
+		jb, err = evmlog.ValidatedEVMLogSpec(args.Input.TOML)
 	case job.FluxMonitor:
 		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config.JobPipeline(), args.Input.TOML)
 	case job.Keeper:
@@ -1071,6 +1075,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 	if err != nil {
 		return nil, err
 	}
+	jb.SpecTOML = args.Input.TOML
 
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -1089,7 +1094,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionJobsWrite); err != nil {
 		return nil, err
 	}
 
@@ -1123,7 +1128,7 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionJobsWrite); err != nil {
 		return nil, err
 	}
 
@@ -1157,7 +1162,7 @@ func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 func (r *Resolver) RunJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RunJobPayloadResolver, error) {
-	if err := authenticateUserCanRun(ctx); err != nil {
+	if err := authenticateUserCanRun(ctx, sessions.PermissionJobsRun); err != nil {
 		return nil, err
 	}
 
@@ -1187,7 +1192,7 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	Level LogLevel
 }) (*SetGlobalLogLevelPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.PermissionConfigWrite); err != nil {
 		return nil, err
 	}
 
@@ -1213,7 +1218,7 @@ func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 	ChainType OCR2ChainType
 }) (*CreateOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.PermissionKeysWrite); err != nil {
 		return nil, err
 	}
 
@@ -1241,7 +1246,7 @@ func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.PermissionKeysWrite); err != nil {
 		return nil, err
 	}
 
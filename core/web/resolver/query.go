@@ -16,12 +16,13 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 )
 
 // Bridge retrieves a bridges by name.
 func (r *Resolver) Bridge(ctx context.Context, args struct{ ID graphql.ID }) (*BridgePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionBridgesRead); err != nil {
 		return nil, err
 	}
 
@@ -47,7 +48,7 @@ func (r *Resolver) Bridges(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*BridgesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionBridgesRead); err != nil {
 		return nil, err
 	}
 
@@ -64,7 +65,7 @@ func (r *Resolver) Bridges(ctx context.Context, args struct {
 
 // Chain retrieves a chain by id.
 func (r *Resolver) Chain(ctx context.Context, args struct{ ID graphql.ID }) (*ChainPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionChainsRead); err != nil {
 		return nil, err
 	}
 
@@ -87,7 +88,7 @@ func (r *Resolver) Chains(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*ChainsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionChainsRead); err != nil {
 		return nil, err
 	}
 
@@ -123,7 +124,7 @@ func (r *Resolver) Chains(ctx context.Context, args struct {
 
 // FeedsManager retrieves a feeds manager by id.
 func (r *Resolver) FeedsManager(ctx context.Context, args struct{ ID graphql.ID }) (*FeedsManagerPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionFeedsRead); err != nil {
 		return nil, err
 	}
 
@@ -145,7 +146,7 @@ func (r *Resolver) FeedsManager(ctx context.Context, args struct{ ID graphql.ID
 }
 
 func (r *Resolver) FeedsManagers(ctx context.Context) (*FeedsManagersPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionFeedsRead); err != nil {
 		return nil, err
 	}
 
@@ -159,7 +160,7 @@ func (r *Resolver) FeedsManagers(ctx context.Context) (*FeedsManagersPayloadReso
 
 // Job retrieves a job by id.
 func (r *Resolver) Job(ctx context.Context, args struct{ ID graphql.ID }) (*JobPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionJobsRead); err != nil {
 		return nil, err
 	}
 
@@ -190,7 +191,7 @@ func (r *Resolver) Jobs(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*JobsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionJobsRead); err != nil {
 		return nil, err
 	}
 
@@ -206,7 +207,7 @@ func (r *Resolver) Jobs(ctx context.Context, args struct {
 }
 
 func (r *Resolver) OCRKeyBundles(ctx context.Context) (*OCRKeyBundlesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionKeysRead); err != nil {
 		return nil, err
 	}
 
@@ -219,7 +220,7 @@ func (r *Resolver) OCRKeyBundles(ctx context.Context) (*OCRKeyBundlesPayloadReso
 }
 
 func (r *Resolver) CSAKeys(ctx context.Context) (*CSAKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionKeysRead); err != nil {
 		return nil, err
 	}
 
@@ -233,7 +234,7 @@ func (r *Resolver) CSAKeys(ctx context.Context) (*CSAKeysPayloadResolver, error)
 
 // Features retrieves each featured enabled by boolean mapping
 func (r *Resolver) Features(ctx context.Context) (*FeaturesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionConfigRead); err != nil {
 		return nil, err
 	}
 
@@ -242,7 +243,7 @@ func (r *Resolver) Features(ctx context.Context) (*FeaturesPayloadResolver, erro
 
 // Node retrieves a node by ID (Name)
 func (r *Resolver) Node(ctx context.Context, args struct{ ID graphql.ID }) (*NodePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionChainsRead); err != nil {
 		return nil, err
 	}
 	r.App.GetLogger().Debug("resolver Node args %v", args)
@@ -270,7 +271,7 @@ func (r *Resolver) Node(ctx context.Context, args struct{ ID graphql.ID }) (*Nod
 }
 
 func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionKeysRead); err != nil {
 		return nil, err
 	}
 
@@ -284,7 +285,7 @@ func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error)
 
 // VRFKeys fetches all VRF keys.
 func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionKeysRead); err != nil {
 		return nil, err
 	}
 
@@ -300,7 +301,7 @@ func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error)
 func (r *Resolver) VRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*VRFKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionKeysRead); err != nil {
 		return nil, err
 	}
 
@@ -319,7 +320,7 @@ func (r *Resolver) VRFKey(ctx context.Context, args struct {
 func (r *Resolver) JobProposal(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*JobProposalPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionFeedsRead); err != nil {
 		return nil, err
 	}
 
@@ -345,7 +346,7 @@ func (r *Resolver) Nodes(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*NodesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionChainsRead); err != nil {
 		return nil, err
 	}
 
@@ -370,7 +371,7 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*JobRunsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionJobsRead); err != nil {
 		return nil, err
 	}
 
@@ -388,7 +389,7 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 func (r *Resolver) JobRun(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*JobRunPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionJobsRead); err != nil {
 		return nil, err
 	}
 
@@ -410,7 +411,7 @@ func (r *Resolver) JobRun(ctx context.Context, args struct {
 }
 
 func (r *Resolver) ETHKeys(ctx context.Context) (*ETHKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionKeysRead); err != nil {
 		return nil, err
 	}
 
@@ -463,7 +464,7 @@ func (r *Resolver) ETHKeys(ctx context.Context) (*ETHKeysPayloadResolver, error)
 
 // ConfigV2 retrieves the Chainlink node's configuration (V2 mode)
 func (r *Resolver) ConfigV2(ctx context.Context) (*ConfigV2PayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionConfigRead); err != nil {
 		return nil, err
 	}
 
@@ -474,7 +475,7 @@ func (r *Resolver) ConfigV2(ctx context.Context) (*ConfigV2PayloadResolver, erro
 func (r *Resolver) EthTransaction(ctx context.Context, args struct {
 	Hash graphql.ID
 }) (*EthTransactionPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionTxsRead); err != nil {
 		return nil, err
 	}
 
@@ -495,7 +496,7 @@ func (r *Resolver) EthTransactions(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*EthTransactionsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionTxsRead); err != nil {
 		return nil, err
 	}
 
@@ -514,7 +515,7 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*EthTransactionsAttemptsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionTxsRead); err != nil {
 		return nil, err
 	}
 
@@ -530,7 +531,7 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 }
 
 func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionConfigRead); err != nil {
 		return nil, err
 	}
 
@@ -540,7 +541,7 @@ func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadRe
 }
 
 func (r *Resolver) SolanaKeys(ctx context.Context) (*SolanaKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionKeysRead); err != nil {
 		return nil, err
 	}
 
@@ -553,7 +554,7 @@ func (r *Resolver) SolanaKeys(ctx context.Context) (*SolanaKeysPayloadResolver,
 }
 
 func (r *Resolver) SQLLogging(ctx context.Context) (*GetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionConfigRead); err != nil {
 		return nil, err
 	}
 
@@ -564,7 +565,7 @@ func (r *Resolver) SQLLogging(ctx context.Context) (*GetSQLLoggingPayloadResolve
 
 // OCR2KeyBundles resolves the list of OCR2 key bundles
 func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.PermissionKeysRead); err != nil {
 		return nil, err
 	}
 
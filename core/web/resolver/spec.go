@@ -32,6 +32,14 @@ func (r *SpecResolver) ToDirectRequestSpec() (*DirectRequestSpecResolver, bool)
 	return &DirectRequestSpecResolver{spec: *r.j.DirectRequestSpec}, true
 }
 
+func (r *SpecResolver) ToEVMLogSpec() (*EVMLogSpecResolver, bool) {
+	if r.j.Type != job.EVMLog {
+		return nil, false
+	}
+
+	return &EVMLogSpecResolver{spec: *r.j.EVMLogSpec}, true
+}
+
 func (r *SpecResolver) ToFluxMonitorSpec() (*FluxMonitorSpecResolver, bool) {
 	if r.j.Type != job.FluxMonitor {
 		return nil, false
@@ -141,6 +149,31 @@ func (r *CronSpecResolver) Schedule() string {
 	return r.spec.CronSchedule
 }
 
+// Timezone resolves the spec's timezone.
+func (r *CronSpecResolver) Timezone() string {
+	return r.spec.Timezone
+}
+
+// Jitter resolves the spec's jitter.
+func (r *CronSpecResolver) Jitter() string {
+	return r.spec.Jitter.Duration().String()
+}
+
+// CatchUp resolves the spec's catch up policy.
+func (r *CronSpecResolver) CatchUp() string {
+	return string(r.spec.CatchUp)
+}
+
+// MaxCatchUpRuns resolves the spec's max catch up runs.
+func (r *CronSpecResolver) MaxCatchUpRuns() int32 {
+	return int32(r.spec.MaxCatchUpRuns)
+}
+
+// MaxConcurrentRuns resolves the spec's max concurrent runs.
+func (r *CronSpecResolver) MaxConcurrentRuns() int32 {
+	return int32(r.spec.MaxConcurrentRuns)
+}
+
 // CreatedAt resolves the spec's created at timestamp.
 func (r *CronSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
@@ -196,6 +229,63 @@ func (r *DirectRequestSpecResolver) Requesters() *[]string {
 	return &requesters
 }
 
+type EVMLogSpecResolver struct {
+	spec job.EVMLogSpec
+}
+
+// ContractAddress resolves the spec's contract address.
+func (r *EVMLogSpecResolver) ContractAddress() string {
+	return r.spec.ContractAddress.String()
+}
+
+// CreatedAt resolves the spec's created at timestamp.
+func (r *EVMLogSpecResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.spec.CreatedAt}
+}
+
+// EventABI resolves the spec's event ABI.
+func (r *EVMLogSpecResolver) EventABI() string {
+	return r.spec.EventABI
+}
+
+// EVMChainID resolves the spec's evm chain id.
+func (r *EVMLogSpecResolver) EVMChainID() *string {
+	if r.spec.EVMChainID == nil {
+		return nil
+	}
+
+	chainID := r.spec.EVMChainID.String()
+
+	return &chainID
+}
+
+// MinConfirmations resolves the spec's min confirmations, which is null when only finalized
+// logs are processed.
+func (r *EVMLogSpecResolver) MinConfirmations() *int32 {
+	if !r.spec.MinConfirmations.Valid {
+		return nil
+	}
+
+	confs := int32(r.spec.MinConfirmations.Uint32)
+
+	return &confs
+}
+
+// Topic2 resolves the spec's filter on the first indexed argument of the event.
+func (r *EVMLogSpecResolver) Topic2() []string {
+	return r.spec.Topic2.ToStrings()
+}
+
+// Topic3 resolves the spec's filter on the second indexed argument of the event.
+func (r *EVMLogSpecResolver) Topic3() []string {
+	return r.spec.Topic3.ToStrings()
+}
+
+// Topic4 resolves the spec's filter on the third indexed argument of the event.
+func (r *EVMLogSpecResolver) Topic4() []string {
+	return r.spec.Topic4.ToStrings()
+}
+
 type FluxMonitorSpecResolver struct {
 	spec job.FluxMonitorSpec
 }
@@ -699,6 +789,26 @@ type WebhookSpecResolver struct {
 	spec job.WebhookSpec
 }
 
+// SignatureRequired resolves whether requests from external initiators must be signed.
+func (r *WebhookSpecResolver) SignatureRequired() bool {
+	return r.spec.SignatureSecret != ""
+}
+
+// SignatureTolerance resolves the spec's signature tolerance.
+func (r *WebhookSpecResolver) SignatureTolerance() string {
+	return r.spec.SignatureTolerance.Duration().String()
+}
+
+// RateLimitRPS resolves the spec's rate limit in runs per second.
+func (r *WebhookSpecResolver) RateLimitRPS() float64 {
+	return r.spec.RateLimitRPS
+}
+
+// RateLimitBurst resolves the spec's rate limit burst.
+func (r *WebhookSpecResolver) RateLimitBurst() int32 {
+	return int32(r.spec.RateLimitBurst)
+}
+
 // CreatedAt resolves the spec's created at timestamp.
 func (r *WebhookSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
@@ -40,8 +40,13 @@ func TestResolver_CronSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{
 					Type: job.Cron,
 					CronSpec: &job.CronSpec{
-						CronSchedule: "CRON_TZ=UTC 0 0 1 1 *",
-						CreatedAt:    f.Timestamp(),
+						CronSchedule:      "0 0 1 1 *",
+						Timezone:          "Europe/London",
+						Jitter:            models.Interval(30 * time.Second),
+						CatchUp:           job.CronCatchUpAll,
+						MaxCatchUpRuns:    3,
+						MaxConcurrentRuns: 1,
+						CreatedAt:         f.Timestamp(),
 					},
 				}, nil)
 			},
@@ -53,6 +58,11 @@ func TestResolver_CronSpec(t *testing.T) {
 								__typename
 								... on CronSpec {
 									schedule
+									timezone
+									jitter
+									catchUp
+									maxCatchUpRuns
+									maxConcurrentRuns
 									createdAt
 								}
 							}
@@ -65,7 +75,12 @@ func TestResolver_CronSpec(t *testing.T) {
 					"job": {
 						"spec": {
 							"__typename": "CronSpec",
-							"schedule": "CRON_TZ=UTC 0 0 1 1 *",
+							"schedule": "0 0 1 1 *",
+							"timezone": "Europe/London",
+							"jitter": "30s",
+							"catchUp": "all",
+							"maxCatchUpRuns": 3,
+							"maxConcurrentRuns": 1,
 							"createdAt": "2021-01-01T00:00:00Z"
 						}
 					}
@@ -143,6 +158,75 @@ func TestResolver_DirectRequestSpec(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func TestResolver_EVMLogSpec(t *testing.T) {
+	var (
+		id    = int32(1)
+		topic = common.HexToHash("0x0000000000000000000000003cCad4715152693fE3BC4460591e3D3Fbd071b42")
+	)
+	contractAddress, err := evmtypes.NewEIP55Address("0x613a38AC1659769640aaE063C651F48E0250454C")
+	require.NoError(t, err)
+
+	testCases := []GQLTestCase{
+		{
+			name:          "evmlog spec success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{
+					Type: job.EVMLog,
+					EVMLogSpec: &job.EVMLogSpec{
+						ContractAddress: contractAddress,
+						CreatedAt:       f.Timestamp(),
+						EventABI:        "Transfer(address indexed from, address indexed to, uint256 value)",
+						EVMChainID:      ubig.NewI(42),
+						Topic3:          models.HashCollection{topic},
+					},
+				}, nil)
+			},
+			query: `
+				query GetJob {
+					job(id: "1") {
+						... on Job {
+							spec {
+								__typename
+								... on EVMLogSpec {
+									contractAddress
+									createdAt
+									eventABI
+									evmChainID
+									minConfirmations
+									topic2
+									topic3
+									topic4
+								}
+							}
+						}
+					}
+				}
+			`,
+			result: `
+				{
+					"job": {
+						"spec": {
+							"__typename": "EVMLogSpec",
+							"contractAddress": "0x613a38AC1659769640aaE063C651F48E0250454C",
+							"createdAt": "2021-01-01T00:00:00Z",
+							"eventABI": "Transfer(address indexed from, address indexed to, uint256 value)",
+							"evmChainID": "42",
+							"minConfirmations": null,
+							"topic2": [],
+							"topic3": ["0x0000000000000000000000003ccad4715152693fe3bc4460591e3d3fbd071b42"],
+							"topic4": []
+						}
+					}
+				}
+			`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func TestResolver_FluxMonitorSpec(t *testing.T) {
 	var (
 		id = int32(1)
@@ -676,7 +760,11 @@ func TestResolver_WebhookSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{
 					Type: job.Webhook,
 					WebhookSpec: &job.WebhookSpec{
-						CreatedAt: f.Timestamp(),
+						SignatureSecret:    "partner-secret",
+						SignatureTolerance: models.Interval(5 * time.Minute),
+						RateLimitRPS:       0.5,
+						RateLimitBurst:     2,
+						CreatedAt:          f.Timestamp(),
 					},
 				}, nil)
 			},
@@ -687,6 +775,10 @@ func TestResolver_WebhookSpec(t *testing.T) {
 							spec {
 								__typename
 								... on WebhookSpec {
+									signatureRequired
+									signatureTolerance
+									rateLimitRPS
+									rateLimitBurst
 									createdAt
 								}
 							}
@@ -699,6 +791,10 @@ func TestResolver_WebhookSpec(t *testing.T) {
 					"job": {
 						"spec": {
 							"__typename": "WebhookSpec",
+							"signatureRequired": true,
+							"signatureTolerance": "5m0s",
+							"rateLimitRPS": 0.5,
+							"rateLimitBurst": 2,
 							"createdAt": "2021-01-01T00:00:00Z"
 						}
 					}
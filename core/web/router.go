@@ -94,7 +94,7 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 	guiAssetRoutes(engine, config.Insecure().DisableRateLimiting(), app.GetLogger())
 
 	api.POST("/query",
-		auth.AuthenticateGQL(app.AuthenticationProvider(), app.GetLogger().Named("GQLHandler")),
+		auth.AuthenticateGQL(app.AuthenticationProvider(), app.APITokenORM(), app.GetLogger().Named("GQLHandler")),
 		loader.Middleware(app),
 		graphqlHandler(app),
 	)
@@ -239,6 +239,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 	authv2 := r.Group("/v2", auth.Authenticate(app.AuthenticationProvider(),
 		auth.AuthenticateByToken,
+		auth.AuthenticateByAPIToken(app.APITokenORM()),
 		auth.AuthenticateBySession,
 	))
 	{
@@ -251,6 +252,11 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
 
+		atc := APITokensController{app}
+		authv2.GET("/api_tokens", auth.RequiresAdminRole(atc.Index))
+		authv2.POST("/api_tokens", auth.RequiresAdminRole(atc.Create))
+		authv2.DELETE("/api_tokens/:name", auth.RequiresAdminRole(atc.Revoke))
+
 		wa := NewWebAuthnController(app)
 		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
 		authv2.POST("/enroll_webauthn", wa.FinishRegistration)
@@ -285,6 +291,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
+		fbbc := EVMFeeBumpBudgetsController{app}
+		authv2.GET("/transactions/evm/fee_bump_budgets", fbbc.Index)
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
@@ -311,6 +319,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		ethKeysGroup := authv2.Group("", auth.Authenticate(app.AuthenticationProvider(),
 			auth.AuthenticateByToken,
+			auth.AuthenticateByAPIToken(app.APITokenORM()),
 			auth.AuthenticateBySession,
 		))
 
@@ -371,18 +380,31 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.POST("/jobs/simulate", auth.RequiresEditRole(jc.Simulate))
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
 
+		jsvc := JobSpecVersionsController{app}
+		authv2.GET("/jobs/:ID/versions", jsvc.Index)
+		authv2.GET("/jobs/:ID/diff", jsvc.Diff)
+		authv2.POST("/jobs/:ID/rollback", auth.RequiresEditRole(jsvc.Rollback))
+
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
 
+		wec := WorkflowExecutionsController{app}
+		authv2.GET("/workflows/executions", paginatedRequest(wec.Index))
+		authv2.GET("/workflows/executions/:ID", wec.Show)
+
 		// FeaturesController
 		fc := FeaturesController{app}
 		authv2.GET("/features", fc.Index)
 
+		capc := CapabilitiesController{app}
+		authv2.GET("/capabilities", capc.Index)
+
 		// PipelineJobSpecErrorsController
 		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresEditRole(psec.Destroy))
 
@@ -403,6 +425,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			chains.GET(chain.path, paginatedRequest(chain.cc.Index))
 			chains.GET(chain.path+"/:ID", chain.cc.Show)
 		}
+		erc := EVMReorgsController{app}
+		chains.GET("evm/:ID/reorgs", paginatedRequest(erc.Index))
 
 		nodes := authv2.Group("nodes")
 		for _, chain := range []struct {
@@ -438,6 +462,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	userOrEI := r.Group("/v2", auth.Authenticate(app.AuthenticationProvider(),
 		auth.AuthenticateExternalInitiator,
 		auth.AuthenticateByToken,
+		auth.AuthenticateByAPIToken(app.APITokenORM()),
 		auth.AuthenticateBySession,
 	))
 	userOrEI.GET("/ping", ping.Show)
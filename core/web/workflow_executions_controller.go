@@ -0,0 +1,84 @@
+package web
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WorkflowExecutionsController exposes the execution history of workflows.
+type WorkflowExecutionsController struct {
+	App chainlink.Application
+}
+
+// Index lists workflow executions, most recent first, one page at a time.
+// Results can be filtered by workflow ID, status and creation time range
+// (RFC3339 timestamps).
+// Example:
+// "GET <application>/workflows/executions?workflowID=<id>&status=completed&from=<time>&to=<time>"
+func (wec *WorkflowExecutionsController) Index(c *gin.Context, size, page, offset int) {
+	filter, err := parseExecutionFilter(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	executions, count, err := wec.App.WorkflowORM().List(c.Request.Context(), filter, offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	paginatedResponse(c, "workflowExecutions", size, page, presenters.NewWorkflowExecutionResources(executions), count, err)
+}
+
+// Show returns a workflow execution, including the inputs, outputs and errors of each step.
+// Example:
+// "GET <application>/workflows/executions/:ID"
+func (wec *WorkflowExecutionsController) Show(c *gin.Context) {
+	execution, err := wec.App.WorkflowORM().Get(c.Request.Context(), c.Param("ID"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow execution not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewWorkflowExecutionResource(execution), "workflowExecutions")
+}
+
+func parseExecutionFilter(c *gin.Context) (store.ExecutionFilter, error) {
+	filter := store.ExecutionFilter{
+		WorkflowID: c.Query("workflowID"),
+		Status:     c.Query("status"),
+	}
+	switch filter.Status {
+	case "", store.StatusStarted, store.StatusErrored, store.StatusTimeout, store.StatusCompleted:
+	default:
+		return filter, fmt.Errorf("invalid status %q", filter.Status)
+	}
+
+	for param, dst := range map[string]**time.Time{
+		"from": &filter.CreatedAfter,
+		"to":   &filter.CreatedBefore,
+	} {
+		if v := c.Query(param); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return filter, fmt.Errorf("invalid %s timestamp: %w", param, err)
+			}
+			*dst = &t
+		}
+	}
+	return filter, nil
+}
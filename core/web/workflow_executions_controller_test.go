@@ -0,0 +1,108 @@
+package web_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWorkflowExecutionsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+	ctx := testutils.Context(t)
+
+	completed, started := uuid.NewString(), uuid.NewString()
+	for _, id := range []string{completed, started} {
+		require.NoError(t, app.WorkflowORM().Add(ctx, &store.WorkflowExecution{
+			ExecutionID: id,
+			Status:      store.StatusStarted,
+			Steps:       map[string]*store.WorkflowExecutionStep{},
+		}))
+	}
+	require.NoError(t, app.WorkflowORM().UpdateStatus(ctx, completed, store.StatusCompleted))
+
+	resp, cleanup := client.Get("/v2/workflows/executions?status=completed")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var links jsonapi.Links
+	resources := []presenters.WorkflowExecutionResource{}
+	require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &resources, &links))
+	require.Len(t, resources, 1)
+	assert.Equal(t, completed, resources[0].ID)
+	assert.Equal(t, store.StatusCompleted, resources[0].Status)
+	assert.NotNil(t, resources[0].FinishedAt)
+
+	resp, cleanup = client.Get("/v2/workflows/executions?size=1")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	resources = []presenters.WorkflowExecutionResource{}
+	require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &resources, &links))
+	assert.Len(t, resources, 1)
+	assert.NotEmpty(t, links["next"].Href)
+
+	resp, cleanup = client.Get("/v2/workflows/executions?status=bogus")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Get("/v2/workflows/executions?from=yesterday")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
+func TestWorkflowExecutionsController_Show(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+	ctx := testutils.Context(t)
+
+	id := uuid.NewString()
+	inputs, err := values.NewMap(map[string]any{"feedID": "0x1"})
+	require.NoError(t, err)
+	output, err := values.Wrap("42")
+	require.NoError(t, err)
+	require.NoError(t, app.WorkflowORM().Add(ctx, &store.WorkflowExecution{
+		ExecutionID: id,
+		Status:      store.StatusStarted,
+		Steps: map[string]*store.WorkflowExecutionStep{
+			"trigger": {ExecutionID: id, Ref: "trigger", Status: store.StatusCompleted, Inputs: inputs, Outputs: store.StepOutput{Value: output}},
+			"target":  {ExecutionID: id, Ref: "target", Status: store.StatusErrored, Outputs: store.StepOutput{Err: errors.New("boom")}},
+		},
+	}))
+
+	resp, cleanup := client.Get("/v2/workflows/executions/" + id)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var resource presenters.WorkflowExecutionResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resource))
+	assert.Equal(t, id, resource.ID)
+	require.Len(t, resource.Steps, 2)
+	assert.Equal(t, "target", resource.Steps[0].Ref)
+	require.NotNil(t, resource.Steps[0].Error)
+	assert.Equal(t, "boom", *resource.Steps[0].Error)
+	assert.Equal(t, "trigger", resource.Steps[1].Ref)
+	assert.Equal(t, map[string]any{"feedID": "0x1"}, resource.Steps[1].Inputs)
+	assert.Equal(t, "42", resource.Steps[1].Outputs)
+
+	resp, cleanup = client.Get("/v2/workflows/executions/unknown")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}